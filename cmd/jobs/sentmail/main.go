@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"time"
 
 	"github.com/dwarvesf/go-api/pkg/config"
@@ -10,11 +11,16 @@ import (
 	"github.com/dwarvesf/go-api/pkg/logger/monitor"
 	"github.com/dwarvesf/go-api/pkg/repository"
 	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/service"
+	"github.com/dwarvesf/go-api/pkg/service/eventbus"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
 
 func main() {
+	campaign := flag.String("campaign", "", "campaign to send or resume, defaults to the campaign of the day")
+	flag.Parse()
+
 	cfg := config.LoadConfig(config.DefaultConfigLoaders())
 
 	l := logger.NewLogByConfig(cfg)
@@ -41,7 +47,17 @@ func main() {
 	ctx, span := sentryMonitor.Start(context.Background(), spanName, opts...)
 	defer span.End()
 
+	// the job has no subscriber, the bus is only needed by the controller
+	bus := eventbus.New(l, eventbus.DefaultBufferSize)
+	defer bus.Close()
+
 	// new controler
-	c := user.NewUserController(*cfg, repository.NewRepo(), sentryMonitor)
-	c.SentMail(ctx)
+	c := user.NewUserController(*cfg, repository.NewRepo(sentryMonitor), service.New(cfg), bus, sentryMonitor)
+	rs, err := c.SentMail(ctx, *campaign)
+	if err != nil {
+		l.Error(err, "failed to send mail")
+		return
+	}
+	l.Infof("campaign %s finished in %s: %d sent, %d skipped, %d failed",
+		rs.Campaign, rs.Duration, rs.Sent, rs.Skipped, rs.Failed)
 }
@@ -7,16 +7,25 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/dwarvesf/go-api/pkg/config"
+	jobHandler "github.com/dwarvesf/go-api/pkg/handler/job"
+	realtimeHandler "github.com/dwarvesf/go-api/pkg/handler/v1/realtime"
+	"github.com/dwarvesf/go-api/pkg/jobs"
 	"github.com/dwarvesf/go-api/pkg/logger"
 	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/mailing"
 	"github.com/dwarvesf/go-api/pkg/middleware"
 	"github.com/dwarvesf/go-api/pkg/realtime"
 	"github.com/dwarvesf/go-api/pkg/repository"
 	"github.com/dwarvesf/go-api/pkg/repository/db"
+	repoUtil "github.com/dwarvesf/go-api/pkg/repository/util"
+	"github.com/dwarvesf/go-api/pkg/scheduler"
 	"github.com/dwarvesf/go-api/pkg/service"
+	"github.com/dwarvesf/go-api/pkg/service/eventbus"
 	"github.com/dwarvesf/go-api/pkg/service/jwthelper"
 )
 
@@ -39,6 +48,19 @@ import (
 // @name Authorization
 func main() {
 	cfg := config.LoadConfig(config.DefaultConfigLoaders())
+	if isMigrateCommand() {
+		if err := runMigrate(*cfg, os.Args[2:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if isSeedCommand() {
+		if err := runSeed(*cfg, os.Args[2:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	sMonitor, err := monitor.NewSentry(cfg)
 	if err != nil {
 		log.Fatal(err, "failed to init sentry")
@@ -49,19 +71,50 @@ func main() {
 	l.Infof("Server starting")
 
 	authMw := middleware.NewAuthMiddleware(jwthelper.NewHelper(cfg.SecretKey))
+	repo := repository.NewRepo(sMonitor)
+	tickets := realtime.NewTicketStore(repo.RealtimeTicket, realtime.DefaultTicketTTL)
+
+	// undeliverable messages are only persisted when the offline queue is enabled
+	var offline realtime.OfflineQueue
+	if cfg.RealtimeOfflineQueue {
+		offline = realtime.NewOfflineQueue(repo.OfflineMessage, time.Duration(cfg.RealtimeOfflineTTL)*time.Second)
+	}
+
 	a := App{
-		l:              l,
-		cfg:            cfg,
-		service:        service.New(cfg),
-		repo:           repository.NewRepo(),
-		monitor:        sMonitor,
-		realtimeServer: realtime.New(authMw, l),
+		l:         l,
+		cfg:       cfg,
+		service:   service.New(cfg),
+		repo:      repo,
+		bus:       eventbus.New(l, eventbus.DefaultBufferSize),
+		monitor:   sMonitor,
+		tickets:   tickets,
+		offline:   offline,
+		wsServer:  realtime.New(*cfg, authMw, tickets, offline, l),
+		sseServer: realtime.NewSSE(*cfg, authMw, tickets, offline, l),
+		topics:    realtime.NewTopics(),
 	}
+	a.rtHandler = newRealtimeHandler(a)
+
+	// keep every open tab of the user in sync with the domain events
+	a.bus.Subscribe(a.rtHandler.ForwardEvent)
 
 	_, err = db.Init(*cfg)
 	if err != nil {
 		l.Fatal(err, "failed to init db")
 	}
+	// the replicas of a rollout take turns, the first one applies the migrations
+	if cfg.DBMigrateOnBoot {
+		n, err := db.MigrateOnBoot(context.Background())
+		if err != nil {
+			l.Fatal(err, "failed to migrate db")
+		}
+		l.Infof("Applied %d migrations", n)
+	}
+	cursorSecret := cfg.CursorSecret
+	if cursorSecret == "" {
+		cursorSecret = repoUtil.DeriveCursorSecret(cfg.SecretKey)
+	}
+	repoUtil.SetCursorSecret(cursorSecret)
 
 	// Server
 	srv := &http.Server{
@@ -69,7 +122,39 @@ func main() {
 		Handler: setupRouter(a),
 	}
 
-	quit := make(chan os.Signal)
+	// run the job worker, the mail dispatcher and the scheduler in the server process when enabled
+	bgCtx, stopBackground := context.WithCancel(context.Background())
+	var background sync.WaitGroup
+	jobH := jobHandler.New(*cfg, l, repo, a.service, a.bus, sMonitor)
+	if cfg.JobsEmbedded {
+		w := jobs.NewWorker(*cfg, repo.Job, l)
+		jobH.Register(w)
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			w.Run(bgCtx)
+		}()
+
+		dispatcher := mailing.NewDispatcher(*cfg, repo, a.service.Mailer, l)
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			dispatcher.Run(bgCtx)
+		}()
+	}
+	if cfg.SchedulerEnabled {
+		sched := scheduler.New(repo.Schedule, l)
+		if err := jobH.RegisterSchedules(sched); err != nil {
+			l.Fatal(err, "failed to register schedules")
+		}
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			sched.Run(bgCtx)
+		}()
+	}
+
+	quit := make(chan os.Signal, 1)
 
 	// serve http server
 	go func() {
@@ -81,16 +166,32 @@ func main() {
 		quit <- os.Interrupt
 	}()
 
-	signal.Notify(quit, os.Interrupt)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 
 	<-quit
 
-	shutdownServer(srv, l)
+	stopBackground()
+	shutdownServer(srv, l, a.wsServer, a.sseServer)
+	background.Wait()
+	a.bus.Close()
 }
 
-func shutdownServer(srv *http.Server, l logger.Log) {
+// shutdownTimeout is the deadline for the clients to disconnect and the requests to finish
+const shutdownTimeout = 20 * time.Second
+
+func shutdownServer(srv *http.Server, l logger.Log, rtServers ...realtime.Server) {
 	l.Info("Server Shutting Down")
-	if err := srv.Shutdown(context.Background()); err != nil {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	// hijacked WebSocket connections and SSE streams aren't closed by srv.Shutdown
+	for _, s := range rtServers {
+		if err := s.Shutdown(ctx); err != nil {
+			l.Error(err, "failed to shutdown realtime server")
+		}
+	}
+
+	if err := srv.Shutdown(ctx); err != nil {
 		l.Error(err, "failed to shutdown server")
 	}
 
@@ -99,10 +200,16 @@ func shutdownServer(srv *http.Server, l logger.Log) {
 
 // App api app instance
 type App struct {
-	l              logger.Log
-	cfg            *config.Config
-	service        service.Service
-	repo           *repository.Repo
-	monitor        monitor.Tracer
-	realtimeServer realtime.Server
+	l         logger.Log
+	cfg       *config.Config
+	service   service.Service
+	repo      *repository.Repo
+	bus       eventbus.Bus
+	monitor   monitor.Tracer
+	tickets   realtime.TicketStore
+	offline   realtime.OfflineQueue
+	wsServer  realtime.Server
+	sseServer realtime.Server
+	topics    *realtime.Topics
+	rtHandler *realtimeHandler.Handler
 }
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+)
+
+const migrateUsage = `usage: server migrate <command> [-limit N]
+
+commands:
+  up      apply the pending migrations, all of them by default
+  down    roll back the applied migrations, the last one by default
+  status  list the migrations and when they were applied
+  redo    roll back the last migration and apply it again`
+
+// runMigrate runs the migrate command with the migrations embedded in the binary
+func runMigrate(cfg config.Config, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	cmd := args[0]
+	switch cmd {
+	case "up", "down", "status", "redo":
+	default:
+		return errors.New(migrateUsage)
+	}
+
+	fs := flag.NewFlagSet("migrate "+cmd, flag.ContinueOnError)
+	defaultLimit := 0
+	if cmd == "down" {
+		defaultLimit = 1
+	}
+	limit := fs.Int("limit", defaultLimit, "the number of migrations to apply or roll back, 0 means all of them")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	if _, err := db.Init(cfg); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	switch cmd {
+	case "up", "down":
+		dir, done := db.Up, "Applied"
+		if cmd == "down" {
+			dir, done = db.Down, "Rolled back"
+		}
+		n, err := db.Migrate(ctx, dir, *limit)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "%s %d migrations\n", done, n)
+
+	case "redo":
+		id, err := db.Redo(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Reapplied migration %s\n", id)
+
+	case "status":
+		ss, err := db.MigrationStatuses()
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "MIGRATION\tAPPLIED")
+		for _, s := range ss {
+			appliedAt := "no"
+			if s.AppliedAt != nil {
+				appliedAt = s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%s\t%s\n", s.ID, appliedAt)
+		}
+		return w.Flush()
+	}
+
+	return nil
+}
+
+// isMigrateCommand reports whether the binary was started with the migrate command
+func isMigrateCommand() bool {
+	return len(os.Args) > 1 && os.Args[1] == "migrate"
+}
@@ -3,14 +3,18 @@ package main
 import (
 	"github.com/dwarvesf/go-api/docs"
 	"github.com/dwarvesf/go-api/pkg/handler"
+	"github.com/dwarvesf/go-api/pkg/handler/v1/admin"
 	"github.com/dwarvesf/go-api/pkg/handler/v1/portal"
+	realtimeHandler "github.com/dwarvesf/go-api/pkg/handler/v1/realtime"
+	"github.com/dwarvesf/go-api/pkg/handler/v1/webhook"
 	"github.com/dwarvesf/go-api/pkg/logger/monitor"
 	"github.com/dwarvesf/go-api/pkg/middleware"
+	"github.com/dwarvesf/go-api/pkg/model"
 	"github.com/dwarvesf/go-api/pkg/realtime"
 	"github.com/dwarvesf/go-api/pkg/service/jwthelper"
-	"github.com/dwarvesf/go-api/pkg/util"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	swaggerFiles "github.com/swaggo/files"     // swagger embed files
 	ginSwagger "github.com/swaggo/gin-swagger" // gin-swagger middleware
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
@@ -30,6 +34,12 @@ func setupRouter(a App) *gin.Engine {
 
 	r := gin.New()
 
+	// the client IP is only read from X-Forwarded-For behind the trusted proxies,
+	// the limits per IP of the realtime servers can't be bypassed by forging it
+	if err := r.SetTrustedProxies(a.cfg.TrustedProxies); err != nil {
+		a.l.Fatal(err, "invalid trusted proxies")
+	}
+
 	r.Use(cors.New(
 		cors.Config{
 			AllowOrigins: []string{"*"},
@@ -37,8 +47,9 @@ func setupRouter(a App) *gin.Engine {
 			AllowHeaders: []string{"Origin", "Host",
 				"Content-Type", "Content-Length",
 				"Accept-Encoding", "Accept-Language", "Accept",
-				"X-CSRF-Token", "Authorization", "X-Requested-With", "X-Access-Token"},
-			ExposeHeaders:    []string{"MeAllowMethodsntent-Length"},
+				"X-CSRF-Token", "Authorization", "X-Requested-With", "X-Access-Token",
+				"If-Match"},
+			ExposeHeaders:    []string{"Content-Length", "ETag"},
 			AllowCredentials: true,
 		},
 	))
@@ -60,9 +71,10 @@ func setupRouter(a App) *gin.Engine {
 
 func publicHandler(r *gin.Engine, a App) {
 	h := handler.New(*a.cfg, a.monitor)
-	portalHandler := portal.New(*a.cfg, a.l, a.repo, a.service, a.monitor)
+	portalHandler := portal.New(*a.cfg, a.l, a.repo, a.service, a.bus, a.monitor)
 
 	r.GET("/healthz", h.Healthz)
+	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
 	// use ginSwagger middleware to serve the API docs
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -73,22 +85,18 @@ func publicHandler(r *gin.Engine, a App) {
 	{
 		portalGroup.POST("/auth/login", portalHandler.Login)
 		portalGroup.POST("/auth/signup", portalHandler.Signup)
+		portalGroup.GET("/mail/unsubscribe", portalHandler.Unsubscribe)
+		portalGroup.POST("/mail/unsubscribe", portalHandler.Unsubscribe)
 	}
 
-	apiV1.GET("/sse", realtime.SSEHeadersMiddleware(), func(c *gin.Context) {
-		u, err := a.realtimeServer.HandleConnection(c)
-		if err != nil {
-			a.l.Error(err, "failed to handle connection")
-			util.HandleError(c, err)
-			return
-		}
-
-		a.l.Infof("user %s connected", u.ID)
-		a.realtimeServer.HandleEvent(c, *u, func(ginCtx *gin.Context, data any) error {
-			a.l.Infof("data received: %v", data)
-			return nil
-		})
-	})
+	webhookHandler := webhook.New(*a.cfg, a.l, a.repo, a.monitor)
+	webhookGroup := apiV1.Group("/webhooks")
+	{
+		webhookGroup.POST("/mail", webhookHandler.MailEvents)
+	}
+
+	apiV1.GET("/ws", a.rtHandler.WebSocket)
+	apiV1.GET("/sse", realtime.SSEHeadersMiddleware(), a.rtHandler.SSE)
 }
 
 func authenticatedHandler(r *gin.Engine, a App) {
@@ -99,9 +107,50 @@ func authenticatedHandler(r *gin.Engine, a App) {
 	apiV1.Use(authMw.WithAuth)
 	portalGroup := apiV1.Group("/portal")
 	{
-		portalHandler := portal.New(*a.cfg, a.l, a.repo, a.service, a.monitor)
+		portalHandler := portal.New(*a.cfg, a.l, a.repo, a.service, a.bus, a.monitor)
 		portalGroup.GET("/me", portalHandler.Me)
 		portalGroup.PUT("/users", portalHandler.UpdateUser)
 		portalGroup.PUT("/users/password", portalHandler.UpdatePassword)
+		portalGroup.GET("/users/mail-preferences", portalHandler.MailPreference)
+		portalGroup.PUT("/users/mail-preferences", portalHandler.UpdateMailPreference)
+	}
+
+	apiV1.POST("/realtime/ticket", a.rtHandler.Ticket)
+	apiV1.POST("/realtime/ack", a.rtHandler.Ack)
+
+	adminHandler := admin.New(*a.cfg, a.l, a.repo, a.service, a.bus, a.monitor)
+	adminGroup := apiV1.Group("/admin", middleware.WithRole(model.RoleAdmin))
+	{
+		adminGroup.GET("/users", adminHandler.Users)
+		adminGroup.GET("/schedules", adminHandler.Schedules)
+		adminGroup.GET("/campaigns", adminHandler.Campaigns)
+		adminGroup.POST("/campaigns", adminHandler.CreateCampaign)
+		adminGroup.GET("/campaigns/:id", adminHandler.Campaign)
+		adminGroup.PUT("/campaigns/:id", adminHandler.UpdateCampaign)
+		adminGroup.POST("/campaigns/:id/schedule", adminHandler.ScheduleCampaign)
+		adminGroup.POST("/campaigns/:id/unschedule", adminHandler.UnscheduleCampaign)
+		adminGroup.GET("/campaigns/:id/preview", adminHandler.PreviewCampaign)
+		adminGroup.GET("/campaigns/:id/sample", adminHandler.SampleCampaign)
+		adminGroup.GET("/realtime/connections", a.rtHandler.Connections)
+		adminGroup.DELETE("/realtime/connections/:userID", a.rtHandler.Disconnect)
 	}
 }
+
+// newRealtimeHandler builds the realtime handler, it's built once and shared by the routes and the event bus.
+func newRealtimeHandler(a App) *realtimeHandler.Handler {
+	return realtimeHandler.New(*a.cfg, a.l, a.monitor, a.wsServer, a.sseServer, a.tickets, a.offline, realtimeRouter(a), a.topics)
+}
+
+func realtimeRouter(a App) *realtime.Router {
+	r := realtime.NewRouter()
+	r.Handle("ping", func(c *gin.Context, u realtime.User, msg realtime.Message) (any, error) {
+		return "pong", nil
+	})
+	r.Handle(realtime.TypeSubscribe, realtime.HandleSubscribe(a.topics))
+	r.Handle(realtime.TypeUnsubscribe, realtime.HandleUnsubscribe(a.topics))
+	if a.offline != nil {
+		r.Handle(realtime.TypeOfflineAck, realtime.HandleAck(a.offline))
+	}
+
+	return r
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/seed"
+)
+
+const seedUsage = `usage: server seed <profile>
+       server seed -file <fixtures.yaml|fixtures.json>
+
+profiles:
+  dev   the accounts of the local environment
+  demo  the users and the campaigns of a demo`
+
+// runSeed inserts the fixtures of a profile, or of a file, through the repos
+func runSeed(cfg config.Config, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("seed", flag.ContinueOnError)
+	file := fs.String("file", "", "the YAML or JSON file of the fixtures")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if (*file == "") == (fs.NArg() == 0) {
+		return errors.New(seedUsage)
+	}
+
+	// the fixtures have well-known passwords
+	if cfg.Env == "prod" {
+		return errors.New("seeding is disabled in the prod environment")
+	}
+
+	var f *seed.Fixtures
+	var err error
+	if *file != "" {
+		f, err = seed.LoadFile(*file)
+	} else {
+		f, err = seed.Load(fs.Arg(0))
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err := db.Init(cfg); err != nil {
+		return err
+	}
+
+	// the seed command isn't traced
+	rs, err := seed.New(repository.NewRepo(monitor.TestMonitor())).Seed(context.Background(), *f)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Created %d users and %d campaigns\n", rs.Users, rs.Campaigns)
+
+	return nil
+}
+
+// isSeedCommand reports whether the binary was started with the seed command
+func isSeedCommand() bool {
+	return len(os.Args) > 1 && os.Args[1] == "seed"
+}
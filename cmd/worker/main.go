@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/config"
+	jobHandler "github.com/dwarvesf/go-api/pkg/handler/job"
+	"github.com/dwarvesf/go-api/pkg/jobs"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/mailing"
+	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/scheduler"
+	"github.com/dwarvesf/go-api/pkg/service"
+	"github.com/dwarvesf/go-api/pkg/service/eventbus"
+)
+
+func main() {
+	cfg := config.LoadConfig(config.DefaultConfigLoaders())
+	sMonitor, err := monitor.NewSentry(cfg)
+	if err != nil {
+		log.Fatal(err, "failed to init sentry")
+	}
+	defer sMonitor.Clean(2 * time.Second)
+
+	l := logger.NewLogByConfig(cfg)
+	l.Infof("Worker starting")
+
+	_, err = db.Init(*cfg)
+	if err != nil {
+		l.Fatal(err, "failed to init db")
+	}
+
+	// the worker has no subscriber, the bus is only needed by the controllers
+	bus := eventbus.New(l, eventbus.DefaultBufferSize)
+	defer bus.Close()
+
+	repo := repository.NewRepo(sMonitor)
+	svc := service.New(cfg)
+	jobH := jobHandler.New(*cfg, l, repo, svc, bus, sMonitor)
+	w := jobs.NewWorker(*cfg, repo.Job, l)
+	jobH.Register(w)
+
+	// stop claiming jobs on SIGTERM and let the running ones finish
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// the dispatchers of the replicas claim the mails of the outbox one by one
+	var wg sync.WaitGroup
+	dispatcher := mailing.NewDispatcher(*cfg, repo, svc.Mailer, l)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		dispatcher.Run(ctx)
+	}()
+
+	// the replicas elect a leader so the scheduler can run in every worker
+	if cfg.SchedulerEnabled {
+		sched := scheduler.New(repo.Schedule, l)
+		if err := jobH.RegisterSchedules(sched); err != nil {
+			l.Fatal(err, "failed to register schedules")
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sched.Run(ctx)
+		}()
+	}
+
+	w.Run(ctx)
+	wg.Wait()
+	l.Info("Worker Exit")
+}
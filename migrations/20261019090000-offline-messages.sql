@@ -0,0 +1,14 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS offline_messages (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    payload JSONB NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS offline_messages_user_id_idx ON offline_messages (user_id, id);
+CREATE INDEX IF NOT EXISTS offline_messages_expires_at_idx ON offline_messages (expires_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS offline_messages;
@@ -0,0 +1,6 @@
+-- +migrate Up
+-- the version is bumped by every update of the profile, the update of a stale version is rejected
+ALTER TABLE users ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+-- +migrate Down
+ALTER TABLE users DROP COLUMN IF EXISTS version;
@@ -0,0 +1,75 @@
+-- +migrate Up
+-- the user making the changes, set on the session by the connections of the API before every write
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION audit_user_id() RETURNS INTEGER AS $$
+    SELECT NULLIF(current_setting('app.user_id', true), '')::INTEGER;
+$$ LANGUAGE sql STABLE;
+-- +migrate StatementEnd
+
+-- updated_at is only set when the update didn't set it, the creator can't be changed
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION set_audit_columns() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        NEW.created_by := COALESCE(NEW.created_by, audit_user_id());
+        NEW.updated_by := COALESCE(NEW.updated_by, NEW.created_by);
+    ELSE
+        NEW.created_by := OLD.created_by;
+        NEW.updated_by := audit_user_id();
+        IF NEW.updated_at IS NOT DISTINCT FROM OLD.updated_at THEN
+            NEW.updated_at := NOW();
+        END IF;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+-- the new tables are audited by calling it in their migration: SELECT audit_table('table');
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION audit_table(tbl REGCLASS) RETURNS VOID AS $$
+BEGIN
+    EXECUTE format('ALTER TABLE %s
+        ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        ADD COLUMN IF NOT EXISTS created_by INTEGER,
+        ADD COLUMN IF NOT EXISTS updated_by INTEGER', tbl);
+    EXECUTE format('DROP TRIGGER IF EXISTS audit ON %s', tbl);
+    EXECUTE format('CREATE TRIGGER audit BEFORE INSERT OR UPDATE ON %s
+        FOR EACH ROW EXECUTE FUNCTION set_audit_columns()', tbl);
+END;
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+SELECT audit_table('users');
+SELECT audit_table('jobs');
+SELECT audit_table('schedules');
+SELECT audit_table('mail_checkpoints');
+SELECT audit_table('mail_deliveries');
+SELECT audit_table('campaigns');
+SELECT audit_table('mail_preferences');
+SELECT audit_table('mail_outbox');
+SELECT audit_table('mail_suppressions');
+SELECT audit_table('offline_messages');
+
+-- +migrate Down
+-- +migrate StatementBegin
+DO $$
+DECLARE
+    tbl TEXT;
+BEGIN
+    FOREACH tbl IN ARRAY ARRAY['users', 'jobs', 'schedules', 'mail_checkpoints', 'mail_deliveries',
+        'campaigns', 'mail_preferences', 'mail_outbox', 'mail_suppressions', 'offline_messages']
+    LOOP
+        EXECUTE format('DROP TRIGGER IF EXISTS audit ON %I', tbl);
+        EXECUTE format('ALTER TABLE %I DROP COLUMN IF EXISTS created_by, DROP COLUMN IF EXISTS updated_by', tbl);
+    END LOOP;
+END;
+$$;
+-- +migrate StatementEnd
+
+-- offline_messages is the only audited table which had no updated_at before
+ALTER TABLE offline_messages DROP COLUMN IF EXISTS updated_at;
+
+DROP FUNCTION IF EXISTS audit_table(REGCLASS);
+DROP FUNCTION IF EXISTS set_audit_columns();
+DROP FUNCTION IF EXISTS audit_user_id();
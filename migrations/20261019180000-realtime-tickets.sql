@@ -0,0 +1,15 @@
+-- +migrate Up
+-- the tickets are shared by the replicas, a ticket issued by one of them opens a connection on any other
+CREATE TABLE IF NOT EXISTS realtime_tickets (
+    token_hash VARCHAR(64) PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS realtime_tickets_expires_at_idx ON realtime_tickets (expires_at);
+
+-- the tickets are never updated, only inserted and deleted once redeemed or expired, so they aren't audited
+
+-- +migrate Down
+DROP TABLE IF EXISTS realtime_tickets;
@@ -0,0 +1,17 @@
+-- +migrate Up
+-- the devices the users logged in from, a login from an unknown device is reported to the user
+CREATE TABLE IF NOT EXISTS user_devices (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    fingerprint VARCHAR(64) NOT NULL,
+    ip VARCHAR(45) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, fingerprint)
+);
+
+SELECT audit_table('user_devices');
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_devices;
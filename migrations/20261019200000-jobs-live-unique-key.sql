@@ -0,0 +1,8 @@
+-- +migrate Up
+-- the unique key only deduplicates the live jobs, a key can be enqueued again once its job succeeded or died
+ALTER TABLE jobs DROP CONSTRAINT IF EXISTS jobs_unique_key_key;
+CREATE UNIQUE INDEX IF NOT EXISTS jobs_live_unique_key_idx ON jobs (unique_key) WHERE status IN ('pending', 'running');
+
+-- +migrate Down
+DROP INDEX IF EXISTS jobs_live_unique_key_idx;
+ALTER TABLE jobs ADD CONSTRAINT jobs_unique_key_key UNIQUE (unique_key);
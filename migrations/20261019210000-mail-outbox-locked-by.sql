@@ -0,0 +1,6 @@
+-- +migrate Up
+-- the dispatcher holding the lock of the mail, the outcome of a dispatcher whose lock expired is ignored
+ALTER TABLE mail_outbox ADD COLUMN IF NOT EXISTS locked_by VARCHAR(255);
+
+-- +migrate Down
+ALTER TABLE mail_outbox DROP COLUMN IF EXISTS locked_by;
@@ -0,0 +1,9 @@
+// Package migrations embeds the SQL migrations so the binaries can apply them without the sources
+package migrations
+
+import "embed"
+
+// FS holds the migrations in the sql-migrate format
+//
+//go:embed *.sql
+var FS embed.FS
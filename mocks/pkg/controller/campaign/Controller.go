@@ -0,0 +1,633 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mailer "github.com/dwarvesf/go-api/pkg/service/mailer"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+
+	time "time"
+)
+
+// Controller is an autogenerated mock type for the Controller type
+type Controller struct {
+	mock.Mock
+}
+
+type Controller_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Controller) EXPECT() *Controller_Expecter {
+	return &Controller_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *Controller) Create(ctx context.Context, req model.CampaignRequest) (*model.Campaign, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.CampaignRequest) (*model.Campaign, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.CampaignRequest) *model.Campaign); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.CampaignRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Controller_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type Controller_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req model.CampaignRequest
+func (_e *Controller_Expecter) Create(ctx interface{}, req interface{}) *Controller_Create_Call {
+	return &Controller_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *Controller_Create_Call) Run(run func(ctx context.Context, req model.CampaignRequest)) *Controller_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.CampaignRequest))
+	})
+	return _c
+}
+
+func (_c *Controller_Create_Call) Return(_a0 *model.Campaign, _a1 error) *Controller_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Controller_Create_Call) RunAndReturn(run func(context.Context, model.CampaignRequest) (*model.Campaign, error)) *Controller_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Dispatch provides a mock function with given fields: ctx, now
+func (_m *Controller) Dispatch(ctx context.Context, now time.Time) ([]model.Campaign, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 []model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]model.Campaign, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []model.Campaign); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Controller_Dispatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dispatch'
+type Controller_Dispatch_Call struct {
+	*mock.Call
+}
+
+// Dispatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *Controller_Expecter) Dispatch(ctx interface{}, now interface{}) *Controller_Dispatch_Call {
+	return &Controller_Dispatch_Call{Call: _e.mock.On("Dispatch", ctx, now)}
+}
+
+func (_c *Controller_Dispatch_Call) Run(run func(ctx context.Context, now time.Time)) *Controller_Dispatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Controller_Dispatch_Call) Return(_a0 []model.Campaign, _a1 error) *Controller_Dispatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Controller_Dispatch_Call) RunAndReturn(run func(context.Context, time.Time) ([]model.Campaign, error)) *Controller_Dispatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fail provides a mock function with given fields: ctx, id
+func (_m *Controller) Fail(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Controller_Fail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fail'
+type Controller_Fail_Call struct {
+	*mock.Call
+}
+
+// Fail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *Controller_Expecter) Fail(ctx interface{}, id interface{}) *Controller_Fail_Call {
+	return &Controller_Fail_Call{Call: _e.mock.On("Fail", ctx, id)}
+}
+
+func (_c *Controller_Fail_Call) Run(run func(ctx context.Context, id int)) *Controller_Fail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Controller_Fail_Call) Return(_a0 error) *Controller_Fail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Controller_Fail_Call) RunAndReturn(run func(context.Context, int) error) *Controller_Fail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *Controller) Get(ctx context.Context, id int) (*model.Campaign, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*model.Campaign, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *model.Campaign); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Controller_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type Controller_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *Controller_Expecter) Get(ctx interface{}, id interface{}) *Controller_Get_Call {
+	return &Controller_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *Controller_Get_Call) Run(run func(ctx context.Context, id int)) *Controller_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Controller_Get_Call) Return(_a0 *model.Campaign, _a1 error) *Controller_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Controller_Get_Call) RunAndReturn(run func(context.Context, int) (*model.Campaign, error)) *Controller_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *Controller) List(ctx context.Context) ([]model.Campaign, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.Campaign, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.Campaign); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Controller_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type Controller_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Controller_Expecter) List(ctx interface{}) *Controller_List_Call {
+	return &Controller_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *Controller_List_Call) Run(run func(ctx context.Context)) *Controller_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Controller_List_Call) Return(_a0 []model.Campaign, _a1 error) *Controller_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Controller_List_Call) RunAndReturn(run func(context.Context) ([]model.Campaign, error)) *Controller_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Preview provides a mock function with given fields: ctx, id
+func (_m *Controller) Preview(ctx context.Context, id int) (int64, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int64, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int64); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Controller_Preview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Preview'
+type Controller_Preview_Call struct {
+	*mock.Call
+}
+
+// Preview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *Controller_Expecter) Preview(ctx interface{}, id interface{}) *Controller_Preview_Call {
+	return &Controller_Preview_Call{Call: _e.mock.On("Preview", ctx, id)}
+}
+
+func (_c *Controller_Preview_Call) Run(run func(ctx context.Context, id int)) *Controller_Preview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Controller_Preview_Call) Return(_a0 int64, _a1 error) *Controller_Preview_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Controller_Preview_Call) RunAndReturn(run func(context.Context, int) (int64, error)) *Controller_Preview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Sample provides a mock function with given fields: ctx, id
+func (_m *Controller) Sample(ctx context.Context, id int) (*mailer.Message, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *mailer.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*mailer.Message, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *mailer.Message); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*mailer.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Controller_Sample_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sample'
+type Controller_Sample_Call struct {
+	*mock.Call
+}
+
+// Sample is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *Controller_Expecter) Sample(ctx interface{}, id interface{}) *Controller_Sample_Call {
+	return &Controller_Sample_Call{Call: _e.mock.On("Sample", ctx, id)}
+}
+
+func (_c *Controller_Sample_Call) Run(run func(ctx context.Context, id int)) *Controller_Sample_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Controller_Sample_Call) Return(_a0 *mailer.Message, _a1 error) *Controller_Sample_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Controller_Sample_Call) RunAndReturn(run func(context.Context, int) (*mailer.Message, error)) *Controller_Sample_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Schedule provides a mock function with given fields: ctx, id, at
+func (_m *Controller) Schedule(ctx context.Context, id int, at time.Time) (*model.Campaign, error) {
+	ret := _m.Called(ctx, id, at)
+
+	var r0 *model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) (*model.Campaign, error)); ok {
+		return rf(ctx, id, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) *model.Campaign); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, id, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Controller_Schedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Schedule'
+type Controller_Schedule_Call struct {
+	*mock.Call
+}
+
+// Schedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - at time.Time
+func (_e *Controller_Expecter) Schedule(ctx interface{}, id interface{}, at interface{}) *Controller_Schedule_Call {
+	return &Controller_Schedule_Call{Call: _e.mock.On("Schedule", ctx, id, at)}
+}
+
+func (_c *Controller_Schedule_Call) Run(run func(ctx context.Context, id int, at time.Time)) *Controller_Schedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Controller_Schedule_Call) Return(_a0 *model.Campaign, _a1 error) *Controller_Schedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Controller_Schedule_Call) RunAndReturn(run func(context.Context, int, time.Time) (*model.Campaign, error)) *Controller_Schedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Send provides a mock function with given fields: ctx, id
+func (_m *Controller) Send(ctx context.Context, id int) (*model.SentMailReport, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.SentMailReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*model.SentMailReport, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *model.SentMailReport); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SentMailReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Controller_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type Controller_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *Controller_Expecter) Send(ctx interface{}, id interface{}) *Controller_Send_Call {
+	return &Controller_Send_Call{Call: _e.mock.On("Send", ctx, id)}
+}
+
+func (_c *Controller_Send_Call) Run(run func(ctx context.Context, id int)) *Controller_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Controller_Send_Call) Return(_a0 *model.SentMailReport, _a1 error) *Controller_Send_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Controller_Send_Call) RunAndReturn(run func(context.Context, int) (*model.SentMailReport, error)) *Controller_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unschedule provides a mock function with given fields: ctx, id
+func (_m *Controller) Unschedule(ctx context.Context, id int) (*model.Campaign, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*model.Campaign, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *model.Campaign); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Controller_Unschedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unschedule'
+type Controller_Unschedule_Call struct {
+	*mock.Call
+}
+
+// Unschedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *Controller_Expecter) Unschedule(ctx interface{}, id interface{}) *Controller_Unschedule_Call {
+	return &Controller_Unschedule_Call{Call: _e.mock.On("Unschedule", ctx, id)}
+}
+
+func (_c *Controller_Unschedule_Call) Run(run func(ctx context.Context, id int)) *Controller_Unschedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Controller_Unschedule_Call) Return(_a0 *model.Campaign, _a1 error) *Controller_Unschedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Controller_Unschedule_Call) RunAndReturn(run func(context.Context, int) (*model.Campaign, error)) *Controller_Unschedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *Controller) Update(ctx context.Context, id int, req model.CampaignRequest) (*model.Campaign, error) {
+	ret := _m.Called(ctx, id, req)
+
+	var r0 *model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.CampaignRequest) (*model.Campaign, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.CampaignRequest) *model.Campaign); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, model.CampaignRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Controller_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type Controller_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req model.CampaignRequest
+func (_e *Controller_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *Controller_Update_Call {
+	return &Controller_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *Controller_Update_Call) Run(run func(ctx context.Context, id int, req model.CampaignRequest)) *Controller_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(model.CampaignRequest))
+	})
+	return _c
+}
+
+func (_c *Controller_Update_Call) Return(_a0 *model.Campaign, _a1 error) *Controller_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Controller_Update_Call) RunAndReturn(run func(context.Context, int, model.CampaignRequest) (*model.Campaign, error)) *Controller_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewController creates a new instance of Controller. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Controller {
+	mock := &Controller{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,81 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+)
+
+// Controller is an autogenerated mock type for the Controller type
+type Controller struct {
+	mock.Mock
+}
+
+type Controller_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Controller) EXPECT() *Controller_Expecter {
+	return &Controller_Expecter{mock: &_m.Mock}
+}
+
+// HandleEvents provides a mock function with given fields: ctx, events
+func (_m *Controller) HandleEvents(ctx context.Context, events []model.MailEvent) error {
+	ret := _m.Called(ctx, events)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.MailEvent) error); ok {
+		r0 = rf(ctx, events)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Controller_HandleEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleEvents'
+type Controller_HandleEvents_Call struct {
+	*mock.Call
+}
+
+// HandleEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - events []model.MailEvent
+func (_e *Controller_Expecter) HandleEvents(ctx interface{}, events interface{}) *Controller_HandleEvents_Call {
+	return &Controller_HandleEvents_Call{Call: _e.mock.On("HandleEvents", ctx, events)}
+}
+
+func (_c *Controller_HandleEvents_Call) Run(run func(ctx context.Context, events []model.MailEvent)) *Controller_HandleEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]model.MailEvent))
+	})
+	return _c
+}
+
+func (_c *Controller_HandleEvents_Call) Return(_a0 error) *Controller_HandleEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Controller_HandleEvents_Call) RunAndReturn(run func(context.Context, []model.MailEvent) error) *Controller_HandleEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewController creates a new instance of Controller. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Controller {
+	mock := &Controller{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
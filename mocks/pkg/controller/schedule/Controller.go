@@ -0,0 +1,91 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Controller is an autogenerated mock type for the Controller type
+type Controller struct {
+	mock.Mock
+}
+
+type Controller_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Controller) EXPECT() *Controller_Expecter {
+	return &Controller_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *Controller) List(ctx context.Context) ([]model.Schedule, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []model.Schedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.Schedule, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.Schedule); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Schedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Controller_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type Controller_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Controller_Expecter) List(ctx interface{}) *Controller_List_Call {
+	return &Controller_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *Controller_List_Call) Run(run func(ctx context.Context)) *Controller_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Controller_List_Call) Return(_a0 []model.Schedule, _a1 error) *Controller_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Controller_List_Call) RunAndReturn(run func(context.Context) ([]model.Schedule, error)) *Controller_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewController creates a new instance of Controller. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Controller {
+	mock := &Controller{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
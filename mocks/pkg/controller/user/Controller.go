@@ -22,6 +22,115 @@ func (_m *Controller) EXPECT() *Controller_Expecter {
 	return &Controller_Expecter{mock: &_m.Mock}
 }
 
+// GetMailPreference provides a mock function with given fields: ctx
+func (_m *Controller) GetMailPreference(ctx context.Context) (*model.MailPreference, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *model.MailPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*model.MailPreference, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *model.MailPreference); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.MailPreference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Controller_GetMailPreference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMailPreference'
+type Controller_GetMailPreference_Call struct {
+	*mock.Call
+}
+
+// GetMailPreference is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Controller_Expecter) GetMailPreference(ctx interface{}) *Controller_GetMailPreference_Call {
+	return &Controller_GetMailPreference_Call{Call: _e.mock.On("GetMailPreference", ctx)}
+}
+
+func (_c *Controller_GetMailPreference_Call) Run(run func(ctx context.Context)) *Controller_GetMailPreference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Controller_GetMailPreference_Call) Return(_a0 *model.MailPreference, _a1 error) *Controller_GetMailPreference_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Controller_GetMailPreference_Call) RunAndReturn(run func(context.Context) (*model.MailPreference, error)) *Controller_GetMailPreference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, q
+func (_m *Controller) List(ctx context.Context, q model.ListQuery) (*model.ListResult[model.User], error) {
+	ret := _m.Called(ctx, q)
+
+	var r0 *model.ListResult[model.User]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListQuery) (*model.ListResult[model.User], error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListQuery) *model.ListResult[model.User]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ListResult[model.User])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListQuery) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Controller_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type Controller_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q model.ListQuery
+func (_e *Controller_Expecter) List(ctx interface{}, q interface{}) *Controller_List_Call {
+	return &Controller_List_Call{Call: _e.mock.On("List", ctx, q)}
+}
+
+func (_c *Controller_List_Call) Run(run func(ctx context.Context, q model.ListQuery)) *Controller_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.ListQuery))
+	})
+	return _c
+}
+
+func (_c *Controller_List_Call) Return(_a0 *model.ListResult[model.User], _a1 error) *Controller_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Controller_List_Call) RunAndReturn(run func(context.Context, model.ListQuery) (*model.ListResult[model.User], error)) *Controller_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Me provides a mock function with given fields: ctx
 func (_m *Controller) Me(ctx context.Context) (*model.User, error) {
 	ret := _m.Called(ctx)
@@ -76,18 +185,30 @@ func (_c *Controller_Me_Call) RunAndReturn(run func(context.Context) (*model.Use
 	return _c
 }
 
-// SentMail provides a mock function with given fields: ctx
-func (_m *Controller) SentMail(ctx context.Context) error {
-	ret := _m.Called(ctx)
+// SentMail provides a mock function with given fields: ctx, campaign
+func (_m *Controller) SentMail(ctx context.Context, campaign string) (*model.SentMailReport, error) {
+	ret := _m.Called(ctx, campaign)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
-		r0 = rf(ctx)
+	var r0 *model.SentMailReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.SentMailReport, error)); ok {
+		return rf(ctx, campaign)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.SentMailReport); ok {
+		r0 = rf(ctx, campaign)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SentMailReport)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, campaign)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // Controller_SentMail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SentMail'
@@ -97,23 +218,122 @@ type Controller_SentMail_Call struct {
 
 // SentMail is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *Controller_Expecter) SentMail(ctx interface{}) *Controller_SentMail_Call {
-	return &Controller_SentMail_Call{Call: _e.mock.On("SentMail", ctx)}
+//   - campaign string
+func (_e *Controller_Expecter) SentMail(ctx interface{}, campaign interface{}) *Controller_SentMail_Call {
+	return &Controller_SentMail_Call{Call: _e.mock.On("SentMail", ctx, campaign)}
 }
 
-func (_c *Controller_SentMail_Call) Run(run func(ctx context.Context)) *Controller_SentMail_Call {
+func (_c *Controller_SentMail_Call) Run(run func(ctx context.Context, campaign string)) *Controller_SentMail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *Controller_SentMail_Call) Return(_a0 error) *Controller_SentMail_Call {
+func (_c *Controller_SentMail_Call) Return(_a0 *model.SentMailReport, _a1 error) *Controller_SentMail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Controller_SentMail_Call) RunAndReturn(run func(context.Context, string) (*model.SentMailReport, error)) *Controller_SentMail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unsubscribe provides a mock function with given fields: ctx, token
+func (_m *Controller) Unsubscribe(ctx context.Context, token string) error {
+	ret := _m.Called(ctx, token)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Controller_Unsubscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unsubscribe'
+type Controller_Unsubscribe_Call struct {
+	*mock.Call
+}
+
+// Unsubscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *Controller_Expecter) Unsubscribe(ctx interface{}, token interface{}) *Controller_Unsubscribe_Call {
+	return &Controller_Unsubscribe_Call{Call: _e.mock.On("Unsubscribe", ctx, token)}
+}
+
+func (_c *Controller_Unsubscribe_Call) Run(run func(ctx context.Context, token string)) *Controller_Unsubscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Controller_Unsubscribe_Call) Return(_a0 error) *Controller_Unsubscribe_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Controller_SentMail_Call) RunAndReturn(run func(context.Context) error) *Controller_SentMail_Call {
+func (_c *Controller_Unsubscribe_Call) RunAndReturn(run func(context.Context, string) error) *Controller_Unsubscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMailPreference provides a mock function with given fields: ctx, campaigns
+func (_m *Controller) UpdateMailPreference(ctx context.Context, campaigns bool) (*model.MailPreference, error) {
+	ret := _m.Called(ctx, campaigns)
+
+	var r0 *model.MailPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) (*model.MailPreference, error)); ok {
+		return rf(ctx, campaigns)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) *model.MailPreference); ok {
+		r0 = rf(ctx, campaigns)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.MailPreference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, campaigns)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Controller_UpdateMailPreference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMailPreference'
+type Controller_UpdateMailPreference_Call struct {
+	*mock.Call
+}
+
+// UpdateMailPreference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campaigns bool
+func (_e *Controller_Expecter) UpdateMailPreference(ctx interface{}, campaigns interface{}) *Controller_UpdateMailPreference_Call {
+	return &Controller_UpdateMailPreference_Call{Call: _e.mock.On("UpdateMailPreference", ctx, campaigns)}
+}
+
+func (_c *Controller_UpdateMailPreference_Call) Run(run func(ctx context.Context, campaigns bool)) *Controller_UpdateMailPreference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *Controller_UpdateMailPreference_Call) Return(_a0 *model.MailPreference, _a1 error) *Controller_UpdateMailPreference_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Controller_UpdateMailPreference_Call) RunAndReturn(run func(context.Context, bool) (*model.MailPreference, error)) *Controller_UpdateMailPreference_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,82 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+)
+
+// DeadFunc is an autogenerated mock type for the DeadFunc type
+type DeadFunc struct {
+	mock.Mock
+}
+
+type DeadFunc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DeadFunc) EXPECT() *DeadFunc_Expecter {
+	return &DeadFunc_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, j, lastErr
+func (_m *DeadFunc) Execute(ctx context.Context, j model.Job, lastErr string) error {
+	ret := _m.Called(ctx, j, lastErr)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Job, string) error); ok {
+		r0 = rf(ctx, j, lastErr)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeadFunc_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type DeadFunc_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - j model.Job
+//   - lastErr string
+func (_e *DeadFunc_Expecter) Execute(ctx interface{}, j interface{}, lastErr interface{}) *DeadFunc_Execute_Call {
+	return &DeadFunc_Execute_Call{Call: _e.mock.On("Execute", ctx, j, lastErr)}
+}
+
+func (_c *DeadFunc_Execute_Call) Run(run func(ctx context.Context, j model.Job, lastErr string)) *DeadFunc_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Job), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *DeadFunc_Execute_Call) Return(_a0 error) *DeadFunc_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeadFunc_Execute_Call) RunAndReturn(run func(context.Context, model.Job, string) error) *DeadFunc_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDeadFunc creates a new instance of DeadFunc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDeadFunc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DeadFunc {
+	mock := &DeadFunc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,81 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+)
+
+// HandlerFunc is an autogenerated mock type for the HandlerFunc type
+type HandlerFunc struct {
+	mock.Mock
+}
+
+type HandlerFunc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HandlerFunc) EXPECT() *HandlerFunc_Expecter {
+	return &HandlerFunc_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, j
+func (_m *HandlerFunc) Execute(ctx context.Context, j model.Job) error {
+	ret := _m.Called(ctx, j)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Job) error); ok {
+		r0 = rf(ctx, j)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HandlerFunc_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type HandlerFunc_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - j model.Job
+func (_e *HandlerFunc_Expecter) Execute(ctx interface{}, j interface{}) *HandlerFunc_Execute_Call {
+	return &HandlerFunc_Execute_Call{Call: _e.mock.On("Execute", ctx, j)}
+}
+
+func (_c *HandlerFunc_Execute_Call) Run(run func(ctx context.Context, j model.Job)) *HandlerFunc_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Job))
+	})
+	return _c
+}
+
+func (_c *HandlerFunc_Execute_Call) Return(_a0 error) *HandlerFunc_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HandlerFunc_Execute_Call) RunAndReturn(run func(context.Context, model.Job) error) *HandlerFunc_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHandlerFunc creates a new instance of HandlerFunc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHandlerFunc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HandlerFunc {
+	mock := &HandlerFunc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,110 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	jobs "github.com/dwarvesf/go-api/pkg/jobs"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+)
+
+// Queue is an autogenerated mock type for the Queue type
+type Queue struct {
+	mock.Mock
+}
+
+type Queue_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Queue) EXPECT() *Queue_Expecter {
+	return &Queue_Expecter{mock: &_m.Mock}
+}
+
+// Enqueue provides a mock function with given fields: ctx, name, payload, opts
+func (_m *Queue) Enqueue(ctx context.Context, name string, payload interface{}, opts ...jobs.Option) (*model.Job, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, name, payload)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *model.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}, ...jobs.Option) (*model.Job, error)); ok {
+		return rf(ctx, name, payload, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}, ...jobs.Option) *model.Job); ok {
+		r0 = rf(ctx, name, payload, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, interface{}, ...jobs.Option) error); ok {
+		r1 = rf(ctx, name, payload, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Queue_Enqueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enqueue'
+type Queue_Enqueue_Call struct {
+	*mock.Call
+}
+
+// Enqueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - payload interface{}
+//   - opts ...jobs.Option
+func (_e *Queue_Expecter) Enqueue(ctx interface{}, name interface{}, payload interface{}, opts ...interface{}) *Queue_Enqueue_Call {
+	return &Queue_Enqueue_Call{Call: _e.mock.On("Enqueue",
+		append([]interface{}{ctx, name, payload}, opts...)...)}
+}
+
+func (_c *Queue_Enqueue_Call) Run(run func(ctx context.Context, name string, payload interface{}, opts ...jobs.Option)) *Queue_Enqueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]jobs.Option, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(jobs.Option)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Queue_Enqueue_Call) Return(_a0 *model.Job, _a1 error) *Queue_Enqueue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Queue_Enqueue_Call) RunAndReturn(run func(context.Context, string, interface{}, ...jobs.Option) (*model.Job, error)) *Queue_Enqueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewQueue creates a new instance of Queue. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewQueue(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Queue {
+	mock := &Queue{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
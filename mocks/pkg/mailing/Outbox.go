@@ -0,0 +1,95 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	db "github.com/dwarvesf/go-api/pkg/repository/db"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+)
+
+// Outbox is an autogenerated mock type for the Outbox type
+type Outbox struct {
+	mock.Mock
+}
+
+type Outbox_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Outbox) EXPECT() *Outbox_Expecter {
+	return &Outbox_Expecter{mock: &_m.Mock}
+}
+
+// Enqueue provides a mock function with given fields: ctx, to, template, data
+func (_m *Outbox) Enqueue(ctx db.Context, to string, template string, data interface{}) (*model.MailOutboxMessage, error) {
+	ret := _m.Called(ctx, to, template, data)
+
+	var r0 *model.MailOutboxMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, string, string, interface{}) (*model.MailOutboxMessage, error)); ok {
+		return rf(ctx, to, template, data)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, string, string, interface{}) *model.MailOutboxMessage); ok {
+		r0 = rf(ctx, to, template, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.MailOutboxMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, string, string, interface{}) error); ok {
+		r1 = rf(ctx, to, template, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Outbox_Enqueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enqueue'
+type Outbox_Enqueue_Call struct {
+	*mock.Call
+}
+
+// Enqueue is a helper method to define mock.On call
+//   - ctx db.Context
+//   - to string
+//   - template string
+//   - data interface{}
+func (_e *Outbox_Expecter) Enqueue(ctx interface{}, to interface{}, template interface{}, data interface{}) *Outbox_Enqueue_Call {
+	return &Outbox_Enqueue_Call{Call: _e.mock.On("Enqueue", ctx, to, template, data)}
+}
+
+func (_c *Outbox_Enqueue_Call) Run(run func(ctx db.Context, to string, template string, data interface{})) *Outbox_Enqueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(string), args[2].(string), args[3].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Outbox_Enqueue_Call) Return(_a0 *model.MailOutboxMessage, _a1 error) *Outbox_Enqueue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Outbox_Enqueue_Call) RunAndReturn(run func(db.Context, string, string, interface{}) (*model.MailOutboxMessage, error)) *Outbox_Enqueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOutbox creates a new instance of Outbox. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutbox(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Outbox {
+	mock := &Outbox{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,151 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mailing "github.com/dwarvesf/go-api/pkg/mailing"
+	mailer "github.com/dwarvesf/go-api/pkg/service/mailer"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+)
+
+// Sender is an autogenerated mock type for the Sender type
+type Sender struct {
+	mock.Mock
+}
+
+type Sender_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Sender) EXPECT() *Sender_Expecter {
+	return &Sender_Expecter{mock: &_m.Mock}
+}
+
+// Render provides a mock function with given fields: d, u
+func (_m *Sender) Render(d mailing.Delivery, u model.User) (*mailer.Message, error) {
+	ret := _m.Called(d, u)
+
+	var r0 *mailer.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(mailing.Delivery, model.User) (*mailer.Message, error)); ok {
+		return rf(d, u)
+	}
+	if rf, ok := ret.Get(0).(func(mailing.Delivery, model.User) *mailer.Message); ok {
+		r0 = rf(d, u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*mailer.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(mailing.Delivery, model.User) error); ok {
+		r1 = rf(d, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Sender_Render_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Render'
+type Sender_Render_Call struct {
+	*mock.Call
+}
+
+// Render is a helper method to define mock.On call
+//   - d mailing.Delivery
+//   - u model.User
+func (_e *Sender_Expecter) Render(d interface{}, u interface{}) *Sender_Render_Call {
+	return &Sender_Render_Call{Call: _e.mock.On("Render", d, u)}
+}
+
+func (_c *Sender_Render_Call) Run(run func(d mailing.Delivery, u model.User)) *Sender_Render_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(mailing.Delivery), args[1].(model.User))
+	})
+	return _c
+}
+
+func (_c *Sender_Render_Call) Return(_a0 *mailer.Message, _a1 error) *Sender_Render_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Sender_Render_Call) RunAndReturn(run func(mailing.Delivery, model.User) (*mailer.Message, error)) *Sender_Render_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Send provides a mock function with given fields: ctx, d
+func (_m *Sender) Send(ctx context.Context, d mailing.Delivery) (*model.SentMailReport, error) {
+	ret := _m.Called(ctx, d)
+
+	var r0 *model.SentMailReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, mailing.Delivery) (*model.SentMailReport, error)); ok {
+		return rf(ctx, d)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, mailing.Delivery) *model.SentMailReport); ok {
+		r0 = rf(ctx, d)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SentMailReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, mailing.Delivery) error); ok {
+		r1 = rf(ctx, d)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Sender_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type Sender_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - d mailing.Delivery
+func (_e *Sender_Expecter) Send(ctx interface{}, d interface{}) *Sender_Send_Call {
+	return &Sender_Send_Call{Call: _e.mock.On("Send", ctx, d)}
+}
+
+func (_c *Sender_Send_Call) Run(run func(ctx context.Context, d mailing.Delivery)) *Sender_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(mailing.Delivery))
+	})
+	return _c
+}
+
+func (_c *Sender_Send_Call) Return(_a0 *model.SentMailReport, _a1 error) *Sender_Send_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Sender_Send_Call) RunAndReturn(run func(context.Context, mailing.Delivery) (*model.SentMailReport, error)) *Sender_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSender creates a new instance of Sender. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSender(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Sender {
+	mock := &Sender{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,93 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+
+	realtime "github.com/dwarvesf/go-api/pkg/realtime"
+)
+
+// HandlerFunc is an autogenerated mock type for the HandlerFunc type
+type HandlerFunc struct {
+	mock.Mock
+}
+
+type HandlerFunc_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HandlerFunc) EXPECT() *HandlerFunc_Expecter {
+	return &HandlerFunc_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: c, u, msg
+func (_m *HandlerFunc) Execute(c *gin.Context, u realtime.User, msg realtime.Message) (interface{}, error) {
+	ret := _m.Called(c, u, msg)
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context, realtime.User, realtime.Message) (interface{}, error)); ok {
+		return rf(c, u, msg)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context, realtime.User, realtime.Message) interface{}); ok {
+		r0 = rf(c, u, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context, realtime.User, realtime.Message) error); ok {
+		r1 = rf(c, u, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HandlerFunc_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type HandlerFunc_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - c *gin.Context
+//   - u realtime.User
+//   - msg realtime.Message
+func (_e *HandlerFunc_Expecter) Execute(c interface{}, u interface{}, msg interface{}) *HandlerFunc_Execute_Call {
+	return &HandlerFunc_Execute_Call{Call: _e.mock.On("Execute", c, u, msg)}
+}
+
+func (_c *HandlerFunc_Execute_Call) Run(run func(c *gin.Context, u realtime.User, msg realtime.Message)) *HandlerFunc_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context), args[1].(realtime.User), args[2].(realtime.Message))
+	})
+	return _c
+}
+
+func (_c *HandlerFunc_Execute_Call) Return(_a0 interface{}, _a1 error) *HandlerFunc_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HandlerFunc_Execute_Call) RunAndReturn(run func(*gin.Context, realtime.User, realtime.Message) (interface{}, error)) *HandlerFunc_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHandlerFunc creates a new instance of HandlerFunc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHandlerFunc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HandlerFunc {
+	mock := &HandlerFunc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
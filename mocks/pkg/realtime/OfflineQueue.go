@@ -0,0 +1,180 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	realtime "github.com/dwarvesf/go-api/pkg/realtime"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OfflineQueue is an autogenerated mock type for the OfflineQueue type
+type OfflineQueue struct {
+	mock.Mock
+}
+
+type OfflineQueue_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OfflineQueue) EXPECT() *OfflineQueue_Expecter {
+	return &OfflineQueue_Expecter{mock: &_m.Mock}
+}
+
+// Ack provides a mock function with given fields: ctx, userID, msgID
+func (_m *OfflineQueue) Ack(ctx context.Context, userID string, msgID string) error {
+	ret := _m.Called(ctx, userID, msgID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, msgID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OfflineQueue_Ack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ack'
+type OfflineQueue_Ack_Call struct {
+	*mock.Call
+}
+
+// Ack is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - msgID string
+func (_e *OfflineQueue_Expecter) Ack(ctx interface{}, userID interface{}, msgID interface{}) *OfflineQueue_Ack_Call {
+	return &OfflineQueue_Ack_Call{Call: _e.mock.On("Ack", ctx, userID, msgID)}
+}
+
+func (_c *OfflineQueue_Ack_Call) Run(run func(ctx context.Context, userID string, msgID string)) *OfflineQueue_Ack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OfflineQueue_Ack_Call) Return(_a0 error) *OfflineQueue_Ack_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OfflineQueue_Ack_Call) RunAndReturn(run func(context.Context, string, string) error) *OfflineQueue_Ack_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pending provides a mock function with given fields: ctx, userID
+func (_m *OfflineQueue) Pending(ctx context.Context, userID string) ([]realtime.Message, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []realtime.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]realtime.Message, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []realtime.Message); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]realtime.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OfflineQueue_Pending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pending'
+type OfflineQueue_Pending_Call struct {
+	*mock.Call
+}
+
+// Pending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *OfflineQueue_Expecter) Pending(ctx interface{}, userID interface{}) *OfflineQueue_Pending_Call {
+	return &OfflineQueue_Pending_Call{Call: _e.mock.On("Pending", ctx, userID)}
+}
+
+func (_c *OfflineQueue_Pending_Call) Run(run func(ctx context.Context, userID string)) *OfflineQueue_Pending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OfflineQueue_Pending_Call) Return(_a0 []realtime.Message, _a1 error) *OfflineQueue_Pending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OfflineQueue_Pending_Call) RunAndReturn(run func(context.Context, string) ([]realtime.Message, error)) *OfflineQueue_Pending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Push provides a mock function with given fields: ctx, userID, data
+func (_m *OfflineQueue) Push(ctx context.Context, userID string, data interface{}) error {
+	ret := _m.Called(ctx, userID, data)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}) error); ok {
+		r0 = rf(ctx, userID, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OfflineQueue_Push_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Push'
+type OfflineQueue_Push_Call struct {
+	*mock.Call
+}
+
+// Push is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - data interface{}
+func (_e *OfflineQueue_Expecter) Push(ctx interface{}, userID interface{}, data interface{}) *OfflineQueue_Push_Call {
+	return &OfflineQueue_Push_Call{Call: _e.mock.On("Push", ctx, userID, data)}
+}
+
+func (_c *OfflineQueue_Push_Call) Run(run func(ctx context.Context, userID string, data interface{})) *OfflineQueue_Push_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *OfflineQueue_Push_Call) Return(_a0 error) *OfflineQueue_Push_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OfflineQueue_Push_Call) RunAndReturn(run func(context.Context, string, interface{}) error) *OfflineQueue_Push_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOfflineQueue creates a new instance of OfflineQueue. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOfflineQueue(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OfflineQueue {
+	mock := &OfflineQueue{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
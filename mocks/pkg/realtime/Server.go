@@ -3,7 +3,11 @@
 package mocks
 
 import (
+	context "context"
+	json "encoding/json"
+
 	gin "github.com/gin-gonic/gin"
+
 	mock "github.com/stretchr/testify/mock"
 
 	realtime "github.com/dwarvesf/go-api/pkg/realtime"
@@ -106,6 +110,49 @@ func (_c *Server_BroadcastMessage_Call) RunAndReturn(run func(string) error) *Se
 	return _c
 }
 
+// Connections provides a mock function with given fields:
+func (_m *Server) Connections() []realtime.ConnectionInfo {
+	ret := _m.Called()
+
+	var r0 []realtime.ConnectionInfo
+	if rf, ok := ret.Get(0).(func() []realtime.ConnectionInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]realtime.ConnectionInfo)
+		}
+	}
+
+	return r0
+}
+
+// Server_Connections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Connections'
+type Server_Connections_Call struct {
+	*mock.Call
+}
+
+// Connections is a helper method to define mock.On call
+func (_e *Server_Expecter) Connections() *Server_Connections_Call {
+	return &Server_Connections_Call{Call: _e.mock.On("Connections")}
+}
+
+func (_c *Server_Connections_Call) Run(run func()) *Server_Connections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Server_Connections_Call) Return(_a0 []realtime.ConnectionInfo) *Server_Connections_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Server_Connections_Call) RunAndReturn(run func() []realtime.ConnectionInfo) *Server_Connections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DisconnectUser provides a mock function with given fields: u
 func (_m *Server) DisconnectUser(u realtime.User) error {
 	ret := _m.Called(u)
@@ -237,6 +284,116 @@ func (_c *Server_HandleEvent_Call) RunAndReturn(run func(*gin.Context, realtime.
 	return _c
 }
 
+// Request provides a mock function with given fields: ctx, u, method, params
+func (_m *Server) Request(ctx context.Context, u realtime.User, method string, params interface{}) (json.RawMessage, error) {
+	ret := _m.Called(ctx, u, method, params)
+
+	var r0 json.RawMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, realtime.User, string, interface{}) (json.RawMessage, error)); ok {
+		return rf(ctx, u, method, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, realtime.User, string, interface{}) json.RawMessage); ok {
+		r0 = rf(ctx, u, method, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(json.RawMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, realtime.User, string, interface{}) error); ok {
+		r1 = rf(ctx, u, method, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Server_Request_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Request'
+type Server_Request_Call struct {
+	*mock.Call
+}
+
+// Request is a helper method to define mock.On call
+//   - ctx context.Context
+//   - u realtime.User
+//   - method string
+//   - params interface{}
+func (_e *Server_Expecter) Request(ctx interface{}, u interface{}, method interface{}, params interface{}) *Server_Request_Call {
+	return &Server_Request_Call{Call: _e.mock.On("Request", ctx, u, method, params)}
+}
+
+func (_c *Server_Request_Call) Run(run func(ctx context.Context, u realtime.User, method string, params interface{})) *Server_Request_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(realtime.User), args[2].(string), args[3].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Server_Request_Call) Return(_a0 json.RawMessage, _a1 error) *Server_Request_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Server_Request_Call) RunAndReturn(run func(context.Context, realtime.User, string, interface{}) (json.RawMessage, error)) *Server_Request_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendConnected provides a mock function with given fields: userID, data
+func (_m *Server) SendConnected(userID string, data interface{}) (int, error) {
+	ret := _m.Called(userID, data)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, interface{}) (int, error)); ok {
+		return rf(userID, data)
+	}
+	if rf, ok := ret.Get(0).(func(string, interface{}) int); ok {
+		r0 = rf(userID, data)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, interface{}) error); ok {
+		r1 = rf(userID, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Server_SendConnected_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendConnected'
+type Server_SendConnected_Call struct {
+	*mock.Call
+}
+
+// SendConnected is a helper method to define mock.On call
+//   - userID string
+//   - data interface{}
+func (_e *Server_Expecter) SendConnected(userID interface{}, data interface{}) *Server_SendConnected_Call {
+	return &Server_SendConnected_Call{Call: _e.mock.On("SendConnected", userID, data)}
+}
+
+func (_c *Server_SendConnected_Call) Run(run func(userID string, data interface{})) *Server_SendConnected_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Server_SendConnected_Call) Return(_a0 int, _a1 error) *Server_SendConnected_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Server_SendConnected_Call) RunAndReturn(run func(string, interface{}) (int, error)) *Server_SendConnected_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendData provides a mock function with given fields: userID, data
 func (_m *Server) SendData(userID string, data interface{}) error {
 	ret := _m.Called(userID, data)
@@ -280,6 +437,49 @@ func (_c *Server_SendData_Call) RunAndReturn(run func(string, interface{}) error
 	return _c
 }
 
+// SendDeviceData provides a mock function with given fields: u, data
+func (_m *Server) SendDeviceData(u realtime.User, data interface{}) error {
+	ret := _m.Called(u, data)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(realtime.User, interface{}) error); ok {
+		r0 = rf(u, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Server_SendDeviceData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendDeviceData'
+type Server_SendDeviceData_Call struct {
+	*mock.Call
+}
+
+// SendDeviceData is a helper method to define mock.On call
+//   - u realtime.User
+//   - data interface{}
+func (_e *Server_Expecter) SendDeviceData(u interface{}, data interface{}) *Server_SendDeviceData_Call {
+	return &Server_SendDeviceData_Call{Call: _e.mock.On("SendDeviceData", u, data)}
+}
+
+func (_c *Server_SendDeviceData_Call) Run(run func(u realtime.User, data interface{})) *Server_SendDeviceData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(realtime.User), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Server_SendDeviceData_Call) Return(_a0 error) *Server_SendDeviceData_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Server_SendDeviceData_Call) RunAndReturn(run func(realtime.User, interface{}) error) *Server_SendDeviceData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendMessage provides a mock function with given fields: userID, message
 func (_m *Server) SendMessage(userID string, message string) error {
 	ret := _m.Called(userID, message)
@@ -323,6 +523,48 @@ func (_c *Server_SendMessage_Call) RunAndReturn(run func(string, string) error)
 	return _c
 }
 
+// Shutdown provides a mock function with given fields: ctx
+func (_m *Server) Shutdown(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Server_Shutdown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Shutdown'
+type Server_Shutdown_Call struct {
+	*mock.Call
+}
+
+// Shutdown is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Server_Expecter) Shutdown(ctx interface{}) *Server_Shutdown_Call {
+	return &Server_Shutdown_Call{Call: _e.mock.On("Shutdown", ctx)}
+}
+
+func (_c *Server_Shutdown_Call) Run(run func(ctx context.Context)) *Server_Shutdown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Server_Shutdown_Call) Return(_a0 error) *Server_Shutdown_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Server_Shutdown_Call) RunAndReturn(run func(context.Context) error) *Server_Shutdown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewServer creates a new instance of Server. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewServer(t interface {
@@ -0,0 +1,145 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	realtime "github.com/dwarvesf/go-api/pkg/realtime"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TicketStore is an autogenerated mock type for the TicketStore type
+type TicketStore struct {
+	mock.Mock
+}
+
+type TicketStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TicketStore) EXPECT() *TicketStore_Expecter {
+	return &TicketStore_Expecter{mock: &_m.Mock}
+}
+
+// Issue provides a mock function with given fields: ctx, userID
+func (_m *TicketStore) Issue(ctx context.Context, userID int) (*realtime.Ticket, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *realtime.Ticket
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*realtime.Ticket, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *realtime.Ticket); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realtime.Ticket)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TicketStore_Issue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Issue'
+type TicketStore_Issue_Call struct {
+	*mock.Call
+}
+
+// Issue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *TicketStore_Expecter) Issue(ctx interface{}, userID interface{}) *TicketStore_Issue_Call {
+	return &TicketStore_Issue_Call{Call: _e.mock.On("Issue", ctx, userID)}
+}
+
+func (_c *TicketStore_Issue_Call) Run(run func(ctx context.Context, userID int)) *TicketStore_Issue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *TicketStore_Issue_Call) Return(_a0 *realtime.Ticket, _a1 error) *TicketStore_Issue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TicketStore_Issue_Call) RunAndReturn(run func(context.Context, int) (*realtime.Ticket, error)) *TicketStore_Issue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Redeem provides a mock function with given fields: ctx, value
+func (_m *TicketStore) Redeem(ctx context.Context, value string) (int, error) {
+	ret := _m.Called(ctx, value)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, value)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, value)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TicketStore_Redeem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Redeem'
+type TicketStore_Redeem_Call struct {
+	*mock.Call
+}
+
+// Redeem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - value string
+func (_e *TicketStore_Expecter) Redeem(ctx interface{}, value interface{}) *TicketStore_Redeem_Call {
+	return &TicketStore_Redeem_Call{Call: _e.mock.On("Redeem", ctx, value)}
+}
+
+func (_c *TicketStore_Redeem_Call) Run(run func(ctx context.Context, value string)) *TicketStore_Redeem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TicketStore_Redeem_Call) Return(_a0 int, _a1 error) *TicketStore_Redeem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TicketStore_Redeem_Call) RunAndReturn(run func(context.Context, string) (int, error)) *TicketStore_Redeem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTicketStore creates a new instance of TicketStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTicketStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TicketStore {
+	mock := &TicketStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,148 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// codec is an autogenerated mock type for the codec type
+type codec struct {
+	mock.Mock
+}
+
+type codec_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *codec) EXPECT() *codec_Expecter {
+	return &codec_Expecter{mock: &_m.Mock}
+}
+
+// decode provides a mock function with given fields: messageType, data
+func (_m *codec) decode(messageType int, data []byte) ([]byte, error) {
+	ret := _m.Called(messageType, data)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, []byte) ([]byte, error)); ok {
+		return rf(messageType, data)
+	}
+	if rf, ok := ret.Get(0).(func(int, []byte) []byte); ok {
+		r0 = rf(messageType, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, []byte) error); ok {
+		r1 = rf(messageType, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// codec_decode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'decode'
+type codec_decode_Call struct {
+	*mock.Call
+}
+
+// decode is a helper method to define mock.On call
+//   - messageType int
+//   - data []byte
+func (_e *codec_Expecter) decode(messageType interface{}, data interface{}) *codec_decode_Call {
+	return &codec_decode_Call{Call: _e.mock.On("decode", messageType, data)}
+}
+
+func (_c *codec_decode_Call) Run(run func(messageType int, data []byte)) *codec_decode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].([]byte))
+	})
+	return _c
+}
+
+func (_c *codec_decode_Call) Return(_a0 []byte, _a1 error) *codec_decode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *codec_decode_Call) RunAndReturn(run func(int, []byte) ([]byte, error)) *codec_decode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// encode provides a mock function with given fields: v
+func (_m *codec) encode(v interface{}) (int, []byte, error) {
+	ret := _m.Called(v)
+
+	var r0 int
+	var r1 []byte
+	var r2 error
+	if rf, ok := ret.Get(0).(func(interface{}) (int, []byte, error)); ok {
+		return rf(v)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}) int); ok {
+		r0 = rf(v)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}) []byte); ok {
+		r1 = rf(v)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(interface{}) error); ok {
+		r2 = rf(v)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// codec_encode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'encode'
+type codec_encode_Call struct {
+	*mock.Call
+}
+
+// encode is a helper method to define mock.On call
+//   - v interface{}
+func (_e *codec_Expecter) encode(v interface{}) *codec_encode_Call {
+	return &codec_encode_Call{Call: _e.mock.On("encode", v)}
+}
+
+func (_c *codec_encode_Call) Run(run func(v interface{})) *codec_encode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *codec_encode_Call) Return(_a0 int, _a1 []byte, _a2 error) *codec_encode_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *codec_encode_Call) RunAndReturn(run func(interface{}) (int, []byte, error)) *codec_encode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newCodec creates a new instance of codec. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newCodec(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *codec {
+	mock := &codec{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,136 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	boil "github.com/volatiletech/sqlboiler/v4/boil"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Entity is an autogenerated mock type for the Entity type
+type Entity struct {
+	mock.Mock
+}
+
+type Entity_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Entity) EXPECT() *Entity_Expecter {
+	return &Entity_Expecter{mock: &_m.Mock}
+}
+
+// Insert provides a mock function with given fields: ctx, exec, columns
+func (_m *Entity) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	ret := _m.Called(ctx, exec, columns)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, boil.ContextExecutor, boil.Columns) error); ok {
+		r0 = rf(ctx, exec, columns)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Entity_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type Entity_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - exec boil.ContextExecutor
+//   - columns boil.Columns
+func (_e *Entity_Expecter) Insert(ctx interface{}, exec interface{}, columns interface{}) *Entity_Insert_Call {
+	return &Entity_Insert_Call{Call: _e.mock.On("Insert", ctx, exec, columns)}
+}
+
+func (_c *Entity_Insert_Call) Run(run func(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns)) *Entity_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(boil.ContextExecutor), args[2].(boil.Columns))
+	})
+	return _c
+}
+
+func (_c *Entity_Insert_Call) Return(_a0 error) *Entity_Insert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Entity_Insert_Call) RunAndReturn(run func(context.Context, boil.ContextExecutor, boil.Columns) error) *Entity_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, exec, columns
+func (_m *Entity) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	ret := _m.Called(ctx, exec, columns)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, boil.ContextExecutor, boil.Columns) (int64, error)); ok {
+		return rf(ctx, exec, columns)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, boil.ContextExecutor, boil.Columns) int64); ok {
+		r0 = rf(ctx, exec, columns)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, boil.ContextExecutor, boil.Columns) error); ok {
+		r1 = rf(ctx, exec, columns)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Entity_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type Entity_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - exec boil.ContextExecutor
+//   - columns boil.Columns
+func (_e *Entity_Expecter) Update(ctx interface{}, exec interface{}, columns interface{}) *Entity_Update_Call {
+	return &Entity_Update_Call{Call: _e.mock.On("Update", ctx, exec, columns)}
+}
+
+func (_c *Entity_Update_Call) Run(run func(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns)) *Entity_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(boil.ContextExecutor), args[2].(boil.Columns))
+	})
+	return _c
+}
+
+func (_c *Entity_Update_Call) Return(_a0 int64, _a1 error) *Entity_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Entity_Update_Call) RunAndReturn(run func(context.Context, boil.ContextExecutor, boil.Columns) (int64, error)) *Entity_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEntity creates a new instance of Entity. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEntity(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Entity {
+	mock := &Entity{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
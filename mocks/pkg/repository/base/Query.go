@@ -0,0 +1,241 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	boil "github.com/volatiletech/sqlboiler/v4/boil"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Query is an autogenerated mock type for the Query type
+type Query struct {
+	mock.Mock
+}
+
+type Query_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Query) EXPECT() *Query_Expecter {
+	return &Query_Expecter{mock: &_m.Mock}
+}
+
+// Bind provides a mock function with given fields: ctx, exec, obj
+func (_m *Query) Bind(ctx context.Context, exec boil.Executor, obj interface{}) error {
+	ret := _m.Called(ctx, exec, obj)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, boil.Executor, interface{}) error); ok {
+		r0 = rf(ctx, exec, obj)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Query_Bind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Bind'
+type Query_Bind_Call struct {
+	*mock.Call
+}
+
+// Bind is a helper method to define mock.On call
+//   - ctx context.Context
+//   - exec boil.Executor
+//   - obj interface{}
+func (_e *Query_Expecter) Bind(ctx interface{}, exec interface{}, obj interface{}) *Query_Bind_Call {
+	return &Query_Bind_Call{Call: _e.mock.On("Bind", ctx, exec, obj)}
+}
+
+func (_c *Query_Bind_Call) Run(run func(ctx context.Context, exec boil.Executor, obj interface{})) *Query_Bind_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(boil.Executor), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Query_Bind_Call) Return(_a0 error) *Query_Bind_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Query_Bind_Call) RunAndReturn(run func(context.Context, boil.Executor, interface{}) error) *Query_Bind_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Count provides a mock function with given fields: ctx, exec
+func (_m *Query) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	ret := _m.Called(ctx, exec)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, boil.ContextExecutor) (int64, error)); ok {
+		return rf(ctx, exec)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, boil.ContextExecutor) int64); ok {
+		r0 = rf(ctx, exec)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, boil.ContextExecutor) error); ok {
+		r1 = rf(ctx, exec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Query_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type Query_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - ctx context.Context
+//   - exec boil.ContextExecutor
+func (_e *Query_Expecter) Count(ctx interface{}, exec interface{}) *Query_Count_Call {
+	return &Query_Count_Call{Call: _e.mock.On("Count", ctx, exec)}
+}
+
+func (_c *Query_Count_Call) Run(run func(ctx context.Context, exec boil.ContextExecutor)) *Query_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(boil.ContextExecutor))
+	})
+	return _c
+}
+
+func (_c *Query_Count_Call) Return(_a0 int64, _a1 error) *Query_Count_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Query_Count_Call) RunAndReturn(run func(context.Context, boil.ContextExecutor) (int64, error)) *Query_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAll provides a mock function with given fields: ctx, exec
+func (_m *Query) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	ret := _m.Called(ctx, exec)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, boil.ContextExecutor) (int64, error)); ok {
+		return rf(ctx, exec)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, boil.ContextExecutor) int64); ok {
+		r0 = rf(ctx, exec)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, boil.ContextExecutor) error); ok {
+		r1 = rf(ctx, exec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Query_DeleteAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAll'
+type Query_DeleteAll_Call struct {
+	*mock.Call
+}
+
+// DeleteAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - exec boil.ContextExecutor
+func (_e *Query_Expecter) DeleteAll(ctx interface{}, exec interface{}) *Query_DeleteAll_Call {
+	return &Query_DeleteAll_Call{Call: _e.mock.On("DeleteAll", ctx, exec)}
+}
+
+func (_c *Query_DeleteAll_Call) Run(run func(ctx context.Context, exec boil.ContextExecutor)) *Query_DeleteAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(boil.ContextExecutor))
+	})
+	return _c
+}
+
+func (_c *Query_DeleteAll_Call) Return(_a0 int64, _a1 error) *Query_DeleteAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Query_DeleteAll_Call) RunAndReturn(run func(context.Context, boil.ContextExecutor) (int64, error)) *Query_DeleteAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exists provides a mock function with given fields: ctx, exec
+func (_m *Query) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	ret := _m.Called(ctx, exec)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, boil.ContextExecutor) (bool, error)); ok {
+		return rf(ctx, exec)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, boil.ContextExecutor) bool); ok {
+		r0 = rf(ctx, exec)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, boil.ContextExecutor) error); ok {
+		r1 = rf(ctx, exec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Query_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type Query_Exists_Call struct {
+	*mock.Call
+}
+
+// Exists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - exec boil.ContextExecutor
+func (_e *Query_Expecter) Exists(ctx interface{}, exec interface{}) *Query_Exists_Call {
+	return &Query_Exists_Call{Call: _e.mock.On("Exists", ctx, exec)}
+}
+
+func (_c *Query_Exists_Call) Run(run func(ctx context.Context, exec boil.ContextExecutor)) *Query_Exists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(boil.ContextExecutor))
+	})
+	return _c
+}
+
+func (_c *Query_Exists_Call) Return(_a0 bool, _a1 error) *Query_Exists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Query_Exists_Call) RunAndReturn(run func(context.Context, boil.ContextExecutor) (bool, error)) *Query_Exists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewQuery creates a new instance of Query. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewQuery(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Query {
+	mock := &Query{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,544 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	db "github.com/dwarvesf/go-api/pkg/repository/db"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+
+	time "time"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+type Repo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Repo) EXPECT() *Repo_Expecter {
+	return &Repo_Expecter{mock: &_m.Mock}
+}
+
+// ClaimDue provides a mock function with given fields: ctx, now
+func (_m *Repo) ClaimDue(ctx db.Context, now time.Time) ([]model.Campaign, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 []model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, time.Time) ([]model.Campaign, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, time.Time) []model.Campaign); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_ClaimDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDue'
+type Repo_ClaimDue_Call struct {
+	*mock.Call
+}
+
+// ClaimDue is a helper method to define mock.On call
+//   - ctx db.Context
+//   - now time.Time
+func (_e *Repo_Expecter) ClaimDue(ctx interface{}, now interface{}) *Repo_ClaimDue_Call {
+	return &Repo_ClaimDue_Call{Call: _e.mock.On("ClaimDue", ctx, now)}
+}
+
+func (_c *Repo_ClaimDue_Call) Run(run func(ctx db.Context, now time.Time)) *Repo_ClaimDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repo_ClaimDue_Call) Return(_a0 []model.Campaign, _a1 error) *Repo_ClaimDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_ClaimDue_Call) RunAndReturn(run func(db.Context, time.Time) ([]model.Campaign, error)) *Repo_ClaimDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *Repo) Create(ctx db.Context, req model.CampaignRequest) (*model.Campaign, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, model.CampaignRequest) (*model.Campaign, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, model.CampaignRequest) *model.Campaign); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, model.CampaignRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type Repo_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx db.Context
+//   - req model.CampaignRequest
+func (_e *Repo_Expecter) Create(ctx interface{}, req interface{}) *Repo_Create_Call {
+	return &Repo_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *Repo_Create_Call) Run(run func(ctx db.Context, req model.CampaignRequest)) *Repo_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(model.CampaignRequest))
+	})
+	return _c
+}
+
+func (_c *Repo_Create_Call) Return(_a0 *model.Campaign, _a1 error) *Repo_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_Create_Call) RunAndReturn(run func(db.Context, model.CampaignRequest) (*model.Campaign, error)) *Repo_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fail provides a mock function with given fields: ctx, id, now
+func (_m *Repo) Fail(ctx db.Context, id int, now time.Time) error {
+	ret := _m.Called(ctx, id, now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, int, time.Time) error); ok {
+		r0 = rf(ctx, id, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_Fail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fail'
+type Repo_Fail_Call struct {
+	*mock.Call
+}
+
+// Fail is a helper method to define mock.On call
+//   - ctx db.Context
+//   - id int
+//   - now time.Time
+func (_e *Repo_Expecter) Fail(ctx interface{}, id interface{}, now interface{}) *Repo_Fail_Call {
+	return &Repo_Fail_Call{Call: _e.mock.On("Fail", ctx, id, now)}
+}
+
+func (_c *Repo_Fail_Call) Run(run func(ctx db.Context, id int, now time.Time)) *Repo_Fail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repo_Fail_Call) Return(_a0 error) *Repo_Fail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_Fail_Call) RunAndReturn(run func(db.Context, int, time.Time) error) *Repo_Fail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Finish provides a mock function with given fields: ctx, id, now
+func (_m *Repo) Finish(ctx db.Context, id int, now time.Time) error {
+	ret := _m.Called(ctx, id, now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, int, time.Time) error); ok {
+		r0 = rf(ctx, id, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_Finish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Finish'
+type Repo_Finish_Call struct {
+	*mock.Call
+}
+
+// Finish is a helper method to define mock.On call
+//   - ctx db.Context
+//   - id int
+//   - now time.Time
+func (_e *Repo_Expecter) Finish(ctx interface{}, id interface{}, now interface{}) *Repo_Finish_Call {
+	return &Repo_Finish_Call{Call: _e.mock.On("Finish", ctx, id, now)}
+}
+
+func (_c *Repo_Finish_Call) Run(run func(ctx db.Context, id int, now time.Time)) *Repo_Finish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repo_Finish_Call) Return(_a0 error) *Repo_Finish_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_Finish_Call) RunAndReturn(run func(db.Context, int, time.Time) error) *Repo_Finish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *Repo) GetByID(ctx db.Context, id int) (*model.Campaign, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, int) (*model.Campaign, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, int) *model.Campaign); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type Repo_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx db.Context
+//   - id int
+func (_e *Repo_Expecter) GetByID(ctx interface{}, id interface{}) *Repo_GetByID_Call {
+	return &Repo_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *Repo_GetByID_Call) Run(run func(ctx db.Context, id int)) *Repo_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Repo_GetByID_Call) Return(_a0 *model.Campaign, _a1 error) *Repo_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_GetByID_Call) RunAndReturn(run func(db.Context, int) (*model.Campaign, error)) *Repo_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetList provides a mock function with given fields: ctx
+func (_m *Repo) GetList(ctx db.Context) ([]model.Campaign, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context) ([]model.Campaign, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context) []model.Campaign); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_GetList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetList'
+type Repo_GetList_Call struct {
+	*mock.Call
+}
+
+// GetList is a helper method to define mock.On call
+//   - ctx db.Context
+func (_e *Repo_Expecter) GetList(ctx interface{}) *Repo_GetList_Call {
+	return &Repo_GetList_Call{Call: _e.mock.On("GetList", ctx)}
+}
+
+func (_c *Repo_GetList_Call) Run(run func(ctx db.Context)) *Repo_GetList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context))
+	})
+	return _c
+}
+
+func (_c *Repo_GetList_Call) Return(_a0 []model.Campaign, _a1 error) *Repo_GetList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_GetList_Call) RunAndReturn(run func(db.Context) ([]model.Campaign, error)) *Repo_GetList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetListByStatus provides a mock function with given fields: ctx, status
+func (_m *Repo) GetListByStatus(ctx db.Context, status model.CampaignStatus) ([]model.Campaign, error) {
+	ret := _m.Called(ctx, status)
+
+	var r0 []model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, model.CampaignStatus) ([]model.Campaign, error)); ok {
+		return rf(ctx, status)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, model.CampaignStatus) []model.Campaign); ok {
+		r0 = rf(ctx, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, model.CampaignStatus) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_GetListByStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListByStatus'
+type Repo_GetListByStatus_Call struct {
+	*mock.Call
+}
+
+// GetListByStatus is a helper method to define mock.On call
+//   - ctx db.Context
+//   - status model.CampaignStatus
+func (_e *Repo_Expecter) GetListByStatus(ctx interface{}, status interface{}) *Repo_GetListByStatus_Call {
+	return &Repo_GetListByStatus_Call{Call: _e.mock.On("GetListByStatus", ctx, status)}
+}
+
+func (_c *Repo_GetListByStatus_Call) Run(run func(ctx db.Context, status model.CampaignStatus)) *Repo_GetListByStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(model.CampaignStatus))
+	})
+	return _c
+}
+
+func (_c *Repo_GetListByStatus_Call) Return(_a0 []model.Campaign, _a1 error) *Repo_GetListByStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_GetListByStatus_Call) RunAndReturn(run func(db.Context, model.CampaignStatus) ([]model.Campaign, error)) *Repo_GetListByStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Schedule provides a mock function with given fields: ctx, id, at
+func (_m *Repo) Schedule(ctx db.Context, id int, at time.Time) error {
+	ret := _m.Called(ctx, id, at)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, int, time.Time) error); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_Schedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Schedule'
+type Repo_Schedule_Call struct {
+	*mock.Call
+}
+
+// Schedule is a helper method to define mock.On call
+//   - ctx db.Context
+//   - id int
+//   - at time.Time
+func (_e *Repo_Expecter) Schedule(ctx interface{}, id interface{}, at interface{}) *Repo_Schedule_Call {
+	return &Repo_Schedule_Call{Call: _e.mock.On("Schedule", ctx, id, at)}
+}
+
+func (_c *Repo_Schedule_Call) Run(run func(ctx db.Context, id int, at time.Time)) *Repo_Schedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repo_Schedule_Call) Return(_a0 error) *Repo_Schedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_Schedule_Call) RunAndReturn(run func(db.Context, int, time.Time) error) *Repo_Schedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unschedule provides a mock function with given fields: ctx, id
+func (_m *Repo) Unschedule(ctx db.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_Unschedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unschedule'
+type Repo_Unschedule_Call struct {
+	*mock.Call
+}
+
+// Unschedule is a helper method to define mock.On call
+//   - ctx db.Context
+//   - id int
+func (_e *Repo_Expecter) Unschedule(ctx interface{}, id interface{}) *Repo_Unschedule_Call {
+	return &Repo_Unschedule_Call{Call: _e.mock.On("Unschedule", ctx, id)}
+}
+
+func (_c *Repo_Unschedule_Call) Run(run func(ctx db.Context, id int)) *Repo_Unschedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Repo_Unschedule_Call) Return(_a0 error) *Repo_Unschedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_Unschedule_Call) RunAndReturn(run func(db.Context, int) error) *Repo_Unschedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *Repo) Update(ctx db.Context, id int, req model.CampaignRequest) (*model.Campaign, error) {
+	ret := _m.Called(ctx, id, req)
+
+	var r0 *model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, int, model.CampaignRequest) (*model.Campaign, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, int, model.CampaignRequest) *model.Campaign); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, int, model.CampaignRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type Repo_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx db.Context
+//   - id int
+//   - req model.CampaignRequest
+func (_e *Repo_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *Repo_Update_Call {
+	return &Repo_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *Repo_Update_Call) Run(run func(ctx db.Context, id int, req model.CampaignRequest)) *Repo_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int), args[2].(model.CampaignRequest))
+	})
+	return _c
+}
+
+func (_c *Repo_Update_Call) Return(_a0 *model.Campaign, _a1 error) *Repo_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_Update_Call) RunAndReturn(run func(db.Context, int, model.CampaignRequest) (*model.Campaign, error)) *Repo_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,443 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	db "github.com/dwarvesf/go-api/pkg/repository/db"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+
+	time "time"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+type Repo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Repo) EXPECT() *Repo_Expecter {
+	return &Repo_Expecter{mock: &_m.Mock}
+}
+
+// Claim provides a mock function with given fields: ctx, workerID, names, now
+func (_m *Repo) Claim(ctx db.Context, workerID string, names []string, now time.Time) (*model.Job, error) {
+	ret := _m.Called(ctx, workerID, names, now)
+
+	var r0 *model.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, string, []string, time.Time) (*model.Job, error)); ok {
+		return rf(ctx, workerID, names, now)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, string, []string, time.Time) *model.Job); ok {
+		r0 = rf(ctx, workerID, names, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, string, []string, time.Time) error); ok {
+		r1 = rf(ctx, workerID, names, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_Claim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Claim'
+type Repo_Claim_Call struct {
+	*mock.Call
+}
+
+// Claim is a helper method to define mock.On call
+//   - ctx db.Context
+//   - workerID string
+//   - names []string
+//   - now time.Time
+func (_e *Repo_Expecter) Claim(ctx interface{}, workerID interface{}, names interface{}, now interface{}) *Repo_Claim_Call {
+	return &Repo_Claim_Call{Call: _e.mock.On("Claim", ctx, workerID, names, now)}
+}
+
+func (_c *Repo_Claim_Call) Run(run func(ctx db.Context, workerID string, names []string, now time.Time)) *Repo_Claim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(string), args[2].([]string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repo_Claim_Call) Return(_a0 *model.Job, _a1 error) *Repo_Claim_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_Claim_Call) RunAndReturn(run func(db.Context, string, []string, time.Time) (*model.Job, error)) *Repo_Claim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Complete provides a mock function with given fields: ctx, id, workerID, now
+func (_m *Repo) Complete(ctx db.Context, id int64, workerID string, now time.Time) error {
+	ret := _m.Called(ctx, id, workerID, now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, int64, string, time.Time) error); ok {
+		r0 = rf(ctx, id, workerID, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type Repo_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - ctx db.Context
+//   - id int64
+//   - workerID string
+//   - now time.Time
+func (_e *Repo_Expecter) Complete(ctx interface{}, id interface{}, workerID interface{}, now interface{}) *Repo_Complete_Call {
+	return &Repo_Complete_Call{Call: _e.mock.On("Complete", ctx, id, workerID, now)}
+}
+
+func (_c *Repo_Complete_Call) Run(run func(ctx db.Context, id int64, workerID string, now time.Time)) *Repo_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int64), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repo_Complete_Call) Return(_a0 error) *Repo_Complete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_Complete_Call) RunAndReturn(run func(db.Context, int64, string, time.Time) error) *Repo_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *Repo) Create(ctx db.Context, _a1 model.Job) (*model.Job, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *model.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, model.Job) (*model.Job, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, model.Job) *model.Job); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, model.Job) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type Repo_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx db.Context
+//   - _a1 model.Job
+func (_e *Repo_Expecter) Create(ctx interface{}, _a1 interface{}) *Repo_Create_Call {
+	return &Repo_Create_Call{Call: _e.mock.On("Create", ctx, _a1)}
+}
+
+func (_c *Repo_Create_Call) Run(run func(ctx db.Context, _a1 model.Job)) *Repo_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(model.Job))
+	})
+	return _c
+}
+
+func (_c *Repo_Create_Call) Return(_a0 *model.Job, _a1 error) *Repo_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_Create_Call) RunAndReturn(run func(db.Context, model.Job) (*model.Job, error)) *Repo_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fail provides a mock function with given fields: ctx, id, workerID, now, lastErr
+func (_m *Repo) Fail(ctx db.Context, id int64, workerID string, now time.Time, lastErr string) error {
+	ret := _m.Called(ctx, id, workerID, now, lastErr)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, int64, string, time.Time, string) error); ok {
+		r0 = rf(ctx, id, workerID, now, lastErr)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_Fail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fail'
+type Repo_Fail_Call struct {
+	*mock.Call
+}
+
+// Fail is a helper method to define mock.On call
+//   - ctx db.Context
+//   - id int64
+//   - workerID string
+//   - now time.Time
+//   - lastErr string
+func (_e *Repo_Expecter) Fail(ctx interface{}, id interface{}, workerID interface{}, now interface{}, lastErr interface{}) *Repo_Fail_Call {
+	return &Repo_Fail_Call{Call: _e.mock.On("Fail", ctx, id, workerID, now, lastErr)}
+}
+
+func (_c *Repo_Fail_Call) Run(run func(ctx db.Context, id int64, workerID string, now time.Time, lastErr string)) *Repo_Fail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int64), args[2].(string), args[3].(time.Time), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *Repo_Fail_Call) Return(_a0 error) *Repo_Fail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_Fail_Call) RunAndReturn(run func(db.Context, int64, string, time.Time, string) error) *Repo_Fail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *Repo) GetByID(ctx db.Context, id int64) (*model.Job, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, int64) (*model.Job, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, int64) *model.Job); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type Repo_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx db.Context
+//   - id int64
+func (_e *Repo_Expecter) GetByID(ctx interface{}, id interface{}) *Repo_GetByID_Call {
+	return &Repo_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *Repo_GetByID_Call) Run(run func(ctx db.Context, id int64)) *Repo_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Repo_GetByID_Call) Return(_a0 *model.Job, _a1 error) *Repo_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_GetByID_Call) RunAndReturn(run func(db.Context, int64) (*model.Job, error)) *Repo_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Heartbeat provides a mock function with given fields: ctx, id, workerID, now
+func (_m *Repo) Heartbeat(ctx db.Context, id int64, workerID string, now time.Time) error {
+	ret := _m.Called(ctx, id, workerID, now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, int64, string, time.Time) error); ok {
+		r0 = rf(ctx, id, workerID, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_Heartbeat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Heartbeat'
+type Repo_Heartbeat_Call struct {
+	*mock.Call
+}
+
+// Heartbeat is a helper method to define mock.On call
+//   - ctx db.Context
+//   - id int64
+//   - workerID string
+//   - now time.Time
+func (_e *Repo_Expecter) Heartbeat(ctx interface{}, id interface{}, workerID interface{}, now interface{}) *Repo_Heartbeat_Call {
+	return &Repo_Heartbeat_Call{Call: _e.mock.On("Heartbeat", ctx, id, workerID, now)}
+}
+
+func (_c *Repo_Heartbeat_Call) Run(run func(ctx db.Context, id int64, workerID string, now time.Time)) *Repo_Heartbeat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int64), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repo_Heartbeat_Call) Return(_a0 error) *Repo_Heartbeat_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_Heartbeat_Call) RunAndReturn(run func(db.Context, int64, string, time.Time) error) *Repo_Heartbeat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseStale provides a mock function with given fields: ctx, lockedBefore
+func (_m *Repo) ReleaseStale(ctx db.Context, lockedBefore time.Time) (int64, error) {
+	ret := _m.Called(ctx, lockedBefore)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, lockedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, time.Time) int64); ok {
+		r0 = rf(ctx, lockedBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, time.Time) error); ok {
+		r1 = rf(ctx, lockedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_ReleaseStale_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseStale'
+type Repo_ReleaseStale_Call struct {
+	*mock.Call
+}
+
+// ReleaseStale is a helper method to define mock.On call
+//   - ctx db.Context
+//   - lockedBefore time.Time
+func (_e *Repo_Expecter) ReleaseStale(ctx interface{}, lockedBefore interface{}) *Repo_ReleaseStale_Call {
+	return &Repo_ReleaseStale_Call{Call: _e.mock.On("ReleaseStale", ctx, lockedBefore)}
+}
+
+func (_c *Repo_ReleaseStale_Call) Run(run func(ctx db.Context, lockedBefore time.Time)) *Repo_ReleaseStale_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repo_ReleaseStale_Call) Return(_a0 int64, _a1 error) *Repo_ReleaseStale_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_ReleaseStale_Call) RunAndReturn(run func(db.Context, time.Time) (int64, error)) *Repo_ReleaseStale_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Retry provides a mock function with given fields: ctx, id, workerID, now, runAt, lastErr
+func (_m *Repo) Retry(ctx db.Context, id int64, workerID string, now time.Time, runAt time.Time, lastErr string) error {
+	ret := _m.Called(ctx, id, workerID, now, runAt, lastErr)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, int64, string, time.Time, time.Time, string) error); ok {
+		r0 = rf(ctx, id, workerID, now, runAt, lastErr)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_Retry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retry'
+type Repo_Retry_Call struct {
+	*mock.Call
+}
+
+// Retry is a helper method to define mock.On call
+//   - ctx db.Context
+//   - id int64
+//   - workerID string
+//   - now time.Time
+//   - runAt time.Time
+//   - lastErr string
+func (_e *Repo_Expecter) Retry(ctx interface{}, id interface{}, workerID interface{}, now interface{}, runAt interface{}, lastErr interface{}) *Repo_Retry_Call {
+	return &Repo_Retry_Call{Call: _e.mock.On("Retry", ctx, id, workerID, now, runAt, lastErr)}
+}
+
+func (_c *Repo_Retry_Call) Run(run func(ctx db.Context, id int64, workerID string, now time.Time, runAt time.Time, lastErr string)) *Repo_Retry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int64), args[2].(string), args[3].(time.Time), args[4].(time.Time), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *Repo_Retry_Call) Return(_a0 error) *Repo_Retry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_Retry_Call) RunAndReturn(run func(db.Context, int64, string, time.Time, time.Time, string) error) *Repo_Retry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,235 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	db "github.com/dwarvesf/go-api/pkg/repository/db"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+type Repo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Repo) EXPECT() *Repo_Expecter {
+	return &Repo_Expecter{mock: &_m.Mock}
+}
+
+// GetCheckpoint provides a mock function with given fields: ctx, campaign
+func (_m *Repo) GetCheckpoint(ctx db.Context, campaign string) (*model.MailCheckpoint, error) {
+	ret := _m.Called(ctx, campaign)
+
+	var r0 *model.MailCheckpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, string) (*model.MailCheckpoint, error)); ok {
+		return rf(ctx, campaign)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, string) *model.MailCheckpoint); ok {
+		r0 = rf(ctx, campaign)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.MailCheckpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, string) error); ok {
+		r1 = rf(ctx, campaign)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_GetCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCheckpoint'
+type Repo_GetCheckpoint_Call struct {
+	*mock.Call
+}
+
+// GetCheckpoint is a helper method to define mock.On call
+//   - ctx db.Context
+//   - campaign string
+func (_e *Repo_Expecter) GetCheckpoint(ctx interface{}, campaign interface{}) *Repo_GetCheckpoint_Call {
+	return &Repo_GetCheckpoint_Call{Call: _e.mock.On("GetCheckpoint", ctx, campaign)}
+}
+
+func (_c *Repo_GetCheckpoint_Call) Run(run func(ctx db.Context, campaign string)) *Repo_GetCheckpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Repo_GetCheckpoint_Call) Return(_a0 *model.MailCheckpoint, _a1 error) *Repo_GetCheckpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_GetCheckpoint_Call) RunAndReturn(run func(db.Context, string) (*model.MailCheckpoint, error)) *Repo_GetCheckpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSentUserIDs provides a mock function with given fields: ctx, campaign, userIDs
+func (_m *Repo) GetSentUserIDs(ctx db.Context, campaign string, userIDs []int) ([]int, error) {
+	ret := _m.Called(ctx, campaign, userIDs)
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, string, []int) ([]int, error)); ok {
+		return rf(ctx, campaign, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, string, []int) []int); ok {
+		r0 = rf(ctx, campaign, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, string, []int) error); ok {
+		r1 = rf(ctx, campaign, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_GetSentUserIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSentUserIDs'
+type Repo_GetSentUserIDs_Call struct {
+	*mock.Call
+}
+
+// GetSentUserIDs is a helper method to define mock.On call
+//   - ctx db.Context
+//   - campaign string
+//   - userIDs []int
+func (_e *Repo_Expecter) GetSentUserIDs(ctx interface{}, campaign interface{}, userIDs interface{}) *Repo_GetSentUserIDs_Call {
+	return &Repo_GetSentUserIDs_Call{Call: _e.mock.On("GetSentUserIDs", ctx, campaign, userIDs)}
+}
+
+func (_c *Repo_GetSentUserIDs_Call) Run(run func(ctx db.Context, campaign string, userIDs []int)) *Repo_GetSentUserIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(string), args[2].([]int))
+	})
+	return _c
+}
+
+func (_c *Repo_GetSentUserIDs_Call) Return(_a0 []int, _a1 error) *Repo_GetSentUserIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_GetSentUserIDs_Call) RunAndReturn(run func(db.Context, string, []int) ([]int, error)) *Repo_GetSentUserIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: ctx, d
+func (_m *Repo) Record(ctx db.Context, d model.MailDelivery) error {
+	ret := _m.Called(ctx, d)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, model.MailDelivery) error); ok {
+		r0 = rf(ctx, d)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type Repo_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - ctx db.Context
+//   - d model.MailDelivery
+func (_e *Repo_Expecter) Record(ctx interface{}, d interface{}) *Repo_Record_Call {
+	return &Repo_Record_Call{Call: _e.mock.On("Record", ctx, d)}
+}
+
+func (_c *Repo_Record_Call) Run(run func(ctx db.Context, d model.MailDelivery)) *Repo_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(model.MailDelivery))
+	})
+	return _c
+}
+
+func (_c *Repo_Record_Call) Return(_a0 error) *Repo_Record_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_Record_Call) RunAndReturn(run func(db.Context, model.MailDelivery) error) *Repo_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveCheckpoint provides a mock function with given fields: ctx, cp
+func (_m *Repo) SaveCheckpoint(ctx db.Context, cp model.MailCheckpoint) error {
+	ret := _m.Called(ctx, cp)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, model.MailCheckpoint) error); ok {
+		r0 = rf(ctx, cp)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_SaveCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveCheckpoint'
+type Repo_SaveCheckpoint_Call struct {
+	*mock.Call
+}
+
+// SaveCheckpoint is a helper method to define mock.On call
+//   - ctx db.Context
+//   - cp model.MailCheckpoint
+func (_e *Repo_Expecter) SaveCheckpoint(ctx interface{}, cp interface{}) *Repo_SaveCheckpoint_Call {
+	return &Repo_SaveCheckpoint_Call{Call: _e.mock.On("SaveCheckpoint", ctx, cp)}
+}
+
+func (_c *Repo_SaveCheckpoint_Call) Run(run func(ctx db.Context, cp model.MailCheckpoint)) *Repo_SaveCheckpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(model.MailCheckpoint))
+	})
+	return _c
+}
+
+func (_c *Repo_SaveCheckpoint_Call) Return(_a0 error) *Repo_SaveCheckpoint_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_SaveCheckpoint_Call) RunAndReturn(run func(db.Context, model.MailCheckpoint) error) *Repo_SaveCheckpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
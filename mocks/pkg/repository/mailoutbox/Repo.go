@@ -0,0 +1,400 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	db "github.com/dwarvesf/go-api/pkg/repository/db"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+
+	time "time"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+type Repo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Repo) EXPECT() *Repo_Expecter {
+	return &Repo_Expecter{mock: &_m.Mock}
+}
+
+// Claim provides a mock function with given fields: ctx, dispatcherID, now, lockedBefore
+func (_m *Repo) Claim(ctx db.Context, dispatcherID string, now time.Time, lockedBefore time.Time) (*model.MailOutboxMessage, error) {
+	ret := _m.Called(ctx, dispatcherID, now, lockedBefore)
+
+	var r0 *model.MailOutboxMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, string, time.Time, time.Time) (*model.MailOutboxMessage, error)); ok {
+		return rf(ctx, dispatcherID, now, lockedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, string, time.Time, time.Time) *model.MailOutboxMessage); ok {
+		r0 = rf(ctx, dispatcherID, now, lockedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.MailOutboxMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, dispatcherID, now, lockedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_Claim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Claim'
+type Repo_Claim_Call struct {
+	*mock.Call
+}
+
+// Claim is a helper method to define mock.On call
+//   - ctx db.Context
+//   - dispatcherID string
+//   - now time.Time
+//   - lockedBefore time.Time
+func (_e *Repo_Expecter) Claim(ctx interface{}, dispatcherID interface{}, now interface{}, lockedBefore interface{}) *Repo_Claim_Call {
+	return &Repo_Claim_Call{Call: _e.mock.On("Claim", ctx, dispatcherID, now, lockedBefore)}
+}
+
+func (_c *Repo_Claim_Call) Run(run func(ctx db.Context, dispatcherID string, now time.Time, lockedBefore time.Time)) *Repo_Claim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(string), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repo_Claim_Call) Return(_a0 *model.MailOutboxMessage, _a1 error) *Repo_Claim_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_Claim_Call) RunAndReturn(run func(db.Context, string, time.Time, time.Time) (*model.MailOutboxMessage, error)) *Repo_Claim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, m
+func (_m *Repo) Create(ctx db.Context, m model.MailOutboxMessage) (*model.MailOutboxMessage, error) {
+	ret := _m.Called(ctx, m)
+
+	var r0 *model.MailOutboxMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, model.MailOutboxMessage) (*model.MailOutboxMessage, error)); ok {
+		return rf(ctx, m)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, model.MailOutboxMessage) *model.MailOutboxMessage); ok {
+		r0 = rf(ctx, m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.MailOutboxMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, model.MailOutboxMessage) error); ok {
+		r1 = rf(ctx, m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type Repo_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx db.Context
+//   - m model.MailOutboxMessage
+func (_e *Repo_Expecter) Create(ctx interface{}, m interface{}) *Repo_Create_Call {
+	return &Repo_Create_Call{Call: _e.mock.On("Create", ctx, m)}
+}
+
+func (_c *Repo_Create_Call) Run(run func(ctx db.Context, m model.MailOutboxMessage)) *Repo_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(model.MailOutboxMessage))
+	})
+	return _c
+}
+
+func (_c *Repo_Create_Call) Return(_a0 *model.MailOutboxMessage, _a1 error) *Repo_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_Create_Call) RunAndReturn(run func(db.Context, model.MailOutboxMessage) (*model.MailOutboxMessage, error)) *Repo_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fail provides a mock function with given fields: ctx, id, dispatcherID, now, lastErr
+func (_m *Repo) Fail(ctx db.Context, id int64, dispatcherID string, now time.Time, lastErr string) error {
+	ret := _m.Called(ctx, id, dispatcherID, now, lastErr)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, int64, string, time.Time, string) error); ok {
+		r0 = rf(ctx, id, dispatcherID, now, lastErr)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_Fail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fail'
+type Repo_Fail_Call struct {
+	*mock.Call
+}
+
+// Fail is a helper method to define mock.On call
+//   - ctx db.Context
+//   - id int64
+//   - dispatcherID string
+//   - now time.Time
+//   - lastErr string
+func (_e *Repo_Expecter) Fail(ctx interface{}, id interface{}, dispatcherID interface{}, now interface{}, lastErr interface{}) *Repo_Fail_Call {
+	return &Repo_Fail_Call{Call: _e.mock.On("Fail", ctx, id, dispatcherID, now, lastErr)}
+}
+
+func (_c *Repo_Fail_Call) Run(run func(ctx db.Context, id int64, dispatcherID string, now time.Time, lastErr string)) *Repo_Fail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int64), args[2].(string), args[3].(time.Time), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *Repo_Fail_Call) Return(_a0 error) *Repo_Fail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_Fail_Call) RunAndReturn(run func(db.Context, int64, string, time.Time, string) error) *Repo_Fail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *Repo) GetByID(ctx db.Context, id int64) (*model.MailOutboxMessage, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.MailOutboxMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, int64) (*model.MailOutboxMessage, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, int64) *model.MailOutboxMessage); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.MailOutboxMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type Repo_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx db.Context
+//   - id int64
+func (_e *Repo_Expecter) GetByID(ctx interface{}, id interface{}) *Repo_GetByID_Call {
+	return &Repo_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *Repo_GetByID_Call) Run(run func(ctx db.Context, id int64)) *Repo_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Repo_GetByID_Call) Return(_a0 *model.MailOutboxMessage, _a1 error) *Repo_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_GetByID_Call) RunAndReturn(run func(db.Context, int64) (*model.MailOutboxMessage, error)) *Repo_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkBounced provides a mock function with given fields: ctx, messageID, now, detail
+func (_m *Repo) MarkBounced(ctx db.Context, messageID string, now time.Time, detail string) (int64, error) {
+	ret := _m.Called(ctx, messageID, now, detail)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, string, time.Time, string) (int64, error)); ok {
+		return rf(ctx, messageID, now, detail)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, string, time.Time, string) int64); ok {
+		r0 = rf(ctx, messageID, now, detail)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, string, time.Time, string) error); ok {
+		r1 = rf(ctx, messageID, now, detail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_MarkBounced_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkBounced'
+type Repo_MarkBounced_Call struct {
+	*mock.Call
+}
+
+// MarkBounced is a helper method to define mock.On call
+//   - ctx db.Context
+//   - messageID string
+//   - now time.Time
+//   - detail string
+func (_e *Repo_Expecter) MarkBounced(ctx interface{}, messageID interface{}, now interface{}, detail interface{}) *Repo_MarkBounced_Call {
+	return &Repo_MarkBounced_Call{Call: _e.mock.On("MarkBounced", ctx, messageID, now, detail)}
+}
+
+func (_c *Repo_MarkBounced_Call) Run(run func(ctx db.Context, messageID string, now time.Time, detail string)) *Repo_MarkBounced_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(string), args[2].(time.Time), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *Repo_MarkBounced_Call) Return(_a0 int64, _a1 error) *Repo_MarkBounced_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_MarkBounced_Call) RunAndReturn(run func(db.Context, string, time.Time, string) (int64, error)) *Repo_MarkBounced_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSent provides a mock function with given fields: ctx, id, dispatcherID, now
+func (_m *Repo) MarkSent(ctx db.Context, id int64, dispatcherID string, now time.Time) error {
+	ret := _m.Called(ctx, id, dispatcherID, now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, int64, string, time.Time) error); ok {
+		r0 = rf(ctx, id, dispatcherID, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_MarkSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSent'
+type Repo_MarkSent_Call struct {
+	*mock.Call
+}
+
+// MarkSent is a helper method to define mock.On call
+//   - ctx db.Context
+//   - id int64
+//   - dispatcherID string
+//   - now time.Time
+func (_e *Repo_Expecter) MarkSent(ctx interface{}, id interface{}, dispatcherID interface{}, now interface{}) *Repo_MarkSent_Call {
+	return &Repo_MarkSent_Call{Call: _e.mock.On("MarkSent", ctx, id, dispatcherID, now)}
+}
+
+func (_c *Repo_MarkSent_Call) Run(run func(ctx db.Context, id int64, dispatcherID string, now time.Time)) *Repo_MarkSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int64), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repo_MarkSent_Call) Return(_a0 error) *Repo_MarkSent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_MarkSent_Call) RunAndReturn(run func(db.Context, int64, string, time.Time) error) *Repo_MarkSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Retry provides a mock function with given fields: ctx, id, dispatcherID, now, nextAttemptAt, lastErr
+func (_m *Repo) Retry(ctx db.Context, id int64, dispatcherID string, now time.Time, nextAttemptAt time.Time, lastErr string) error {
+	ret := _m.Called(ctx, id, dispatcherID, now, nextAttemptAt, lastErr)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, int64, string, time.Time, time.Time, string) error); ok {
+		r0 = rf(ctx, id, dispatcherID, now, nextAttemptAt, lastErr)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_Retry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retry'
+type Repo_Retry_Call struct {
+	*mock.Call
+}
+
+// Retry is a helper method to define mock.On call
+//   - ctx db.Context
+//   - id int64
+//   - dispatcherID string
+//   - now time.Time
+//   - nextAttemptAt time.Time
+//   - lastErr string
+func (_e *Repo_Expecter) Retry(ctx interface{}, id interface{}, dispatcherID interface{}, now interface{}, nextAttemptAt interface{}, lastErr interface{}) *Repo_Retry_Call {
+	return &Repo_Retry_Call{Call: _e.mock.On("Retry", ctx, id, dispatcherID, now, nextAttemptAt, lastErr)}
+}
+
+func (_c *Repo_Retry_Call) Run(run func(ctx db.Context, id int64, dispatcherID string, now time.Time, nextAttemptAt time.Time, lastErr string)) *Repo_Retry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int64), args[2].(string), args[3].(time.Time), args[4].(time.Time), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *Repo_Retry_Call) Return(_a0 error) *Repo_Retry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_Retry_Call) RunAndReturn(run func(db.Context, int64, string, time.Time, time.Time, string) error) *Repo_Retry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,136 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	db "github.com/dwarvesf/go-api/pkg/repository/db"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+type Repo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Repo) EXPECT() *Repo_Expecter {
+	return &Repo_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx, userID
+func (_m *Repo) Get(ctx db.Context, userID int) (*model.MailPreference, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *model.MailPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, int) (*model.MailPreference, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, int) *model.MailPreference); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.MailPreference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type Repo_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx db.Context
+//   - userID int
+func (_e *Repo_Expecter) Get(ctx interface{}, userID interface{}) *Repo_Get_Call {
+	return &Repo_Get_Call{Call: _e.mock.On("Get", ctx, userID)}
+}
+
+func (_c *Repo_Get_Call) Run(run func(ctx db.Context, userID int)) *Repo_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Repo_Get_Call) Return(_a0 *model.MailPreference, _a1 error) *Repo_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_Get_Call) RunAndReturn(run func(db.Context, int) (*model.MailPreference, error)) *Repo_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, p
+func (_m *Repo) Save(ctx db.Context, p model.MailPreference) error {
+	ret := _m.Called(ctx, p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, model.MailPreference) error); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type Repo_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx db.Context
+//   - p model.MailPreference
+func (_e *Repo_Expecter) Save(ctx interface{}, p interface{}) *Repo_Save_Call {
+	return &Repo_Save_Call{Call: _e.mock.On("Save", ctx, p)}
+}
+
+func (_c *Repo_Save_Call) Run(run func(ctx db.Context, p model.MailPreference)) *Repo_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(model.MailPreference))
+	})
+	return _c
+}
+
+func (_c *Repo_Save_Call) Return(_a0 error) *Repo_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_Save_Call) RunAndReturn(run func(db.Context, model.MailPreference) error) *Repo_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
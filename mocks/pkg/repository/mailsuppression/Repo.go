@@ -0,0 +1,134 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	db "github.com/dwarvesf/go-api/pkg/repository/db"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+type Repo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Repo) EXPECT() *Repo_Expecter {
+	return &Repo_Expecter{mock: &_m.Mock}
+}
+
+// IsSuppressed provides a mock function with given fields: ctx, email
+func (_m *Repo) IsSuppressed(ctx db.Context, email string) (bool, error) {
+	ret := _m.Called(ctx, email)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, string) (bool, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, string) bool); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_IsSuppressed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsSuppressed'
+type Repo_IsSuppressed_Call struct {
+	*mock.Call
+}
+
+// IsSuppressed is a helper method to define mock.On call
+//   - ctx db.Context
+//   - email string
+func (_e *Repo_Expecter) IsSuppressed(ctx interface{}, email interface{}) *Repo_IsSuppressed_Call {
+	return &Repo_IsSuppressed_Call{Call: _e.mock.On("IsSuppressed", ctx, email)}
+}
+
+func (_c *Repo_IsSuppressed_Call) Run(run func(ctx db.Context, email string)) *Repo_IsSuppressed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Repo_IsSuppressed_Call) Return(_a0 bool, _a1 error) *Repo_IsSuppressed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_IsSuppressed_Call) RunAndReturn(run func(db.Context, string) (bool, error)) *Repo_IsSuppressed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, s
+func (_m *Repo) Save(ctx db.Context, s model.MailSuppression) error {
+	ret := _m.Called(ctx, s)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, model.MailSuppression) error); ok {
+		r0 = rf(ctx, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type Repo_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx db.Context
+//   - s model.MailSuppression
+func (_e *Repo_Expecter) Save(ctx interface{}, s interface{}) *Repo_Save_Call {
+	return &Repo_Save_Call{Call: _e.mock.On("Save", ctx, s)}
+}
+
+func (_c *Repo_Save_Call) Run(run func(ctx db.Context, s model.MailSuppression)) *Repo_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(model.MailSuppression))
+	})
+	return _c
+}
+
+func (_c *Repo_Save_Call) Return(_a0 error) *Repo_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_Save_Call) RunAndReturn(run func(db.Context, model.MailSuppression) error) *Repo_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
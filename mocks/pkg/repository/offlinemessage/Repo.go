@@ -0,0 +1,243 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	json "encoding/json"
+
+	db "github.com/dwarvesf/go-api/pkg/repository/db"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+
+	time "time"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+type Repo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Repo) EXPECT() *Repo_Expecter {
+	return &Repo_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, uID, payload, expiresAt
+func (_m *Repo) Create(ctx db.Context, uID int, payload json.RawMessage, expiresAt time.Time) (*model.OfflineMessage, error) {
+	ret := _m.Called(ctx, uID, payload, expiresAt)
+
+	var r0 *model.OfflineMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, int, json.RawMessage, time.Time) (*model.OfflineMessage, error)); ok {
+		return rf(ctx, uID, payload, expiresAt)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, int, json.RawMessage, time.Time) *model.OfflineMessage); ok {
+		r0 = rf(ctx, uID, payload, expiresAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OfflineMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, int, json.RawMessage, time.Time) error); ok {
+		r1 = rf(ctx, uID, payload, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type Repo_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx db.Context
+//   - uID int
+//   - payload json.RawMessage
+//   - expiresAt time.Time
+func (_e *Repo_Expecter) Create(ctx interface{}, uID interface{}, payload interface{}, expiresAt interface{}) *Repo_Create_Call {
+	return &Repo_Create_Call{Call: _e.mock.On("Create", ctx, uID, payload, expiresAt)}
+}
+
+func (_c *Repo_Create_Call) Run(run func(ctx db.Context, uID int, payload json.RawMessage, expiresAt time.Time)) *Repo_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int), args[2].(json.RawMessage), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repo_Create_Call) Return(_a0 *model.OfflineMessage, _a1 error) *Repo_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_Create_Call) RunAndReturn(run func(db.Context, int, json.RawMessage, time.Time) (*model.OfflineMessage, error)) *Repo_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, uID, id
+func (_m *Repo) Delete(ctx db.Context, uID int, id int64) error {
+	ret := _m.Called(ctx, uID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, int, int64) error); ok {
+		r0 = rf(ctx, uID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type Repo_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx db.Context
+//   - uID int
+//   - id int64
+func (_e *Repo_Expecter) Delete(ctx interface{}, uID interface{}, id interface{}) *Repo_Delete_Call {
+	return &Repo_Delete_Call{Call: _e.mock.On("Delete", ctx, uID, id)}
+}
+
+func (_c *Repo_Delete_Call) Run(run func(ctx db.Context, uID int, id int64)) *Repo_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *Repo_Delete_Call) Return(_a0 error) *Repo_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_Delete_Call) RunAndReturn(run func(db.Context, int, int64) error) *Repo_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpired provides a mock function with given fields: ctx, uID, now
+func (_m *Repo) DeleteExpired(ctx db.Context, uID int, now time.Time) error {
+	ret := _m.Called(ctx, uID, now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, int, time.Time) error); ok {
+		r0 = rf(ctx, uID, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_DeleteExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpired'
+type Repo_DeleteExpired_Call struct {
+	*mock.Call
+}
+
+// DeleteExpired is a helper method to define mock.On call
+//   - ctx db.Context
+//   - uID int
+//   - now time.Time
+func (_e *Repo_Expecter) DeleteExpired(ctx interface{}, uID interface{}, now interface{}) *Repo_DeleteExpired_Call {
+	return &Repo_DeleteExpired_Call{Call: _e.mock.On("DeleteExpired", ctx, uID, now)}
+}
+
+func (_c *Repo_DeleteExpired_Call) Run(run func(ctx db.Context, uID int, now time.Time)) *Repo_DeleteExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repo_DeleteExpired_Call) Return(_a0 error) *Repo_DeleteExpired_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_DeleteExpired_Call) RunAndReturn(run func(db.Context, int, time.Time) error) *Repo_DeleteExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPending provides a mock function with given fields: ctx, uID, now
+func (_m *Repo) GetPending(ctx db.Context, uID int, now time.Time) ([]model.OfflineMessage, error) {
+	ret := _m.Called(ctx, uID, now)
+
+	var r0 []model.OfflineMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, int, time.Time) ([]model.OfflineMessage, error)); ok {
+		return rf(ctx, uID, now)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, int, time.Time) []model.OfflineMessage); ok {
+		r0 = rf(ctx, uID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OfflineMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, uID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_GetPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPending'
+type Repo_GetPending_Call struct {
+	*mock.Call
+}
+
+// GetPending is a helper method to define mock.On call
+//   - ctx db.Context
+//   - uID int
+//   - now time.Time
+func (_e *Repo_Expecter) GetPending(ctx interface{}, uID interface{}, now interface{}) *Repo_GetPending_Call {
+	return &Repo_GetPending_Call{Call: _e.mock.On("GetPending", ctx, uID, now)}
+}
+
+func (_c *Repo_GetPending_Call) Run(run func(ctx db.Context, uID int, now time.Time)) *Repo_GetPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repo_GetPending_Call) Return(_a0 []model.OfflineMessage, _a1 error) *Repo_GetPending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_GetPending_Call) RunAndReturn(run func(db.Context, int, time.Time) ([]model.OfflineMessage, error)) *Repo_GetPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
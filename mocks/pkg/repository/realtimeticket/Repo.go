@@ -0,0 +1,180 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	db "github.com/dwarvesf/go-api/pkg/repository/db"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+
+	time "time"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+type Repo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Repo) EXPECT() *Repo_Expecter {
+	return &Repo_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, t
+func (_m *Repo) Create(ctx db.Context, t model.RealtimeTicket) error {
+	ret := _m.Called(ctx, t)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, model.RealtimeTicket) error); ok {
+		r0 = rf(ctx, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type Repo_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx db.Context
+//   - t model.RealtimeTicket
+func (_e *Repo_Expecter) Create(ctx interface{}, t interface{}) *Repo_Create_Call {
+	return &Repo_Create_Call{Call: _e.mock.On("Create", ctx, t)}
+}
+
+func (_c *Repo_Create_Call) Run(run func(ctx db.Context, t model.RealtimeTicket)) *Repo_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(model.RealtimeTicket))
+	})
+	return _c
+}
+
+func (_c *Repo_Create_Call) Return(_a0 error) *Repo_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_Create_Call) RunAndReturn(run func(db.Context, model.RealtimeTicket) error) *Repo_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpired provides a mock function with given fields: ctx, now
+func (_m *Repo) DeleteExpired(ctx db.Context, now time.Time) error {
+	ret := _m.Called(ctx, now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, time.Time) error); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_DeleteExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpired'
+type Repo_DeleteExpired_Call struct {
+	*mock.Call
+}
+
+// DeleteExpired is a helper method to define mock.On call
+//   - ctx db.Context
+//   - now time.Time
+func (_e *Repo_Expecter) DeleteExpired(ctx interface{}, now interface{}) *Repo_DeleteExpired_Call {
+	return &Repo_DeleteExpired_Call{Call: _e.mock.On("DeleteExpired", ctx, now)}
+}
+
+func (_c *Repo_DeleteExpired_Call) Run(run func(ctx db.Context, now time.Time)) *Repo_DeleteExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repo_DeleteExpired_Call) Return(_a0 error) *Repo_DeleteExpired_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_DeleteExpired_Call) RunAndReturn(run func(db.Context, time.Time) error) *Repo_DeleteExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Take provides a mock function with given fields: ctx, tokenHash
+func (_m *Repo) Take(ctx db.Context, tokenHash string) (*model.RealtimeTicket, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	var r0 *model.RealtimeTicket
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, string) (*model.RealtimeTicket, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, string) *model.RealtimeTicket); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RealtimeTicket)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_Take_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Take'
+type Repo_Take_Call struct {
+	*mock.Call
+}
+
+// Take is a helper method to define mock.On call
+//   - ctx db.Context
+//   - tokenHash string
+func (_e *Repo_Expecter) Take(ctx interface{}, tokenHash interface{}) *Repo_Take_Call {
+	return &Repo_Take_Call{Call: _e.mock.On("Take", ctx, tokenHash)}
+}
+
+func (_c *Repo_Take_Call) Run(run func(ctx db.Context, tokenHash string)) *Repo_Take_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Repo_Take_Call) Return(_a0 *model.RealtimeTicket, _a1 error) *Repo_Take_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_Take_Call) RunAndReturn(run func(db.Context, string) (*model.RealtimeTicket, error)) *Repo_Take_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
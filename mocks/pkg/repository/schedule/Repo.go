@@ -0,0 +1,182 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	db "github.com/dwarvesf/go-api/pkg/repository/db"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+
+	time "time"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+type Repo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Repo) EXPECT() *Repo_Expecter {
+	return &Repo_Expecter{mock: &_m.Mock}
+}
+
+// GetList provides a mock function with given fields: ctx
+func (_m *Repo) GetList(ctx db.Context) ([]model.Schedule, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []model.Schedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context) ([]model.Schedule, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context) []model.Schedule); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Schedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_GetList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetList'
+type Repo_GetList_Call struct {
+	*mock.Call
+}
+
+// GetList is a helper method to define mock.On call
+//   - ctx db.Context
+func (_e *Repo_Expecter) GetList(ctx interface{}) *Repo_GetList_Call {
+	return &Repo_GetList_Call{Call: _e.mock.On("GetList", ctx)}
+}
+
+func (_c *Repo_GetList_Call) Run(run func(ctx db.Context)) *Repo_GetList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context))
+	})
+	return _c
+}
+
+func (_c *Repo_GetList_Call) Return(_a0 []model.Schedule, _a1 error) *Repo_GetList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_GetList_Call) RunAndReturn(run func(db.Context) ([]model.Schedule, error)) *Repo_GetList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, name, spec, nextRunAt
+func (_m *Repo) Save(ctx db.Context, name string, spec string, nextRunAt time.Time) error {
+	ret := _m.Called(ctx, name, spec, nextRunAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, string, string, time.Time) error); ok {
+		r0 = rf(ctx, name, spec, nextRunAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type Repo_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx db.Context
+//   - name string
+//   - spec string
+//   - nextRunAt time.Time
+func (_e *Repo_Expecter) Save(ctx interface{}, name interface{}, spec interface{}, nextRunAt interface{}) *Repo_Save_Call {
+	return &Repo_Save_Call{Call: _e.mock.On("Save", ctx, name, spec, nextRunAt)}
+}
+
+func (_c *Repo_Save_Call) Run(run func(ctx db.Context, name string, spec string, nextRunAt time.Time)) *Repo_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repo_Save_Call) Return(_a0 error) *Repo_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_Save_Call) RunAndReturn(run func(db.Context, string, string, time.Time) error) *Repo_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRun provides a mock function with given fields: ctx, name, run
+func (_m *Repo) UpdateRun(ctx db.Context, name string, run model.ScheduleRun) error {
+	ret := _m.Called(ctx, name, run)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(db.Context, string, model.ScheduleRun) error); ok {
+		r0 = rf(ctx, name, run)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repo_UpdateRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRun'
+type Repo_UpdateRun_Call struct {
+	*mock.Call
+}
+
+// UpdateRun is a helper method to define mock.On call
+//   - ctx db.Context
+//   - name string
+//   - run model.ScheduleRun
+func (_e *Repo_Expecter) UpdateRun(ctx interface{}, name interface{}, run interface{}) *Repo_UpdateRun_Call {
+	return &Repo_UpdateRun_Call{Call: _e.mock.On("UpdateRun", ctx, name, run)}
+}
+
+func (_c *Repo_UpdateRun_Call) Run(run func(ctx db.Context, name string, run model.ScheduleRun)) *Repo_UpdateRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(string), args[2].(model.ScheduleRun))
+	})
+	return _c
+}
+
+func (_c *Repo_UpdateRun_Call) Return(_a0 error) *Repo_UpdateRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repo_UpdateRun_Call) RunAndReturn(run func(db.Context, string, model.ScheduleRun) error) *Repo_UpdateRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
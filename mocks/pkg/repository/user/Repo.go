@@ -74,6 +74,59 @@ func (_c *Repo_Count_Call) RunAndReturn(run func(db.Context) (int64, error)) *Re
 	return _c
 }
 
+// CountAudience provides a mock function with given fields: ctx, f
+func (_m *Repo) CountAudience(ctx db.Context, f model.AudienceFilter) (int64, error) {
+	ret := _m.Called(ctx, f)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, model.AudienceFilter) (int64, error)); ok {
+		return rf(ctx, f)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, model.AudienceFilter) int64); ok {
+		r0 = rf(ctx, f)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, model.AudienceFilter) error); ok {
+		r1 = rf(ctx, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_CountAudience_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountAudience'
+type Repo_CountAudience_Call struct {
+	*mock.Call
+}
+
+// CountAudience is a helper method to define mock.On call
+//   - ctx db.Context
+//   - f model.AudienceFilter
+func (_e *Repo_Expecter) CountAudience(ctx interface{}, f interface{}) *Repo_CountAudience_Call {
+	return &Repo_CountAudience_Call{Call: _e.mock.On("CountAudience", ctx, f)}
+}
+
+func (_c *Repo_CountAudience_Call) Run(run func(ctx db.Context, f model.AudienceFilter)) *Repo_CountAudience_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(model.AudienceFilter))
+	})
+	return _c
+}
+
+func (_c *Repo_CountAudience_Call) Return(_a0 int64, _a1 error) *Repo_CountAudience_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_CountAudience_Call) RunAndReturn(run func(db.Context, model.AudienceFilter) (int64, error)) *Repo_CountAudience_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, _a1
 func (_m *Repo) Create(ctx db.Context, _a1 model.SignupRequest) (*model.User, error) {
 	ret := _m.Called(ctx, _a1)
@@ -294,6 +347,63 @@ func (_c *Repo_GetList_Call) RunAndReturn(run func(db.Context, model.ListQuery)
 	return _c
 }
 
+// GetListAfter provides a mock function with given fields: ctx, f, afterID, limit
+func (_m *Repo) GetListAfter(ctx db.Context, f model.AudienceFilter, afterID int, limit int) ([]model.User, error) {
+	ret := _m.Called(ctx, f, afterID, limit)
+
+	var r0 []model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, model.AudienceFilter, int, int) ([]model.User, error)); ok {
+		return rf(ctx, f, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, model.AudienceFilter, int, int) []model.User); ok {
+		r0 = rf(ctx, f, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, model.AudienceFilter, int, int) error); ok {
+		r1 = rf(ctx, f, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_GetListAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListAfter'
+type Repo_GetListAfter_Call struct {
+	*mock.Call
+}
+
+// GetListAfter is a helper method to define mock.On call
+//   - ctx db.Context
+//   - f model.AudienceFilter
+//   - afterID int
+//   - limit int
+func (_e *Repo_Expecter) GetListAfter(ctx interface{}, f interface{}, afterID interface{}, limit interface{}) *Repo_GetListAfter_Call {
+	return &Repo_GetListAfter_Call{Call: _e.mock.On("GetListAfter", ctx, f, afterID, limit)}
+}
+
+func (_c *Repo_GetListAfter_Call) Run(run func(ctx db.Context, f model.AudienceFilter, afterID int, limit int)) *Repo_GetListAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(model.AudienceFilter), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *Repo_GetListAfter_Call) Return(_a0 []model.User, _a1 error) *Repo_GetListAfter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_GetListAfter_Call) RunAndReturn(run func(db.Context, model.AudienceFilter, int, int) ([]model.User, error)) *Repo_GetListAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, uID, _a2
 func (_m *Repo) Update(ctx db.Context, uID int, _a2 model.UpdateUserRequest) (*model.User, error) {
 	ret := _m.Called(ctx, uID, _a2)
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	db "github.com/dwarvesf/go-api/pkg/repository/db"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+
+	time "time"
+)
+
+// Repo is an autogenerated mock type for the Repo type
+type Repo struct {
+	mock.Mock
+}
+
+type Repo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Repo) EXPECT() *Repo_Expecter {
+	return &Repo_Expecter{mock: &_m.Mock}
+}
+
+// Touch provides a mock function with given fields: ctx, uID, device, now
+func (_m *Repo) Touch(ctx db.Context, uID int, device model.Device, now time.Time) (bool, error) {
+	ret := _m.Called(ctx, uID, device, now)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(db.Context, int, model.Device, time.Time) (bool, error)); ok {
+		return rf(ctx, uID, device, now)
+	}
+	if rf, ok := ret.Get(0).(func(db.Context, int, model.Device, time.Time) bool); ok {
+		r0 = rf(ctx, uID, device, now)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(db.Context, int, model.Device, time.Time) error); ok {
+		r1 = rf(ctx, uID, device, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repo_Touch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Touch'
+type Repo_Touch_Call struct {
+	*mock.Call
+}
+
+// Touch is a helper method to define mock.On call
+//   - ctx db.Context
+//   - uID int
+//   - device model.Device
+//   - now time.Time
+func (_e *Repo_Expecter) Touch(ctx interface{}, uID interface{}, device interface{}, now interface{}) *Repo_Touch_Call {
+	return &Repo_Touch_Call{Call: _e.mock.On("Touch", ctx, uID, device, now)}
+}
+
+func (_c *Repo_Touch_Call) Run(run func(ctx db.Context, uID int, device model.Device, now time.Time)) *Repo_Touch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(db.Context), args[1].(int), args[2].(model.Device), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repo_Touch_Call) Return(_a0 bool, _a1 error) *Repo_Touch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repo_Touch_Call) RunAndReturn(run func(db.Context, int, model.Device, time.Time) (bool, error)) *Repo_Touch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepo creates a new instance of Repo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Repo {
+	mock := &Repo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,172 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Locker is an autogenerated mock type for the Locker type
+type Locker struct {
+	mock.Mock
+}
+
+type Locker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Locker) EXPECT() *Locker_Expecter {
+	return &Locker_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with given fields: ctx
+func (_m *Locker) Check(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Locker_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type Locker_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Locker_Expecter) Check(ctx interface{}) *Locker_Check_Call {
+	return &Locker_Check_Call{Call: _e.mock.On("Check", ctx)}
+}
+
+func (_c *Locker_Check_Call) Run(run func(ctx context.Context)) *Locker_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Locker_Check_Call) Return(_a0 error) *Locker_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Locker_Check_Call) RunAndReturn(run func(context.Context) error) *Locker_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TryLock provides a mock function with given fields: ctx
+func (_m *Locker) TryLock(ctx context.Context) (bool, error) {
+	ret := _m.Called(ctx)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (bool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Locker_TryLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TryLock'
+type Locker_TryLock_Call struct {
+	*mock.Call
+}
+
+// TryLock is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Locker_Expecter) TryLock(ctx interface{}) *Locker_TryLock_Call {
+	return &Locker_TryLock_Call{Call: _e.mock.On("TryLock", ctx)}
+}
+
+func (_c *Locker_TryLock_Call) Run(run func(ctx context.Context)) *Locker_TryLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Locker_TryLock_Call) Return(_a0 bool, _a1 error) *Locker_TryLock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Locker_TryLock_Call) RunAndReturn(run func(context.Context) (bool, error)) *Locker_TryLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unlock provides a mock function with given fields: ctx
+func (_m *Locker) Unlock(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Locker_Unlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unlock'
+type Locker_Unlock_Call struct {
+	*mock.Call
+}
+
+// Unlock is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Locker_Expecter) Unlock(ctx interface{}) *Locker_Unlock_Call {
+	return &Locker_Unlock_Call{Call: _e.mock.On("Unlock", ctx)}
+}
+
+func (_c *Locker_Unlock_Call) Run(run func(ctx context.Context)) *Locker_Unlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Locker_Unlock_Call) Return(_a0 error) *Locker_Unlock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Locker_Unlock_Call) RunAndReturn(run func(context.Context) error) *Locker_Unlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLocker creates a new instance of Locker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLocker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Locker {
+	mock := &Locker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
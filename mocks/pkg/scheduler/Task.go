@@ -0,0 +1,78 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Task is an autogenerated mock type for the Task type
+type Task struct {
+	mock.Mock
+}
+
+type Task_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Task) EXPECT() *Task_Expecter {
+	return &Task_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx
+func (_m *Task) Execute(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Task_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type Task_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Task_Expecter) Execute(ctx interface{}) *Task_Execute_Call {
+	return &Task_Execute_Call{Call: _e.mock.On("Execute", ctx)}
+}
+
+func (_c *Task_Execute_Call) Run(run func(ctx context.Context)) *Task_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Task_Execute_Call) Return(_a0 error) *Task_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Task_Execute_Call) RunAndReturn(run func(context.Context) error) *Task_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTask creates a new instance of Task. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTask(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Task {
+	mock := &Task{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,135 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	eventbus "github.com/dwarvesf/go-api/pkg/service/eventbus"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/dwarvesf/go-api/pkg/model"
+)
+
+// Bus is an autogenerated mock type for the Bus type
+type Bus struct {
+	mock.Mock
+}
+
+type Bus_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Bus) EXPECT() *Bus_Expecter {
+	return &Bus_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with given fields:
+func (_m *Bus) Close() {
+	_m.Called()
+}
+
+// Bus_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type Bus_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *Bus_Expecter) Close() *Bus_Close_Call {
+	return &Bus_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *Bus_Close_Call) Run(run func()) *Bus_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Bus_Close_Call) Return() *Bus_Close_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Bus_Close_Call) RunAndReturn(run func()) *Bus_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Publish provides a mock function with given fields: e
+func (_m *Bus) Publish(e model.Event) {
+	_m.Called(e)
+}
+
+// Bus_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type Bus_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - e model.Event
+func (_e *Bus_Expecter) Publish(e interface{}) *Bus_Publish_Call {
+	return &Bus_Publish_Call{Call: _e.mock.On("Publish", e)}
+}
+
+func (_c *Bus_Publish_Call) Run(run func(e model.Event)) *Bus_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Event))
+	})
+	return _c
+}
+
+func (_c *Bus_Publish_Call) Return() *Bus_Publish_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Bus_Publish_Call) RunAndReturn(run func(model.Event)) *Bus_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: h
+func (_m *Bus) Subscribe(h eventbus.Handler) {
+	_m.Called(h)
+}
+
+// Bus_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type Bus_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - h eventbus.Handler
+func (_e *Bus_Expecter) Subscribe(h interface{}) *Bus_Subscribe_Call {
+	return &Bus_Subscribe_Call{Call: _e.mock.On("Subscribe", h)}
+}
+
+func (_c *Bus_Subscribe_Call) Run(run func(h eventbus.Handler)) *Bus_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(eventbus.Handler))
+	})
+	return _c
+}
+
+func (_c *Bus_Subscribe_Call) Return() *Bus_Subscribe_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Bus_Subscribe_Call) RunAndReturn(run func(eventbus.Handler)) *Bus_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBus creates a new instance of Bus. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBus(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Bus {
+	mock := &Bus{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
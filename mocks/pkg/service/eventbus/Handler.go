@@ -0,0 +1,77 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "github.com/dwarvesf/go-api/pkg/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Handler is an autogenerated mock type for the Handler type
+type Handler struct {
+	mock.Mock
+}
+
+type Handler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Handler) EXPECT() *Handler_Expecter {
+	return &Handler_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: e
+func (_m *Handler) Execute(e model.Event) error {
+	ret := _m.Called(e)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.Event) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Handler_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type Handler_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - e model.Event
+func (_e *Handler_Expecter) Execute(e interface{}) *Handler_Execute_Call {
+	return &Handler_Execute_Call{Call: _e.mock.On("Execute", e)}
+}
+
+func (_c *Handler_Execute_Call) Run(run func(e model.Event)) *Handler_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.Event))
+	})
+	return _c
+}
+
+func (_c *Handler_Execute_Call) Return(_a0 error) *Handler_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Handler_Execute_Call) RunAndReturn(run func(model.Event) error) *Handler_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHandler creates a new instance of Handler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Handler {
+	mock := &Handler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
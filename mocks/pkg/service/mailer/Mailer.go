@@ -0,0 +1,80 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mailer "github.com/dwarvesf/go-api/pkg/service/mailer"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Mailer is an autogenerated mock type for the Mailer type
+type Mailer struct {
+	mock.Mock
+}
+
+type Mailer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Mailer) EXPECT() *Mailer_Expecter {
+	return &Mailer_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function with given fields: ctx, msg
+func (_m *Mailer) Send(ctx context.Context, msg mailer.Message) error {
+	ret := _m.Called(ctx, msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, mailer.Message) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Mailer_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type Mailer_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg mailer.Message
+func (_e *Mailer_Expecter) Send(ctx interface{}, msg interface{}) *Mailer_Send_Call {
+	return &Mailer_Send_Call{Call: _e.mock.On("Send", ctx, msg)}
+}
+
+func (_c *Mailer_Send_Call) Run(run func(ctx context.Context, msg mailer.Message)) *Mailer_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(mailer.Message))
+	})
+	return _c
+}
+
+func (_c *Mailer_Send_Call) Return(_a0 error) *Mailer_Send_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Mailer_Send_Call) RunAndReturn(run func(context.Context, mailer.Message) error) *Mailer_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMailer creates a new instance of Mailer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMailer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Mailer {
+	mock := &Mailer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
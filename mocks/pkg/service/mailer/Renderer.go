@@ -0,0 +1,90 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	mailer "github.com/dwarvesf/go-api/pkg/service/mailer"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Renderer is an autogenerated mock type for the Renderer type
+type Renderer struct {
+	mock.Mock
+}
+
+type Renderer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Renderer) EXPECT() *Renderer_Expecter {
+	return &Renderer_Expecter{mock: &_m.Mock}
+}
+
+// Render provides a mock function with given fields: name, data
+func (_m *Renderer) Render(name string, data interface{}) (*mailer.Message, error) {
+	ret := _m.Called(name, data)
+
+	var r0 *mailer.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, interface{}) (*mailer.Message, error)); ok {
+		return rf(name, data)
+	}
+	if rf, ok := ret.Get(0).(func(string, interface{}) *mailer.Message); ok {
+		r0 = rf(name, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*mailer.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, interface{}) error); ok {
+		r1 = rf(name, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Renderer_Render_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Render'
+type Renderer_Render_Call struct {
+	*mock.Call
+}
+
+// Render is a helper method to define mock.On call
+//   - name string
+//   - data interface{}
+func (_e *Renderer_Expecter) Render(name interface{}, data interface{}) *Renderer_Render_Call {
+	return &Renderer_Render_Call{Call: _e.mock.On("Render", name, data)}
+}
+
+func (_c *Renderer_Render_Call) Run(run func(name string, data interface{})) *Renderer_Render_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Renderer_Render_Call) Return(_a0 *mailer.Message, _a1 error) *Renderer_Render_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Renderer_Render_Call) RunAndReturn(run func(string, interface{}) (*mailer.Message, error)) *Renderer_Render_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRenderer creates a new instance of Renderer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRenderer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Renderer {
+	mock := &Renderer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
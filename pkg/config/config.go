@@ -1,6 +1,8 @@
 package config
 
 import (
+	"strings"
+
 	"github.com/spf13/viper"
 )
 
@@ -23,10 +25,64 @@ type Config struct {
 	Port           string
 	AllowedOrigins string
 	SecretKey      string
+	CursorSecret   string // signs the cursors of the lists, derived from SecretKey when empty
 	DatabaseURL    string
 	DBMaxOpenConns int
 	DBMaxIdleConns int
 
+	// the proxies allowed to set X-Forwarded-For, none uses the address of the peer as the client IP
+	TrustedProxies []string
+
+	// read replicas, none sends every query to the primary
+	DatabaseReplicaURLs     []string
+	DBReplicaHealthInterval int // in seconds
+	DBReadYourWritesWindow  int // in seconds the reads of a user stick to the primary after its writes
+
+	// applies the pending migrations when the server starts
+	DBMigrateOnBoot bool
+
+	// realtime
+	RealtimeOfflineQueue bool // only for a single replica, the presence of the devices is in-process
+	RealtimeOfflineTTL   int  // in seconds
+
+	RealtimeMaxConnsPerUser int
+	RealtimeMaxConnsPerIP   int
+	RealtimeMaxGuests       int
+	RealtimeDisableGuests   bool
+	RealtimeMaxMessageSize  int // in bytes
+	RealtimeMessageRate     int // messages per second
+	RealtimeMessageBurst    int
+
+	// background jobs
+	JobsEmbedded     bool
+	JobsConcurrency  int
+	JobsPollInterval int // in milliseconds
+	JobsLockTimeout  int // in seconds
+	JobsMaxAttempts  int
+
+	// scheduler
+	SchedulerEnabled  bool
+	ScheduleSentMail  string // cron spec, empty disables the schedule
+	ScheduleCampaigns string // cron spec of the dispatch of the scheduled campaigns
+
+	// mailer
+	MailerTransport string
+	MailFrom        string
+	MailDir         string // empty writes the emails of the file transport to stdout
+	SMTPHost        string
+	SMTPPort        int
+	SMTPUsername    string
+	SMTPPassword    string
+	SMTPRequireTLS  bool
+	MailBatchSize   int
+	MailConcurrency int
+	MailRateLimit   int // mails per second, 0 disables the limit
+
+	// transactional mails
+	MailOutboxMaxAttempts  int
+	MailOutboxPollInterval int    // in milliseconds
+	MailWebhookSecret      string // empty disables the webhook of the mail provider
+
 	// log system
 	SentryDSN string
 }
@@ -49,15 +105,59 @@ func Generate(v ENV) *Config {
 		App:            v.GetString("APP"),
 		Env:            v.GetString("ENV"),
 		SecretKey:      v.GetString("SECRET_KEY"),
+		CursorSecret:   v.GetString("CURSOR_SECRET"),
 		SentryDSN:      v.GetString("SENTRY_DSN"),
 		Version:        v.GetString("VERSION"),
 		ServerName:     v.GetString("SERVER_NAME"),
 		BaseURL:        v.GetString("BASE_URL"),
 		Port:           v.GetString("PORT"),
 		AllowedOrigins: v.GetString("ALLOWED_ORIGINS"),
+		TrustedProxies: splitList(v.GetString("TRUSTED_PROXIES")),
 		DatabaseURL:    v.GetString("DATABASE_URL"),
 		DBMaxOpenConns: v.GetInt("DB_MAX_OPEN_CONNS"),
 		DBMaxIdleConns: v.GetInt("DB_MAX_IDLE_CONNS"),
+
+		DatabaseReplicaURLs:     splitList(v.GetString("DATABASE_REPLICA_URLS")),
+		DBReplicaHealthInterval: v.GetInt("DB_REPLICA_HEALTH_INTERVAL"),
+		DBReadYourWritesWindow:  v.GetInt("DB_READ_YOUR_WRITES_WINDOW"),
+		DBMigrateOnBoot:         v.GetBool("DB_MIGRATE_ON_BOOT"),
+
+		RealtimeOfflineQueue: v.GetBool("REALTIME_OFFLINE_QUEUE"),
+		RealtimeOfflineTTL:   v.GetInt("REALTIME_OFFLINE_TTL"),
+
+		RealtimeMaxConnsPerUser: v.GetInt("REALTIME_MAX_CONNS_PER_USER"),
+		RealtimeMaxConnsPerIP:   v.GetInt("REALTIME_MAX_CONNS_PER_IP"),
+		RealtimeMaxGuests:       v.GetInt("REALTIME_MAX_GUESTS"),
+		RealtimeDisableGuests:   v.GetBool("REALTIME_DISABLE_GUESTS"),
+		RealtimeMaxMessageSize:  v.GetInt("REALTIME_MAX_MESSAGE_SIZE"),
+		RealtimeMessageRate:     v.GetInt("REALTIME_MESSAGE_RATE"),
+		RealtimeMessageBurst:    v.GetInt("REALTIME_MESSAGE_BURST"),
+
+		JobsEmbedded:     v.GetBool("JOBS_EMBEDDED"),
+		JobsConcurrency:  v.GetInt("JOBS_CONCURRENCY"),
+		JobsPollInterval: v.GetInt("JOBS_POLL_INTERVAL"),
+		JobsLockTimeout:  v.GetInt("JOBS_LOCK_TIMEOUT"),
+		JobsMaxAttempts:  v.GetInt("JOBS_MAX_ATTEMPTS"),
+
+		SchedulerEnabled:  v.GetBool("SCHEDULER_ENABLED"),
+		ScheduleSentMail:  v.GetString("SCHEDULE_SENT_MAIL"),
+		ScheduleCampaigns: v.GetString("SCHEDULE_CAMPAIGNS"),
+
+		MailerTransport: v.GetString("MAILER_TRANSPORT"),
+		MailFrom:        v.GetString("MAIL_FROM"),
+		MailDir:         v.GetString("MAIL_DIR"),
+		SMTPHost:        v.GetString("SMTP_HOST"),
+		SMTPPort:        v.GetInt("SMTP_PORT"),
+		SMTPUsername:    v.GetString("SMTP_USERNAME"),
+		SMTPPassword:    v.GetString("SMTP_PASSWORD"),
+		SMTPRequireTLS:  v.GetBool("SMTP_REQUIRE_TLS"),
+		MailBatchSize:   v.GetInt("MAIL_BATCH_SIZE"),
+		MailConcurrency: v.GetInt("MAIL_CONCURRENCY"),
+		MailRateLimit:   v.GetInt("MAIL_RATE_LIMIT"),
+
+		MailOutboxMaxAttempts:  v.GetInt("MAIL_OUTBOX_MAX_ATTEMPTS"),
+		MailOutboxPollInterval: v.GetInt("MAIL_OUTBOX_POLL_INTERVAL"),
+		MailWebhookSecret:      v.GetString("MAIL_WEBHOOK_SECRET"),
 	}
 }
 
@@ -70,6 +170,18 @@ func DefaultConfigLoaders() []Loader {
 	return loaders
 }
 
+// splitList splits the comma separated list, the empty items are dropped
+func splitList(s string) []string {
+	var rs []string
+	for _, itm := range strings.Split(s, ",") {
+		if itm = strings.TrimSpace(itm); itm != "" {
+			rs = append(rs, itm)
+		}
+	}
+
+	return rs
+}
+
 // LoadConfig load config from loader list
 func LoadConfig(loaders []Loader) *Config {
 	v := viper.New()
@@ -81,6 +193,34 @@ func LoadConfig(loaders []Loader) *Config {
 	v.SetDefault("SERVER_NAME", "local")
 	v.SetDefault("DB_MAX_OPEN_CONNS", 10)
 	v.SetDefault("DB_MAX_IDLE_CONNS", 5)
+	v.SetDefault("DB_REPLICA_HEALTH_INTERVAL", 5)
+	v.SetDefault("DB_READ_YOUR_WRITES_WINDOW", 5)
+	v.SetDefault("REALTIME_OFFLINE_QUEUE", false)
+	v.SetDefault("REALTIME_OFFLINE_TTL", 86400)
+	v.SetDefault("REALTIME_MAX_CONNS_PER_USER", 10)
+	v.SetDefault("REALTIME_MAX_CONNS_PER_IP", 50)
+	v.SetDefault("REALTIME_MAX_GUESTS", 1000)
+	v.SetDefault("REALTIME_DISABLE_GUESTS", false)
+	v.SetDefault("REALTIME_MAX_MESSAGE_SIZE", 65536)
+	v.SetDefault("REALTIME_MESSAGE_RATE", 10)
+	v.SetDefault("REALTIME_MESSAGE_BURST", 20)
+	v.SetDefault("JOBS_EMBEDDED", false)
+	v.SetDefault("JOBS_CONCURRENCY", 5)
+	v.SetDefault("JOBS_POLL_INTERVAL", 1000)
+	v.SetDefault("JOBS_LOCK_TIMEOUT", 600)
+	v.SetDefault("JOBS_MAX_ATTEMPTS", 5)
+	v.SetDefault("SCHEDULER_ENABLED", false)
+	v.SetDefault("SCHEDULE_SENT_MAIL", "0 0 * * *")
+	v.SetDefault("SCHEDULE_CAMPAIGNS", "* * * * *")
+	v.SetDefault("MAILER_TRANSPORT", "file")
+	v.SetDefault("MAIL_FROM", "no-reply@d.foundation")
+	v.SetDefault("SMTP_PORT", 587)
+	v.SetDefault("SMTP_REQUIRE_TLS", true)
+	v.SetDefault("MAIL_BATCH_SIZE", 100)
+	v.SetDefault("MAIL_CONCURRENCY", 5)
+	v.SetDefault("MAIL_RATE_LIMIT", 10)
+	v.SetDefault("MAIL_OUTBOX_MAX_ATTEMPTS", 5)
+	v.SetDefault("MAIL_OUTBOX_POLL_INTERVAL", 1000)
 
 	for idx := range loaders {
 		newV, err := loaders[idx].Load(*v)
@@ -35,7 +35,7 @@ func (c impl) Login(ctx context.Context, req model.LoginRequest) (*model.LoginRe
 	token, err := c.jwtHelper.GenerateJWTToken(map[string]interface{}{
 		"sub":  user.ID,
 		"iss":  c.cfg.App,
-		"role": "user",
+		"role": user.Role,
 		"exp":  jwt.NewNumericDate(now.AddDate(1, 0, 0)),
 		"nbf":  jwt.NewNumericDate(now),
 		"iat":  jwt.NewNumericDate(now),
@@ -44,6 +44,25 @@ func (c impl) Login(ctx context.Context, req model.LoginRequest) (*model.LoginRe
 		return nil, errors.WithStack(err)
 	}
 
+	// only the logins from a device the user never logged in from are reported,
+	// the report is best effort and never stops the user from logging in
+	device := model.Device{
+		IP:        req.IP,
+		UserAgent: req.UserAgent,
+	}
+	isNew, err := c.repo.UserDevice.Touch(dbCtx, user.ID, device, now)
+	if err != nil {
+		c.log.Errorf(err, "failed to record the login device of user %d", user.ID)
+	}
+	if err == nil && isNew {
+		c.bus.Publish(model.Event{
+			Type:       model.EventNewLogin,
+			UserID:     user.ID,
+			OccurredAt: now,
+			Device:     &device,
+		})
+	}
+
 	return &model.LoginResponse{
 		ID:          user.ID,
 		Email:       user.Email,
@@ -1,22 +1,30 @@
 package auth
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"net/http"
 	"net/http/httptest"
 	"reflect"
 	"testing"
 
 	mocks "github.com/dwarvesf/go-api/mocks/pkg/repository/user"
+	devicemocks "github.com/dwarvesf/go-api/mocks/pkg/repository/userdevice"
+	busmocks "github.com/dwarvesf/go-api/mocks/pkg/service/eventbus"
 	jwtmocks "github.com/dwarvesf/go-api/mocks/pkg/service/jwthelper"
 	passworkmocks "github.com/dwarvesf/go-api/mocks/pkg/service/passwordhelper"
 	"github.com/dwarvesf/go-api/pkg/config"
 	"github.com/dwarvesf/go-api/pkg/handler/testutil"
+	"github.com/dwarvesf/go-api/pkg/logger"
 	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/middleware"
 	"github.com/dwarvesf/go-api/pkg/model"
 	"github.com/dwarvesf/go-api/pkg/repository"
 	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/service/jwthelper"
 	"github.com/dwarvesf/go-api/pkg/util"
+	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -34,6 +42,10 @@ func Test_impl_Login(t *testing.T) {
 		genjwtErr        error
 		compareCalled    bool
 		compare          bool
+		expTouchCalled   bool
+		newDevice        bool
+		touchErr         error
+		expPublishCalled bool
 	}
 	type args struct {
 		req  model.LoginRequest
@@ -58,10 +70,76 @@ func Test_impl_Login(t *testing.T) {
 					HashedPassword: validPass,
 					Salt:           "abcdef",
 				},
-				expJWTCalled:  true,
-				jwtToken:      "token",
-				compareCalled: true,
-				compare:       true,
+				expJWTCalled:     true,
+				jwtToken:         "token",
+				compareCalled:    true,
+				compare:          true,
+				expTouchCalled:   true,
+				newDevice:        true,
+				expPublishCalled: true,
+			},
+			args: args{
+				req: model.LoginRequest{
+					Email:     "admin@d.foundation",
+					Password:  "123456",
+					IP:        "127.0.0.1",
+					UserAgent: "Mozilla/5.0",
+				},
+				role: "admin",
+			},
+			want: &model.LoginResponse{
+				ID:          1,
+				Email:       "admin@d.foundation",
+				AccessToken: "token",
+			},
+			wantErr: false,
+		},
+		"known device": {
+			mocked: mocked{
+				expGetUserCalled: true,
+				getUser: &model.User{
+					ID:             1,
+					Email:          "admin@d.foundation",
+					HashedPassword: validPass,
+					Salt:           "abcdef",
+				},
+				expJWTCalled:   true,
+				jwtToken:       "token",
+				compareCalled:  true,
+				compare:        true,
+				expTouchCalled: true,
+			},
+			args: args{
+				req: model.LoginRequest{
+					Email:     "admin@d.foundation",
+					Password:  "123456",
+					IP:        "127.0.0.1",
+					UserAgent: "Mozilla/5.0",
+				},
+				role: "admin",
+			},
+			want: &model.LoginResponse{
+				ID:          1,
+				Email:       "admin@d.foundation",
+				AccessToken: "token",
+			},
+			wantErr: false,
+		},
+		"device not recorded": {
+			mocked: mocked{
+				expGetUserCalled: true,
+				getUser: &model.User{
+					ID:             1,
+					Email:          "admin@d.foundation",
+					HashedPassword: validPass,
+					Salt:           "abcdef",
+				},
+				expJWTCalled:   true,
+				jwtToken:       "token",
+				compareCalled:  true,
+				compare:        true,
+				expTouchCalled: true,
+				touchErr:       errors.New("failed"),
 			},
 			args: args{
 				req: model.LoginRequest{
@@ -169,6 +247,8 @@ func Test_impl_Login(t *testing.T) {
 				userRepoMock = mocks.NewRepo(t)
 				jwtMock      = jwtmocks.NewHelper(t)
 				passwordMock = passworkmocks.NewHelper(t)
+				busMock      = busmocks.NewBus(t)
+				deviceMock   = devicemocks.NewRepo(t)
 			)
 
 			if tt.mocked.expGetUserCalled {
@@ -191,13 +271,36 @@ func Test_impl_Login(t *testing.T) {
 					GenerateJWTToken(mock.Anything).
 					Return(tt.mocked.jwtToken, tt.mocked.genjwtErr)
 			}
+
+			if tt.mocked.expTouchCalled {
+				deviceMock.
+					EXPECT().
+					Touch(mock.Anything, tt.mocked.getUser.ID, model.Device{IP: tt.args.req.IP, UserAgent: tt.args.req.UserAgent}, mock.Anything).
+					Return(tt.mocked.newDevice, tt.mocked.touchErr)
+			}
+
+			if tt.mocked.expPublishCalled {
+				busMock.
+					EXPECT().
+					Publish(mock.MatchedBy(func(e model.Event) bool {
+						return e.Type == model.EventNewLogin &&
+							e.UserID == tt.mocked.getUser.ID &&
+							e.Device.IP == tt.args.req.IP &&
+							e.Device.UserAgent == tt.args.req.UserAgent
+					})).
+					Return()
+			}
+
 			c := &impl{
 				repo: &repository.Repo{
-					User: userRepoMock,
+					User:       userRepoMock,
+					UserDevice: deviceMock,
 				},
+				bus:            busMock,
 				jwtHelper:      jwtMock,
 				passwordHelper: passwordMock,
 				cfg:            config.LoadTestConfig(),
+				log:            logger.NewLogger(),
 				monitor:        monitor.TestMonitor(),
 			}
 
@@ -217,3 +320,72 @@ func Test_impl_Login(t *testing.T) {
 		})
 	}
 }
+
+func Test_impl_Login_role(t *testing.T) {
+	tests := map[string]struct {
+		role     model.Role
+		expected int
+	}{
+		"admin reaches the admin routes": {
+			role:     model.RoleAdmin,
+			expected: http.StatusOK,
+		},
+		"user is forbidden": {
+			role:     model.RoleUser,
+			expected: http.StatusForbidden,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var (
+				userRepoMock = mocks.NewRepo(t)
+				passwordMock = passworkmocks.NewHelper(t)
+				deviceMock   = devicemocks.NewRepo(t)
+				jwtHelper    = jwthelper.NewHelper("secret")
+			)
+			userRepoMock.
+				EXPECT().
+				GetByEmail(mock.Anything, "admin@d.foundation").
+				Return(&model.User{ID: 1, Email: "admin@d.foundation", Role: string(tt.role)}, nil)
+			passwordMock.
+				EXPECT().
+				Compare(mock.Anything, mock.Anything, mock.Anything).
+				Return(true)
+			deviceMock.
+				EXPECT().
+				Touch(mock.Anything, 1, mock.Anything, mock.Anything).
+				Return(false, nil)
+
+			c := &impl{
+				repo: &repository.Repo{
+					User:       userRepoMock,
+					UserDevice: deviceMock,
+				},
+				jwtHelper:      jwtHelper,
+				passwordHelper: passwordMock,
+				cfg:            config.LoadTestConfig(),
+				log:            logger.NewLogger(),
+				monitor:        monitor.TestMonitor(),
+			}
+
+			_, err := db.Init(c.cfg)
+			require.NoError(t, err)
+
+			rs, err := c.Login(context.Background(), model.LoginRequest{Email: "admin@d.foundation", Password: "123456"})
+			require.NoError(t, err)
+
+			// the token goes through the middleware chain of the admin routes
+			authMw := middleware.NewAuthMiddleware(jwtHelper)
+			r := gin.New()
+			r.GET("/admin", authMw.WithAuth, middleware.WithRole(model.RoleAdmin), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/admin", nil)
+			req.Header.Set("Authorization", "Bearer "+rs.AccessToken)
+			r.ServeHTTP(w, req)
+			require.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
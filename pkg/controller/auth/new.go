@@ -4,9 +4,13 @@ import (
 	"context"
 
 	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/logger"
 	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/mailing"
 	"github.com/dwarvesf/go-api/pkg/model"
 	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/service"
+	"github.com/dwarvesf/go-api/pkg/service/eventbus"
 	"github.com/dwarvesf/go-api/pkg/service/jwthelper"
 	"github.com/dwarvesf/go-api/pkg/service/passwordhelper"
 )
@@ -19,18 +23,24 @@ type Controller interface {
 
 type impl struct {
 	repo           *repository.Repo
+	outbox         mailing.Outbox
+	bus            eventbus.Bus
 	jwtHelper      jwthelper.Helper
 	cfg            config.Config
+	log            logger.Log
 	monitor        monitor.Tracer
 	passwordHelper passwordhelper.Helper
 }
 
 // NewAuthController new auth controller
-func NewAuthController(cfg config.Config, r *repository.Repo, monitor monitor.Tracer) Controller {
+func NewAuthController(cfg config.Config, l logger.Log, r *repository.Repo, svc service.Service, bus eventbus.Bus, monitor monitor.Tracer) Controller {
 	return &impl{
 		repo:           r,
+		outbox:         mailing.NewOutbox(cfg, r, svc.MailTemplates),
+		bus:            bus,
 		jwtHelper:      jwthelper.NewHelper(cfg.SecretKey),
 		cfg:            cfg,
+		log:            l,
 		monitor:        monitor,
 		passwordHelper: passwordhelper.NewScrypt(),
 	}
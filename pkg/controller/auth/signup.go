@@ -2,14 +2,16 @@ package auth
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 
 	"github.com/dwarvesf/go-api/pkg/model"
 	"github.com/dwarvesf/go-api/pkg/repository/db"
 )
 
-func (c impl) Signup(ctx context.Context, req model.SignupRequest) (err error) {
+// welcomeTemplate is the name of the template of the mail sent on signup
+const welcomeTemplate = "welcome"
+
+func (c impl) Signup(ctx context.Context, req model.SignupRequest) error {
 	const spanName = "SignupController"
 	ctx, span := c.monitor.Start(ctx, spanName)
 	defer span.End()
@@ -23,22 +25,26 @@ func (c impl) Signup(ctx context.Context, req model.SignupRequest) (err error) {
 	req.Role = model.RoleUser
 	req.Status = model.StatusActive
 
-	dbCtx, finalFn := db.NewTransaction(ctx)
-	defer finalFn(err)
-
-	//  check if email is existed
-	_, err = c.repo.User.GetByEmail(dbCtx, req.Email)
-	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return err
-	}
-	if err == nil {
-		return model.ErrEmailExisted
-	}
+	return db.Transaction(ctx, func(dbCtx db.Context) error {
+		//  check if email is existed
+		_, err := c.repo.User.GetByEmail(dbCtx, req.Email)
+		if err != nil && !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+		if err == nil {
+			return model.ErrEmailExisted
+		}
+
+		if _, err := c.repo.User.Create(dbCtx, req); err != nil {
+			return err
+		}
+
+		// the mail is only sent once the user is committed
+		_, err = c.outbox.Enqueue(dbCtx, req.Email, welcomeTemplate, map[string]string{
+			"Name":  req.Name,
+			"Email": req.Email,
+		})
 
-	_, err = c.repo.User.Create(dbCtx, req)
-	if err != nil {
 		return err
-	}
-
-	return nil
+	})
 }
@@ -1,10 +1,10 @@
 package auth
 
 import (
-	"database/sql"
 	"net/http/httptest"
 	"testing"
 
+	mailingmocks "github.com/dwarvesf/go-api/mocks/pkg/mailing"
 	mocks "github.com/dwarvesf/go-api/mocks/pkg/repository/user"
 	jwtmocks "github.com/dwarvesf/go-api/mocks/pkg/service/jwthelper"
 	passworkmocks "github.com/dwarvesf/go-api/mocks/pkg/service/passwordhelper"
@@ -31,6 +31,8 @@ func Test_impl_Signup(t *testing.T) {
 		hashCalled          bool
 		hash                string
 		hashErr             error
+		expEnqueueCalled    bool
+		enqueueErr          error
 	}
 	type args struct {
 		req  model.SignupRequest
@@ -48,7 +50,7 @@ func Test_impl_Signup(t *testing.T) {
 				hashCalled:          true,
 				hash:                "hash",
 				expGetUserCalled:    true,
-				getUserErr:          sql.ErrNoRows,
+				getUserErr:          model.ErrNotFound,
 				expCreateUserCalled: true,
 				createUser: &model.User{
 					Email:          "admin@d.foundation",
@@ -59,6 +61,7 @@ func Test_impl_Signup(t *testing.T) {
 					HashedPassword: "hash",
 					Salt:           "abcdef",
 				},
+				expEnqueueCalled: true,
 			},
 			args: args{
 				req: model.SignupRequest{
@@ -107,6 +110,7 @@ func Test_impl_Signup(t *testing.T) {
 				userRepoMock = mocks.NewRepo(t)
 				jwtMock      = jwtmocks.NewHelper(t)
 				passwordMock = passworkmocks.NewHelper(t)
+				outboxMock   = mailingmocks.NewOutbox(t)
 			)
 
 			if tt.mocked.genSaltCalled {
@@ -137,10 +141,18 @@ func Test_impl_Signup(t *testing.T) {
 					Return(tt.mocked.createUser, tt.mocked.createUserErr)
 			}
 
+			if tt.mocked.expEnqueueCalled {
+				outboxMock.
+					EXPECT().
+					Enqueue(mock.Anything, tt.args.req.Email, welcomeTemplate, mock.Anything).
+					Return(&model.MailOutboxMessage{ID: 1}, tt.mocked.enqueueErr)
+			}
+
 			c := &impl{
 				repo: &repository.Repo{
 					User: userRepoMock,
 				},
+				outbox:         outboxMock,
 				jwtHelper:      jwtMock,
 				passwordHelper: passwordMock,
 				cfg:            config.LoadTestConfig(),
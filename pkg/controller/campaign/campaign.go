@@ -0,0 +1,138 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/service/mailer"
+)
+
+// sampleUser is the recipient of the sample when the audience is empty
+var sampleUser = model.User{
+	FullName: "Jane Doe",
+	Email:    "jane.doe@example.com",
+}
+
+func (c *impl) List(ctx context.Context) ([]model.Campaign, error) {
+	const spanName = "ListCampaignsController"
+	ctx, span := c.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	return c.repo.Campaign.GetList(db.FromContext(ctx))
+}
+
+func (c *impl) Get(ctx context.Context, id int) (*model.Campaign, error) {
+	const spanName = "GetCampaignController"
+	ctx, span := c.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	return c.repo.Campaign.GetByID(db.FromContext(ctx), id)
+}
+
+func (c *impl) Create(ctx context.Context, req model.CampaignRequest) (*model.Campaign, error) {
+	const spanName = "CreateCampaignController"
+	ctx, span := c.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	if err := c.validateTemplate(req.Template); err != nil {
+		return nil, err
+	}
+
+	return c.repo.Campaign.Create(db.FromContext(ctx), req)
+}
+
+func (c *impl) Update(ctx context.Context, id int, req model.CampaignRequest) (*model.Campaign, error) {
+	const spanName = "UpdateCampaignController"
+	ctx, span := c.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	if err := c.validateTemplate(req.Template); err != nil {
+		return nil, err
+	}
+
+	return c.repo.Campaign.Update(db.FromContext(ctx), id, req)
+}
+
+func (c *impl) Schedule(ctx context.Context, id int, at time.Time) (*model.Campaign, error) {
+	const spanName = "ScheduleCampaignController"
+	ctx, span := c.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	if !at.After(time.Now()) {
+		return nil, model.ErrInvalidScheduleTime
+	}
+
+	dbCtx := db.FromContext(ctx)
+	if err := c.repo.Campaign.Schedule(dbCtx, id, at); err != nil {
+		return nil, err
+	}
+
+	return c.repo.Campaign.GetByID(dbCtx, id)
+}
+
+func (c *impl) Unschedule(ctx context.Context, id int) (*model.Campaign, error) {
+	const spanName = "UnscheduleCampaignController"
+	ctx, span := c.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	dbCtx := db.FromContext(ctx)
+	if err := c.repo.Campaign.Unschedule(dbCtx, id); err != nil {
+		return nil, err
+	}
+
+	return c.repo.Campaign.GetByID(dbCtx, id)
+}
+
+// Preview returns the number of users the campaign would be sent to now.
+func (c *impl) Preview(ctx context.Context, id int) (int64, error) {
+	const spanName = "PreviewCampaignController"
+	ctx, span := c.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	// the count scans the users, it's served by a replica
+	dbCtx := db.ReadOnlyFromContext(ctx)
+	campaign, err := c.repo.Campaign.GetByID(dbCtx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.repo.User.CountAudience(dbCtx, toDelivery(*campaign).Audience)
+}
+
+// Sample renders the mail of the campaign for its first recipient.
+func (c *impl) Sample(ctx context.Context, id int) (*mailer.Message, error) {
+	const spanName = "SampleCampaignController"
+	ctx, span := c.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	dbCtx := db.FromContext(ctx)
+	campaign, err := c.repo.Campaign.GetByID(dbCtx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	d := toDelivery(*campaign)
+	users, err := c.repo.User.GetListAfter(dbCtx, d.Audience, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+	u := sampleUser
+	if len(users) > 0 {
+		u = users[0]
+	}
+
+	return c.sender.Render(d, u)
+}
+
+// validateTemplate checks the template exists by rendering it for the sample user.
+func (c *impl) validateTemplate(name string) error {
+	_, err := c.sender.Render(toDelivery(model.Campaign{Template: name}), sampleUser)
+	if errors.Is(err, mailer.ErrTemplateNotFound) {
+		return model.ErrTemplateNotFound
+	}
+
+	return err
+}
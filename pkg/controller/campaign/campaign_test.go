@@ -0,0 +1,155 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	campaignmocks "github.com/dwarvesf/go-api/mocks/pkg/repository/campaign"
+	usermocks "github.com/dwarvesf/go-api/mocks/pkg/repository/user"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/mailing"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/service/mailer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestController(t *testing.T, r *repository.Repo) *impl {
+	cfg := config.LoadTestConfig()
+	cfg.BaseURL = "api.d.foundation"
+	cfg.SecretKey = "secret"
+
+	_, err := db.Init(cfg)
+	require.NoError(t, err)
+
+	return &impl{
+		repo:    r,
+		sender:  mailing.NewSender(cfg, r, nil, mailer.DefaultRenderer()),
+		cfg:     cfg,
+		monitor: monitor.TestMonitor(),
+	}
+}
+
+func Test_impl_Create(t *testing.T) {
+	tests := map[string]struct {
+		req       model.CampaignRequest
+		expCreate bool
+		createErr error
+		wantErr   error
+	}{
+		"success": {
+			req:       model.CampaignRequest{Name: "Welcome", Template: "sent_mail"},
+			expCreate: true,
+		},
+		"unknown template": {
+			req:     model.CampaignRequest{Name: "Welcome", Template: "unknown"},
+			wantErr: model.ErrTemplateNotFound,
+		},
+		"failed to create": {
+			req:       model.CampaignRequest{Name: "Welcome", Template: "sent_mail"},
+			expCreate: true,
+			createErr: errors.New("failed to create"),
+			wantErr:   errors.New("failed to create"),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			campaignRepoMock := campaignmocks.NewRepo(t)
+			if tt.expCreate {
+				campaignRepoMock.
+					EXPECT().
+					Create(mock.Anything, tt.req).
+					Return(&model.Campaign{ID: 1, Name: tt.req.Name}, tt.createErr)
+			}
+
+			c := newTestController(t, &repository.Repo{Campaign: campaignRepoMock})
+			_, err := c.Create(context.Background(), tt.req)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_impl_Schedule(t *testing.T) {
+	campaignRepoMock := campaignmocks.NewRepo(t)
+	c := newTestController(t, &repository.Repo{Campaign: campaignRepoMock})
+
+	t.Run("in the past", func(t *testing.T) {
+		_, err := c.Schedule(context.Background(), 1, time.Now().Add(-time.Minute))
+		assert.ErrorIs(t, err, model.ErrInvalidScheduleTime)
+	})
+
+	t.Run("not a draft", func(t *testing.T) {
+		campaignRepoMock.
+			EXPECT().
+			Schedule(mock.Anything, 1, mock.Anything).
+			Return(model.ErrCampaignNotDraft).
+			Once()
+
+		_, err := c.Schedule(context.Background(), 1, time.Now().Add(time.Hour))
+		assert.ErrorIs(t, err, model.ErrCampaignNotDraft)
+	})
+}
+
+func Test_impl_Preview(t *testing.T) {
+	campaignRepoMock := campaignmocks.NewRepo(t)
+	userRepoMock := usermocks.NewRepo(t)
+	campaignRepoMock.
+		EXPECT().
+		GetByID(mock.Anything, 1).
+		Return(&model.Campaign{ID: 1, Template: "sent_mail", Audience: model.AudienceFilter{Role: model.RoleUser}}, nil)
+	userRepoMock.
+		EXPECT().
+		CountAudience(mock.Anything, model.AudienceFilter{Role: model.RoleUser, Subscribed: true}).
+		Return(int64(12), nil)
+
+	c := newTestController(t, &repository.Repo{Campaign: campaignRepoMock, User: userRepoMock})
+	got, err := c.Preview(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), got)
+}
+
+func Test_impl_Sample(t *testing.T) {
+	tests := map[string]struct {
+		users  []model.User
+		wantTo string
+	}{
+		"first recipient": {
+			users:  []model.User{{ID: 3, Email: "tom@d.foundation", FullName: "Tom"}},
+			wantTo: "tom@d.foundation",
+		},
+		"empty audience": {
+			users:  []model.User{},
+			wantTo: "jane.doe@example.com",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			campaignRepoMock := campaignmocks.NewRepo(t)
+			userRepoMock := usermocks.NewRepo(t)
+			campaignRepoMock.
+				EXPECT().
+				GetByID(mock.Anything, 1).
+				Return(&model.Campaign{ID: 1, Template: "sent_mail"}, nil)
+			userRepoMock.
+				EXPECT().
+				GetListAfter(mock.Anything, model.AudienceFilter{Subscribed: true}, 0, 1).
+				Return(tt.users, nil)
+
+			c := newTestController(t, &repository.Repo{Campaign: campaignRepoMock, User: userRepoMock})
+			got, err := c.Sample(context.Background(), 1)
+			require.NoError(t, err)
+			assert.Equal(t, []string{tt.wantTo}, got.To)
+			assert.Contains(t, got.Text, "Unsubscribe: https://api.d.foundation/")
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package campaign
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/mailing"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/service"
+	"github.com/dwarvesf/go-api/pkg/service/mailer"
+)
+
+// Controller campaign controller
+type Controller interface {
+	List(ctx context.Context) ([]model.Campaign, error)
+	Get(ctx context.Context, id int) (*model.Campaign, error)
+	Create(ctx context.Context, req model.CampaignRequest) (*model.Campaign, error)
+	Update(ctx context.Context, id int, req model.CampaignRequest) (*model.Campaign, error)
+	Schedule(ctx context.Context, id int, at time.Time) (*model.Campaign, error)
+	Unschedule(ctx context.Context, id int) (*model.Campaign, error)
+	Preview(ctx context.Context, id int) (int64, error)
+	Sample(ctx context.Context, id int) (*mailer.Message, error)
+	Dispatch(ctx context.Context, now time.Time) ([]model.Campaign, error)
+	Send(ctx context.Context, id int) (*model.SentMailReport, error)
+	Fail(ctx context.Context, id int) error
+}
+
+type impl struct {
+	repo    *repository.Repo
+	sender  mailing.Sender
+	cfg     config.Config
+	monitor monitor.Tracer
+}
+
+// NewCampaignController new campaign controller
+func NewCampaignController(cfg config.Config, r *repository.Repo, svc service.Service, monitor monitor.Tracer) Controller {
+	return &impl{
+		repo:    r,
+		sender:  mailing.NewSender(cfg, r, svc.Mailer, svc.MailTemplates),
+		cfg:     cfg,
+		monitor: monitor,
+	}
+}
+
+// toDelivery returns the delivery of the campaign, the mails have an unsubscribe
+// link and the users who unsubscribed are left out of the audience.
+func toDelivery(c model.Campaign) mailing.Delivery {
+	audience := c.Audience
+	audience.Subscribed = true
+
+	return mailing.Delivery{
+		Campaign:    "campaign-" + strconv.Itoa(c.ID),
+		Template:    c.Template,
+		Audience:    audience,
+		Unsubscribe: true,
+	}
+}
@@ -0,0 +1,62 @@
+package campaign
+
+import (
+	"context"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+)
+
+// Dispatch starts the scheduled campaigns whose send time has come and returns
+// every campaign being sent, including the ones a previous dispatch started.
+func (c *impl) Dispatch(ctx context.Context, now time.Time) ([]model.Campaign, error) {
+	const spanName = "DispatchCampaignsController"
+	ctx, span := c.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	dbCtx := db.FromContext(ctx)
+	if _, err := c.repo.Campaign.ClaimDue(dbCtx, now); err != nil {
+		return nil, err
+	}
+
+	return c.repo.Campaign.GetListByStatus(dbCtx, model.CampaignStatusSending)
+}
+
+// Send sends the campaign to its audience and marks it done,
+// a failed send resumes where it stopped.
+func (c *impl) Send(ctx context.Context, id int) (*model.SentMailReport, error) {
+	const spanName = "SendCampaignController"
+	ctx, span := c.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	dbCtx := db.FromContext(ctx)
+	campaign, err := c.repo.Campaign.GetByID(dbCtx, id)
+	if err != nil {
+		return nil, err
+	}
+	if campaign.Status != model.CampaignStatusSending && campaign.Status != model.CampaignStatusDone {
+		return nil, model.ErrCampaignNotStarted
+	}
+
+	rs, err := c.sender.Send(ctx, toDelivery(*campaign))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.repo.Campaign.Finish(dbCtx, id, rs.FinishedAt); err != nil {
+		return nil, err
+	}
+
+	return rs, nil
+}
+
+// Fail marks the campaign as failed once its send job is dead, so the
+// next dispatches don't pick it up again.
+func (c *impl) Fail(ctx context.Context, id int) error {
+	const spanName = "FailCampaignController"
+	ctx, span := c.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	return c.repo.Campaign.Fail(db.FromContext(ctx), id, time.Now())
+}
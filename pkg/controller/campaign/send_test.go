@@ -0,0 +1,131 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mailingmocks "github.com/dwarvesf/go-api/mocks/pkg/mailing"
+	campaignmocks "github.com/dwarvesf/go-api/mocks/pkg/repository/campaign"
+	"github.com/dwarvesf/go-api/pkg/mailing"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_Dispatch(t *testing.T) {
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	sending := []model.Campaign{{ID: 1, Status: model.CampaignStatusSending}, {ID: 2, Status: model.CampaignStatusSending}}
+
+	campaignRepoMock := campaignmocks.NewRepo(t)
+	campaignRepoMock.
+		EXPECT().
+		ClaimDue(mock.Anything, now).
+		Return(sending[1:], nil)
+	campaignRepoMock.
+		EXPECT().
+		GetListByStatus(mock.Anything, model.CampaignStatusSending).
+		Return(sending, nil)
+
+	c := newTestController(t, &repository.Repo{Campaign: campaignRepoMock})
+	got, err := c.Dispatch(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, sending, got)
+}
+
+func Test_impl_Send(t *testing.T) {
+	finishedAt := time.Date(2026, 10, 19, 9, 5, 0, 0, time.UTC)
+	report := &model.SentMailReport{Campaign: "campaign-1", Sent: 10, FinishedAt: finishedAt}
+
+	type mocked struct {
+		campaign  *model.Campaign
+		expSend   bool
+		sendErr   error
+		expFinish bool
+	}
+	tests := map[string]struct {
+		mocked  mocked
+		want    *model.SentMailReport
+		wantErr error
+	}{
+		"success": {
+			mocked: mocked{
+				campaign:  &model.Campaign{ID: 1, Template: "sent_mail", Status: model.CampaignStatusSending},
+				expSend:   true,
+				expFinish: true,
+			},
+			want: report,
+		},
+		"not started": {
+			mocked: mocked{
+				campaign: &model.Campaign{ID: 1, Template: "sent_mail", Status: model.CampaignStatusScheduled},
+			},
+			wantErr: model.ErrCampaignNotStarted,
+		},
+		"failed to send": {
+			mocked: mocked{
+				campaign: &model.Campaign{ID: 1, Template: "sent_mail", Status: model.CampaignStatusSending},
+				expSend:  true,
+				sendErr:  errors.New("failed to send"),
+			},
+			wantErr: errors.New("failed to send"),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			campaignRepoMock := campaignmocks.NewRepo(t)
+			senderMock := mailingmocks.NewSender(t)
+
+			campaignRepoMock.
+				EXPECT().
+				GetByID(mock.Anything, 1).
+				Return(tt.mocked.campaign, nil)
+			if tt.mocked.expSend {
+				var rs *model.SentMailReport
+				if tt.mocked.sendErr == nil {
+					rs = report
+				}
+				senderMock.
+					EXPECT().
+					Send(mock.Anything, mailing.Delivery{
+						Campaign:    "campaign-1",
+						Template:    "sent_mail",
+						Audience:    model.AudienceFilter{Subscribed: true},
+						Unsubscribe: true,
+					}).
+					Return(rs, tt.mocked.sendErr)
+			}
+			if tt.mocked.expFinish {
+				campaignRepoMock.
+					EXPECT().
+					Finish(mock.Anything, 1, finishedAt).
+					Return(nil)
+			}
+
+			c := newTestController(t, &repository.Repo{Campaign: campaignRepoMock})
+			c.sender = senderMock
+
+			got, err := c.Send(context.Background(), 1)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_impl_Fail(t *testing.T) {
+	campaignRepoMock := campaignmocks.NewRepo(t)
+	campaignRepoMock.
+		EXPECT().
+		Fail(mock.Anything, 1, mock.Anything).
+		Return(nil)
+
+	c := newTestController(t, &repository.Repo{Campaign: campaignRepoMock})
+	require.NoError(t, c.Fail(context.Background(), 1))
+}
@@ -0,0 +1,46 @@
+package mail
+
+import (
+	"context"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+)
+
+// HandleEvents marks the addresses of the bounces and complaints reported by
+// the mail provider as undeliverable, and the bounced mails of the outbox as bounced.
+// Every step is idempotent so the provider can deliver the same event again.
+func (c *impl) HandleEvents(ctx context.Context, events []model.MailEvent) error {
+	const spanName = "HandleMailEventsController"
+	ctx, span := c.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	for _, e := range events {
+		if e.Email == "" || (e.Type != model.MailEventTypeBounce && e.Type != model.MailEventTypeComplaint) {
+			return model.ErrInvalidMailEvent
+		}
+	}
+
+	dbCtx := db.FromContext(ctx)
+	for _, e := range events {
+		err := c.repo.MailSuppression.Save(dbCtx, model.MailSuppression{
+			Email:  e.Email,
+			Reason: e.Type,
+			Detail: e.Detail,
+		})
+		if err != nil {
+			return err
+		}
+
+		// a complaint is about a delivered mail, only a bounce changes its status
+		if e.Type != model.MailEventTypeBounce || e.MessageID == "" {
+			continue
+		}
+		if _, err := c.repo.MailOutbox.MarkBounced(dbCtx, e.MessageID, time.Now(), e.Detail); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
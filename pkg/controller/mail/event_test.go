@@ -0,0 +1,111 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	outboxmocks "github.com/dwarvesf/go-api/mocks/pkg/repository/mailoutbox"
+	suppressionmocks "github.com/dwarvesf/go-api/mocks/pkg/repository/mailsuppression"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_HandleEvents(t *testing.T) {
+	type mocked struct {
+		suppressed []model.MailSuppression
+		saveErr    error
+		bouncedIDs []string
+		bouncedErr error
+	}
+	tests := map[string]struct {
+		events  []model.MailEvent
+		mocked  mocked
+		wantErr error
+	}{
+		"bounce and complaint": {
+			events: []model.MailEvent{
+				{Type: model.MailEventTypeBounce, Email: "a@d.foundation", MessageID: "<1@d.foundation>", Detail: "mailbox full"},
+				{Type: model.MailEventTypeComplaint, Email: "b@d.foundation", MessageID: "<2@d.foundation>"},
+			},
+			mocked: mocked{
+				suppressed: []model.MailSuppression{
+					{Email: "a@d.foundation", Reason: model.MailEventTypeBounce, Detail: "mailbox full"},
+					{Email: "b@d.foundation", Reason: model.MailEventTypeComplaint},
+				},
+				bouncedIDs: []string{"<1@d.foundation>"},
+			},
+		},
+		"bounce without message id": {
+			events: []model.MailEvent{
+				{Type: model.MailEventTypeBounce, Email: "a@d.foundation"},
+			},
+			mocked: mocked{
+				suppressed: []model.MailSuppression{
+					{Email: "a@d.foundation", Reason: model.MailEventTypeBounce},
+				},
+			},
+		},
+		"unknown type": {
+			events: []model.MailEvent{
+				{Type: model.MailEventTypeBounce, Email: "a@d.foundation"},
+				{Type: "delivered", Email: "b@d.foundation"},
+			},
+			wantErr: model.ErrInvalidMailEvent,
+		},
+		"missing address": {
+			events: []model.MailEvent{
+				{Type: model.MailEventTypeBounce},
+			},
+			wantErr: model.ErrInvalidMailEvent,
+		},
+		"failed to save": {
+			events: []model.MailEvent{
+				{Type: model.MailEventTypeBounce, Email: "a@d.foundation", MessageID: "<1@d.foundation>"},
+			},
+			mocked: mocked{
+				suppressed: []model.MailSuppression{
+					{Email: "a@d.foundation", Reason: model.MailEventTypeBounce},
+				},
+				saveErr: errors.New("failed to save"),
+			},
+			wantErr: errors.New("failed to save"),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			outboxMock := outboxmocks.NewRepo(t)
+			suppressionMock := suppressionmocks.NewRepo(t)
+			for _, s := range tt.mocked.suppressed {
+				suppressionMock.EXPECT().Save(mock.Anything, s).Return(tt.mocked.saveErr)
+			}
+			for _, id := range tt.mocked.bouncedIDs {
+				outboxMock.EXPECT().MarkBounced(mock.Anything, id, mock.Anything, mock.Anything).Return(1, tt.mocked.bouncedErr)
+			}
+
+			c := &impl{
+				repo: &repository.Repo{
+					MailOutbox:      outboxMock,
+					MailSuppression: suppressionMock,
+				},
+				cfg:     config.LoadTestConfig(),
+				monitor: monitor.TestMonitor(),
+			}
+
+			_, err := db.Init(c.cfg)
+			require.NoError(t, err)
+
+			err = c.HandleEvents(context.Background(), tt.events)
+			if tt.wantErr != nil {
+				require.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
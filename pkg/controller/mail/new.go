@@ -0,0 +1,30 @@
+package mail
+
+import (
+	"context"
+
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository"
+)
+
+// Controller mail controller
+type Controller interface {
+	HandleEvents(ctx context.Context, events []model.MailEvent) error
+}
+
+type impl struct {
+	repo    *repository.Repo
+	cfg     config.Config
+	monitor monitor.Tracer
+}
+
+// NewMailController new mail controller
+func NewMailController(cfg config.Config, r *repository.Repo, monitor monitor.Tracer) Controller {
+	return &impl{
+		repo:    r,
+		cfg:     cfg,
+		monitor: monitor,
+	}
+}
@@ -0,0 +1,16 @@
+package schedule
+
+import (
+	"context"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+)
+
+func (c *impl) List(ctx context.Context) ([]model.Schedule, error) {
+	const spanName = "ListSchedulesController"
+	ctx, span := c.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	return c.repo.Schedule.GetList(db.FromContext(ctx))
+}
@@ -0,0 +1,71 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	mocks "github.com/dwarvesf/go-api/mocks/pkg/repository/schedule"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_List(t *testing.T) {
+	type mocked struct {
+		schedules []model.Schedule
+		listErr   error
+	}
+	tests := map[string]struct {
+		mocked  mocked
+		want    []model.Schedule
+		wantErr bool
+	}{
+		"success": {
+			mocked: mocked{
+				schedules: []model.Schedule{{Name: "user.sent_mail", Spec: "0 0 * * *"}},
+			},
+			want: []model.Schedule{{Name: "user.sent_mail", Spec: "0 0 * * *"}},
+		},
+		"failed": {
+			mocked: mocked{
+				listErr: errors.New("connection refused"),
+			},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			scheduleRepoMock := mocks.NewRepo(t)
+			scheduleRepoMock.
+				EXPECT().
+				GetList(mock.Anything).
+				Return(tt.mocked.schedules, tt.mocked.listErr)
+
+			c := &impl{
+				repo: &repository.Repo{
+					Schedule: scheduleRepoMock,
+				},
+				cfg:     config.LoadTestConfig(),
+				monitor: monitor.TestMonitor(),
+			}
+
+			_, err := db.Init(c.cfg)
+			require.NoError(t, err)
+
+			got, err := c.List(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("impl.List() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("impl.List() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
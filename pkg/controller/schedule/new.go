@@ -0,0 +1,30 @@
+package schedule
+
+import (
+	"context"
+
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository"
+)
+
+// Controller schedule controller
+type Controller interface {
+	List(ctx context.Context) ([]model.Schedule, error)
+}
+
+type impl struct {
+	repo    *repository.Repo
+	cfg     config.Config
+	monitor monitor.Tracer
+}
+
+// NewScheduleController new schedule controller
+func NewScheduleController(cfg config.Config, r *repository.Repo, monitor monitor.Tracer) Controller {
+	return &impl{
+		repo:    r,
+		cfg:     cfg,
+		monitor: monitor,
+	}
+}
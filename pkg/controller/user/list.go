@@ -0,0 +1,18 @@
+package user
+
+import (
+	"context"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+)
+
+// List returns a page of the users
+func (c *impl) List(ctx context.Context, q model.ListQuery) (*model.ListResult[model.User], error) {
+	const spanName = "ListUsersController"
+	ctx, span := c.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	// the count and the search are served by a replica
+	return c.repo.User.GetList(db.ReadOnlyFromContext(ctx), q)
+}
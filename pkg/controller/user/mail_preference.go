@@ -0,0 +1,61 @@
+package user
+
+import (
+	"context"
+
+	"github.com/dwarvesf/go-api/pkg/mailing"
+	"github.com/dwarvesf/go-api/pkg/middleware"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+)
+
+func (c *impl) GetMailPreference(ctx context.Context) (*model.MailPreference, error) {
+	const spanName = "GetMailPreferenceController"
+	ctx, span := c.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	uID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, model.ErrInvalidToken
+	}
+
+	return c.repo.MailPreference.Get(db.FromContext(ctx), uID)
+}
+
+func (c *impl) UpdateMailPreference(ctx context.Context, campaigns bool) (*model.MailPreference, error) {
+	const spanName = "UpdateMailPreferenceController"
+	ctx, span := c.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	uID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, model.ErrInvalidToken
+	}
+
+	p := model.MailPreference{
+		UserID:    uID,
+		Campaigns: campaigns,
+	}
+	if err := c.repo.MailPreference.Save(db.FromContext(ctx), p); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// Unsubscribe stops the campaigns of the user the token of the unsubscribe link was issued for.
+func (c *impl) Unsubscribe(ctx context.Context, token string) error {
+	const spanName = "UnsubscribeController"
+	ctx, span := c.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	uID, err := mailing.ParseUnsubscribeToken(c.cfg.SecretKey, token)
+	if err != nil {
+		return err
+	}
+
+	return c.repo.MailPreference.Save(db.FromContext(ctx), model.MailPreference{
+		UserID:    uID,
+		Campaigns: false,
+	})
+}
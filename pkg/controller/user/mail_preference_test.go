@@ -0,0 +1,123 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mocks "github.com/dwarvesf/go-api/mocks/pkg/repository/mailpreference"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/mailing"
+	"github.com/dwarvesf/go-api/pkg/middleware"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_UpdateMailPreference(t *testing.T) {
+	tests := map[string]struct {
+		uID       int
+		campaigns bool
+		expSave   bool
+		saveErr   error
+		want      *model.MailPreference
+		wantErr   bool
+	}{
+		"success": {
+			uID:       1,
+			campaigns: false,
+			expSave:   true,
+			want:      &model.MailPreference{UserID: 1, Campaigns: false},
+		},
+		"failed to save": {
+			uID:       1,
+			campaigns: true,
+			expSave:   true,
+			saveErr:   errors.New("failed to save"),
+			wantErr:   true,
+		},
+		"unauthenticated": {
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			prefRepoMock := mocks.NewRepo(t)
+			if tt.expSave {
+				prefRepoMock.
+					EXPECT().
+					Save(mock.Anything, model.MailPreference{UserID: tt.uID, Campaigns: tt.campaigns}).
+					Return(tt.saveErr)
+			}
+
+			c := &impl{
+				repo:    &repository.Repo{MailPreference: prefRepoMock},
+				cfg:     config.LoadTestConfig(),
+				monitor: monitor.TestMonitor(),
+			}
+			_, err := db.Init(c.cfg)
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			if tt.uID != 0 {
+				ctx = context.WithValue(ctx, middleware.UserIDCtxKey, tt.uID)
+			}
+			got, err := c.UpdateMailPreference(ctx, tt.campaigns)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("impl.UpdateMailPreference() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_impl_Unsubscribe(t *testing.T) {
+	cfg := config.LoadTestConfig()
+	cfg.SecretKey = "secret"
+
+	tests := map[string]struct {
+		token   string
+		expSave bool
+		wantErr error
+	}{
+		"success": {
+			token:   mailing.UnsubscribeToken("secret", 1),
+			expSave: true,
+		},
+		"invalid token": {
+			token:   mailing.UnsubscribeToken("other", 1),
+			wantErr: model.ErrInvalidToken,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			prefRepoMock := mocks.NewRepo(t)
+			if tt.expSave {
+				prefRepoMock.
+					EXPECT().
+					Save(mock.Anything, model.MailPreference{UserID: 1, Campaigns: false}).
+					Return(nil)
+			}
+
+			c := &impl{
+				repo:    &repository.Repo{MailPreference: prefRepoMock},
+				cfg:     cfg,
+				monitor: monitor.TestMonitor(),
+			}
+			_, err := db.Init(c.cfg)
+			require.NoError(t, err)
+
+			err = c.Unsubscribe(context.Background(), tt.token)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
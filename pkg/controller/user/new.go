@@ -5,8 +5,11 @@ import (
 
 	"github.com/dwarvesf/go-api/pkg/config"
 	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/mailing"
 	"github.com/dwarvesf/go-api/pkg/model"
 	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/service"
+	"github.com/dwarvesf/go-api/pkg/service/eventbus"
 )
 
 // Controller auth controller
@@ -14,19 +17,27 @@ type Controller interface {
 	Me(ctx context.Context) (*model.User, error)
 	UpdateUser(ctx context.Context, user model.UpdateUserRequest) (*model.User, error)
 	UpdatePassword(ctx context.Context, user model.UpdatePasswordRequest) error
-	SentMail(ctx context.Context) error
+	SentMail(ctx context.Context, campaign string) (*model.SentMailReport, error)
+	GetMailPreference(ctx context.Context) (*model.MailPreference, error)
+	UpdateMailPreference(ctx context.Context, campaigns bool) (*model.MailPreference, error)
+	Unsubscribe(ctx context.Context, token string) error
+	List(ctx context.Context, q model.ListQuery) (*model.ListResult[model.User], error)
 }
 
 type impl struct {
 	repo    *repository.Repo
+	sender  mailing.Sender
+	bus     eventbus.Bus
 	cfg     config.Config
 	monitor monitor.Tracer
 }
 
 // NewUserController new auth controller
-func NewUserController(cfg config.Config, r *repository.Repo, monitor monitor.Tracer) Controller {
+func NewUserController(cfg config.Config, r *repository.Repo, svc service.Service, bus eventbus.Bus, monitor monitor.Tracer) Controller {
 	return &impl{
 		repo:    r,
+		sender:  mailing.NewSender(cfg, r, svc.Mailer, svc.MailTemplates),
+		bus:     bus,
 		cfg:     cfg,
 		monitor: monitor,
 	}
@@ -2,43 +2,32 @@ package user
 
 import (
 	"context"
-	"fmt"
+	"time"
 
+	"github.com/dwarvesf/go-api/pkg/mailing"
 	"github.com/dwarvesf/go-api/pkg/model"
-	"github.com/dwarvesf/go-api/pkg/repository/db"
 )
 
-const pageSize = 10
+// sentMailTemplate is the name of the template of the mail
+const sentMailTemplate = "sent_mail"
 
-func (c *impl) SentMail(ctx context.Context) error {
-	const spanName = "LoginController"
+// SentMail sends the mail of the campaign to every user, a failed run resumes where it stopped.
+func (c *impl) SentMail(ctx context.Context, campaign string) (*model.SentMailReport, error) {
+	const spanName = "SentMailController"
 	ctx, span := c.monitor.Start(ctx, spanName)
 	defer span.End()
 
-	dbCtx := db.FromContext(ctx)
-
-	hashNext := true
-	page := 1
-	// while has next page
-	for hashNext {
-		userList, err := c.repo.User.GetList(dbCtx,
-			model.ListQuery{
-				Page:     page,
-				PageSize: pageSize,
-			},
-		)
-		if err != nil {
-			return err
-		}
-
-		for _, user := range userList.Data {
-			// fake sent mail to user
-			fmt.Println("Sent mail to user: ", user.Email)
-		}
-
-		hashNext = userList.Pagination.HasNext
-		page++
+	if campaign == "" {
+		campaign = DefaultCampaign(time.Now())
 	}
 
-	return nil
+	return c.sender.Send(ctx, mailing.Delivery{
+		Campaign: campaign,
+		Template: sentMailTemplate,
+	})
+}
+
+// DefaultCampaign returns the campaign of the daily mail.
+func DefaultCampaign(t time.Time) string {
+	return sentMailTemplate + "-" + t.UTC().Format("2006-01-02")
 }
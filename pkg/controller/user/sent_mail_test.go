@@ -4,121 +4,72 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
-	mocks "github.com/dwarvesf/go-api/mocks/pkg/repository/user"
+	mailingmocks "github.com/dwarvesf/go-api/mocks/pkg/mailing"
 	"github.com/dwarvesf/go-api/pkg/config"
 	"github.com/dwarvesf/go-api/pkg/logger/monitor"
-	"github.com/dwarvesf/go-api/pkg/middleware"
+	"github.com/dwarvesf/go-api/pkg/mailing"
 	"github.com/dwarvesf/go-api/pkg/model"
-	"github.com/dwarvesf/go-api/pkg/repository"
-	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
-	"github.com/stretchr/testify/require"
 )
 
 func Test_impl_SentMail(t *testing.T) {
 	type mocked struct {
-		uID              int
-		expGetListCalled bool
-		users            *model.ListResult[model.User]
-		GetListErr       error
-		countCalled      bool
-		countErr         error
-		count            int64
+		report  *model.SentMailReport
+		sendErr error
 	}
 	tests := map[string]struct {
-		mocked  mocked
-		want    *model.User
-		wantErr bool
+		campaign     string
+		mocked       mocked
+		wantCampaign string
+		want         *model.SentMailReport
+		wantErr      bool
 	}{
 		"success": {
-			mocked: mocked{
-				uID:              1,
-				expGetListCalled: true,
-				users: &model.ListResult[model.User]{
-					Pagination: model.Pagination{
-						Page:         1,
-						PageSize:     10,
-						TotalRecords: 1,
-						TotalPages:   1,
-						Offset:       0,
-						Sort:         "",
-						HasNext:      false,
-					},
-					Data: []model.User{
-						{
-							ID:             1,
-							Email:          "admin@d.foundation",
-							FullName:       "admin",
-							Status:         "active",
-							Avatar:         "https://d.foundation/avatar.png",
-							Role:           "admin",
-							HashedPassword: "hash",
-							Salt:           "abcdef",
-						},
-					},
-				},
-			},
-			want: &model.User{
-				ID:             1,
-				Email:          "admin@d.foundation",
-				FullName:       "admin1",
-				Status:         "active",
-				Avatar:         "https://d.foundation/avatar1.png",
-				Role:           "admin",
-				HashedPassword: "hash",
-				Salt:           "abcdef",
-			},
-			wantErr: false,
+			campaign:     "welcome",
+			mocked:       mocked{report: &model.SentMailReport{Campaign: "welcome", Sent: 2}},
+			wantCampaign: "welcome",
+			want:         &model.SentMailReport{Campaign: "welcome", Sent: 2},
 		},
-		"failed to get list": {
-			mocked: mocked{
-				uID:              1,
-				expGetListCalled: true,
-				GetListErr:       errors.New("failed to get list"),
-			},
-			wantErr: true,
+		"daily campaign": {
+			mocked:       mocked{report: &model.SentMailReport{Campaign: DefaultCampaign(time.Now()), Sent: 2}},
+			wantCampaign: DefaultCampaign(time.Now()),
+			want:         &model.SentMailReport{Campaign: DefaultCampaign(time.Now()), Sent: 2},
+		},
+		"failed to send": {
+			campaign:     "welcome",
+			mocked:       mocked{sendErr: errors.New("failed to get list")},
+			wantCampaign: "welcome",
+			wantErr:      true,
 		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			var (
-				userRepoMock = mocks.NewRepo(t)
-			)
-
-			if tt.mocked.countCalled {
-				userRepoMock.
-					EXPECT().
-					Count(mock.Anything).
-					Return(tt.mocked.count, tt.mocked.countErr)
-			}
-
-			if tt.mocked.expGetListCalled {
-				userRepoMock.
-					EXPECT().
-					GetList(mock.Anything, mock.Anything).
-					Return(tt.mocked.users, tt.mocked.GetListErr)
-			}
+			senderMock := mailingmocks.NewSender(t)
+			senderMock.
+				EXPECT().
+				Send(mock.Anything, mailing.Delivery{Campaign: tt.wantCampaign, Template: "sent_mail"}).
+				Return(tt.mocked.report, tt.mocked.sendErr)
 
 			c := &impl{
-				repo: &repository.Repo{
-					User: userRepoMock,
-				},
+				sender:  senderMock,
 				cfg:     config.LoadTestConfig(),
 				monitor: monitor.TestMonitor(),
 			}
 
-			_, err := db.Init(c.cfg)
-			require.NoError(t, err)
-
-			ctx := context.Background()
-			// assign userID to context
-			ctx = context.WithValue(ctx, middleware.UserIDCtxKey, tt.mocked.uID)
-			err = c.SentMail(ctx)
+			got, err := c.SentMail(context.Background(), tt.campaign)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("impl.SentMail() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
+
+func TestDefaultCampaign(t *testing.T) {
+	got := DefaultCampaign(time.Date(2026, 10, 19, 23, 0, 0, 0, time.FixedZone("ICT", -7*3600)))
+	assert.Equal(t, "sent_mail-2026-10-20", got)
+}
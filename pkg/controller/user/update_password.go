@@ -27,6 +27,18 @@ func (c impl) UpdatePassword(ctx context.Context, user model.UpdatePasswordReque
 		return model.ErrInvalidCredentials
 	}
 
-	err = c.repo.User.UpdatePassword(dbCtx, uID, user.NewPassword)
-	return err
+	if err := c.repo.User.UpdatePassword(dbCtx, uID, user.NewPassword); err != nil {
+		return err
+	}
+
+	c.bus.Publish(model.Event{
+		Type:   model.EventPasswordChanged,
+		UserID: uID,
+	})
+	c.bus.Publish(model.Event{
+		Type:   model.EventSessionRevoked,
+		UserID: uID,
+	})
+
+	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	mocks "github.com/dwarvesf/go-api/mocks/pkg/repository/user"
+	busmocks "github.com/dwarvesf/go-api/mocks/pkg/service/eventbus"
 	"github.com/dwarvesf/go-api/pkg/config"
 	"github.com/dwarvesf/go-api/pkg/logger/monitor"
 	"github.com/dwarvesf/go-api/pkg/middleware"
@@ -23,6 +24,7 @@ func Test_impl_UpdatePassword(t *testing.T) {
 		getUserErr              error
 		expUpdatePasswordCalled bool
 		updatePasswordErr       error
+		expPublishCalled        bool
 	}
 	type args struct {
 		req  model.UpdatePasswordRequest
@@ -49,6 +51,7 @@ func Test_impl_UpdatePassword(t *testing.T) {
 					Salt:           "abcdef",
 				},
 				expUpdatePasswordCalled: true,
+				expPublishCalled:        true,
 			},
 			args: args{
 				req: model.UpdatePasswordRequest{
@@ -88,6 +91,7 @@ func Test_impl_UpdatePassword(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			var (
 				userRepoMock = mocks.NewRepo(t)
+				busMock      = busmocks.NewBus(t)
 			)
 
 			if tt.mocked.expGetUserCalled {
@@ -104,10 +108,26 @@ func Test_impl_UpdatePassword(t *testing.T) {
 					Return(tt.mocked.updatePasswordErr)
 			}
 
+			if tt.mocked.expPublishCalled {
+				busMock.
+					EXPECT().
+					Publish(mock.MatchedBy(func(e model.Event) bool {
+						return e.Type == model.EventPasswordChanged && e.UserID == tt.mocked.uID
+					})).
+					Return()
+				busMock.
+					EXPECT().
+					Publish(mock.MatchedBy(func(e model.Event) bool {
+						return e.Type == model.EventSessionRevoked && e.UserID == tt.mocked.uID
+					})).
+					Return()
+			}
+
 			c := &impl{
 				repo: &repository.Repo{
 					User: userRepoMock,
 				},
+				bus:     busMock,
 				cfg:     config.LoadTestConfig(),
 				monitor: monitor.TestMonitor(),
 			}
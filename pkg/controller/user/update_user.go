@@ -24,13 +24,21 @@ func (c *impl) UpdateUser(ctx context.Context, user model.UpdateUserRequest) (*m
 		return nil, err
 	}
 
-	u.Avatar = user.Avatar
-	u.FullName = user.FullName
+	// If-Match: * makes the changes on the version just read, a concurrent update still conflicts
+	if user.Version == 0 {
+		user.Version = u.Version
+	}
 
 	updated, err := c.repo.User.Update(dbCtx, uID, user)
 	if err != nil {
 		return nil, err
 	}
 
+	c.bus.Publish(model.Event{
+		Type:   model.EventProfileUpdated,
+		UserID: uID,
+		User:   updated,
+	})
+
 	return updated, nil
 }
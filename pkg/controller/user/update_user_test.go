@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	mocks "github.com/dwarvesf/go-api/mocks/pkg/repository/user"
+	busmocks "github.com/dwarvesf/go-api/mocks/pkg/service/eventbus"
 	"github.com/dwarvesf/go-api/pkg/config"
 	"github.com/dwarvesf/go-api/pkg/logger/monitor"
 	"github.com/dwarvesf/go-api/pkg/middleware"
@@ -23,8 +24,10 @@ func Test_impl_UpdateUser(t *testing.T) {
 		getUser             *model.User
 		getUserErr          error
 		expUpdateUserCalled bool
+		updateVersion       int
 		updateUser          *model.User
 		updateUserErr       error
+		expPublishCalled    bool
 	}
 	type args struct {
 		req  model.UpdateUserRequest
@@ -49,8 +52,11 @@ func Test_impl_UpdateUser(t *testing.T) {
 					Role:           "admin",
 					HashedPassword: "hash",
 					Salt:           "abcdef",
+					Version:        3,
 				},
 				expUpdateUserCalled: true,
+				updateVersion:       3,
+				expPublishCalled:    true,
 				updateUser: &model.User{
 					ID:             1,
 					Email:          "admin@d.foundation",
@@ -60,6 +66,7 @@ func Test_impl_UpdateUser(t *testing.T) {
 					Role:           "admin",
 					HashedPassword: "hash",
 					Salt:           "abcdef",
+					Version:        4,
 				},
 			},
 			args: args{
@@ -77,9 +84,27 @@ func Test_impl_UpdateUser(t *testing.T) {
 				Role:           "admin",
 				HashedPassword: "hash",
 				Salt:           "abcdef",
+				Version:        4,
 			},
 			wantErr: false,
 		},
+		"stale if-match": {
+			mocked: mocked{
+				uID:                 1,
+				expGetUserCalled:    true,
+				getUser:             &model.User{ID: 1, Version: 3},
+				expUpdateUserCalled: true,
+				updateVersion:       2,
+				updateUserErr:       model.ErrVersionConflict,
+			},
+			args: args{
+				req: model.UpdateUserRequest{
+					FullName: "admin1",
+					Version:  2,
+				},
+			},
+			wantErr: true,
+		},
 		"not found": {
 			mocked: mocked{
 				uID:                 2,
@@ -100,6 +125,7 @@ func Test_impl_UpdateUser(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			var (
 				userRepoMock = mocks.NewRepo(t)
+				busMock      = busmocks.NewBus(t)
 			)
 
 			if tt.mocked.expGetUserCalled {
@@ -112,14 +138,26 @@ func Test_impl_UpdateUser(t *testing.T) {
 			if tt.mocked.expUpdateUserCalled {
 				userRepoMock.
 					EXPECT().
-					Update(mock.Anything, mock.Anything, mock.Anything).
+					Update(mock.Anything, tt.mocked.uID, mock.MatchedBy(func(req model.UpdateUserRequest) bool {
+						return req.Version == tt.mocked.updateVersion
+					})).
 					Return(tt.mocked.updateUser, tt.mocked.updateUserErr)
 			}
 
+			if tt.mocked.expPublishCalled {
+				busMock.
+					EXPECT().
+					Publish(mock.MatchedBy(func(e model.Event) bool {
+						return e.Type == model.EventProfileUpdated && e.UserID == tt.mocked.uID
+					})).
+					Return()
+			}
+
 			c := &impl{
 				repo: &repository.Repo{
 					User: userRepoMock,
 				},
+				bus:     busMock,
 				cfg:     config.LoadTestConfig(),
 				monitor: monitor.TestMonitor(),
 			}
@@ -0,0 +1,73 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/jobs"
+	"github.com/dwarvesf/go-api/pkg/model"
+)
+
+// SendCampaignPayload represent the payload of the send campaign job
+type SendCampaignPayload struct {
+	CampaignID int `json:"campaignId"`
+}
+
+// DispatchCampaigns enqueues the job sending every campaign whose send time has come,
+// enqueuing is idempotent so a campaign is sent by a single job. A campaign whose
+// send job died is failed by FailCampaign and isn't dispatched again.
+func (h Handler) DispatchCampaigns(ctx context.Context) error {
+	const spanName = "dispatchCampaignsJobHandler"
+	ctx, span := h.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	campaigns, err := h.campaignCtrl.Dispatch(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, c := range campaigns {
+		_, err := h.queue.Enqueue(ctx, NameSendCampaign,
+			SendCampaignPayload{CampaignID: c.ID},
+			jobs.UniqueKey(NameSendCampaign+":"+strconv.Itoa(c.ID)),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SendCampaign sends the campaign to its audience
+func (h Handler) SendCampaign(ctx context.Context, payload SendCampaignPayload) error {
+	const spanName = "sendCampaignJobHandler"
+	ctx, span := h.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	rs, err := h.campaignCtrl.Send(ctx, payload.CampaignID)
+	if errors.Is(err, model.ErrNotFound) || errors.Is(err, model.ErrCampaignNotStarted) {
+		return jobs.Permanent(err)
+	}
+	if err != nil {
+		return err
+	}
+
+	h.log.Infof("campaign %s finished in %s: %d sent, %d skipped, %d failed",
+		rs.Campaign, rs.Duration, rs.Sent, rs.Skipped, rs.Failed)
+
+	return nil
+}
+
+// FailCampaign marks the campaign as failed once its send job is dead
+func (h Handler) FailCampaign(ctx context.Context, payload SendCampaignPayload, lastErr string) error {
+	const spanName = "failCampaignJobHandler"
+	ctx, span := h.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	h.log.Infof("campaign %d failed: %s", payload.CampaignID, lastErr)
+
+	return h.campaignCtrl.Fail(ctx, payload.CampaignID)
+}
@@ -0,0 +1,30 @@
+package job
+
+import (
+	"context"
+	"testing"
+
+	mocks "github.com/dwarvesf/go-api/mocks/pkg/controller/campaign"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_FailCampaign(t *testing.T) {
+	campaignCtrlMock := mocks.NewController(t)
+	campaignCtrlMock.
+		EXPECT().
+		Fail(mock.Anything, 1).
+		Return(nil)
+
+	h := Handler{
+		log:          logger.NewLogger(),
+		monitor:      monitor.TestMonitor(),
+		campaignCtrl: campaignCtrlMock,
+	}
+
+	// the send job ran out of attempts, the campaign must leave the sending status
+	err := h.FailCampaign(context.Background(), SendCampaignPayload{CampaignID: 1}, "smtp unavailable")
+	require.NoError(t, err)
+}
@@ -0,0 +1,74 @@
+package job
+
+import (
+	"context"
+
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/controller/campaign"
+	"github.com/dwarvesf/go-api/pkg/controller/user"
+	"github.com/dwarvesf/go-api/pkg/jobs"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/scheduler"
+	"github.com/dwarvesf/go-api/pkg/service"
+	"github.com/dwarvesf/go-api/pkg/service/eventbus"
+)
+
+const (
+	// NameSentMail is the job sending the mail to every user
+	NameSentMail = "user.sent_mail"
+	// NameSendCampaign is the job sending a campaign to its audience
+	NameSendCampaign = "campaign.send"
+	// NameDispatchCampaigns is the schedule starting the campaigns whose send time has come
+	NameDispatchCampaigns = "campaign.dispatch"
+)
+
+// Handler for background jobs
+type Handler struct {
+	cfg          config.Config
+	log          logger.Log
+	monitor      monitor.Tracer
+	queue        jobs.Queue
+	userCtrl     user.Controller
+	campaignCtrl campaign.Controller
+}
+
+// New will return an instance of job handler
+func New(cfg config.Config, l logger.Log, repo *repository.Repo, svc service.Service, bus eventbus.Bus, monitor monitor.Tracer) *Handler {
+	return &Handler{
+		cfg:          cfg,
+		log:          l,
+		monitor:      monitor,
+		queue:        jobs.NewQueue(repo.Job, cfg.JobsMaxAttempts),
+		userCtrl:     user.NewUserController(cfg, repo, svc, bus, monitor),
+		campaignCtrl: campaign.NewCampaignController(cfg, repo, svc, monitor),
+	}
+}
+
+// Register registers the handler of every job to the worker.
+func (h Handler) Register(w *jobs.Worker) {
+	jobs.On(w, NameSentMail, h.SentMail)
+	jobs.On(w, NameSendCampaign, h.SendCampaign)
+	jobs.OnDead(w, NameSendCampaign, h.FailCampaign)
+}
+
+// RegisterSchedules registers the periodic jobs to the scheduler,
+// a job whose spec is empty in the config isn't scheduled.
+func (h Handler) RegisterSchedules(s *scheduler.Scheduler) error {
+	if h.cfg.ScheduleSentMail != "" {
+		err := s.Register(NameSentMail, h.cfg.ScheduleSentMail, func(ctx context.Context) error {
+			return h.SentMail(ctx, SentMailPayload{})
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if h.cfg.ScheduleCampaigns != "" {
+		if err := s.Register(NameDispatchCampaigns, h.cfg.ScheduleCampaigns, h.DispatchCampaigns); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package job
+
+import (
+	"context"
+)
+
+// SentMailPayload represent the payload of the sent mail job
+type SentMailPayload struct {
+	// Campaign identifies the run, the daily campaign is used when it's empty
+	Campaign string `json:"campaign,omitempty"`
+}
+
+// SentMail sends the mail to every user
+func (h Handler) SentMail(ctx context.Context, payload SentMailPayload) error {
+	const spanName = "sentMailJobHandler"
+	ctx, span := h.monitor.Start(ctx, spanName)
+	defer span.End()
+
+	rs, err := h.userCtrl.SentMail(ctx, payload.Campaign)
+	if err != nil {
+		return err
+	}
+
+	h.log.Infof("campaign %s finished in %s: %d sent, %d skipped, %d failed",
+		rs.Campaign, rs.Duration, rs.Sent, rs.Skipped, rs.Failed)
+
+	return nil
+}
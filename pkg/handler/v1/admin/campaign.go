@@ -0,0 +1,375 @@
+package admin
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/dwarvesf/go-api/pkg/handler/v1/view"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// Campaigns godoc
+// @Summary List the campaigns
+// @Description List the campaigns, the latest first
+// @id listCampaigns
+// @Tags Admin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} CampaignsResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/campaigns [get]
+func (h Handler) Campaigns(c *gin.Context) {
+	const spanName = "listCampaignsHandler"
+	ctx, span := h.monitor.Start(c.Request.Context(), spanName)
+	defer span.End()
+
+	rs, err := h.campaignCtrl.List(ctx)
+	if err != nil {
+		h.log.Error(err)
+		util.HandleError(c, err)
+		return
+	}
+
+	data := make([]view.Campaign, 0, len(rs))
+	for _, campaign := range rs {
+		data = append(data, toCampaignView(campaign))
+	}
+
+	c.JSON(http.StatusOK, view.CampaignsResponse{
+		Data: data,
+	})
+}
+
+// Campaign godoc
+// @Summary Get a campaign
+// @Description Get a campaign
+// @id getCampaign
+// @Tags Admin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Campaign ID"
+// @Success 200 {object} CampaignResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/campaigns/{id} [get]
+func (h Handler) Campaign(c *gin.Context) {
+	const spanName = "getCampaignHandler"
+	ctx, span := h.monitor.Start(c.Request.Context(), spanName)
+	defer span.End()
+
+	id, err := campaignID(c)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	rs, err := h.campaignCtrl.Get(ctx, id)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CampaignResponse{
+		Data: toCampaignView(*rs),
+	})
+}
+
+// CreateCampaign godoc
+// @Summary Create a campaign
+// @Description Create a draft campaign
+// @id createCampaign
+// @Tags Admin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param body body CampaignRequest true "Campaign"
+// @Success 200 {object} CampaignResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/campaigns [post]
+func (h Handler) CreateCampaign(c *gin.Context) {
+	const spanName = "createCampaignHandler"
+	ctx, span := h.monitor.Start(c.Request.Context(), spanName)
+	defer span.End()
+
+	var req view.CampaignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	rs, err := h.campaignCtrl.Create(ctx, toCampaignRequest(req))
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CampaignResponse{
+		Data: toCampaignView(*rs),
+	})
+}
+
+// UpdateCampaign godoc
+// @Summary Update a campaign
+// @Description Update a draft campaign
+// @id updateCampaign
+// @Tags Admin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Campaign ID"
+// @Param body body CampaignRequest true "Campaign"
+// @Success 200 {object} CampaignResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/campaigns/{id} [put]
+func (h Handler) UpdateCampaign(c *gin.Context) {
+	const spanName = "updateCampaignHandler"
+	ctx, span := h.monitor.Start(c.Request.Context(), spanName)
+	defer span.End()
+
+	id, err := campaignID(c)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	var req view.CampaignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	rs, err := h.campaignCtrl.Update(ctx, id, toCampaignRequest(req))
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CampaignResponse{
+		Data: toCampaignView(*rs),
+	})
+}
+
+// ScheduleCampaign godoc
+// @Summary Schedule a campaign
+// @Description Schedule a draft campaign, it's sent to its audience at the scheduled time
+// @id scheduleCampaign
+// @Tags Admin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Campaign ID"
+// @Param body body ScheduleCampaignRequest true "Send time"
+// @Success 200 {object} CampaignResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/campaigns/{id}/schedule [post]
+func (h Handler) ScheduleCampaign(c *gin.Context) {
+	const spanName = "scheduleCampaignHandler"
+	ctx, span := h.monitor.Start(c.Request.Context(), spanName)
+	defer span.End()
+
+	id, err := campaignID(c)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	var req view.ScheduleCampaignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	rs, err := h.campaignCtrl.Schedule(ctx, id, req.ScheduledAt)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CampaignResponse{
+		Data: toCampaignView(*rs),
+	})
+}
+
+// UnscheduleCampaign godoc
+// @Summary Unschedule a campaign
+// @Description Turn a scheduled campaign back into a draft
+// @id unscheduleCampaign
+// @Tags Admin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Campaign ID"
+// @Success 200 {object} CampaignResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/campaigns/{id}/unschedule [post]
+func (h Handler) UnscheduleCampaign(c *gin.Context) {
+	const spanName = "unscheduleCampaignHandler"
+	ctx, span := h.monitor.Start(c.Request.Context(), spanName)
+	defer span.End()
+
+	id, err := campaignID(c)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	rs, err := h.campaignCtrl.Unschedule(ctx, id)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CampaignResponse{
+		Data: toCampaignView(*rs),
+	})
+}
+
+// PreviewCampaign godoc
+// @Summary Preview the recipients of a campaign
+// @Description Count the users the campaign would be sent to now
+// @id previewCampaign
+// @Tags Admin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Campaign ID"
+// @Success 200 {object} CampaignPreviewResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/campaigns/{id}/preview [get]
+func (h Handler) PreviewCampaign(c *gin.Context) {
+	const spanName = "previewCampaignHandler"
+	ctx, span := h.monitor.Start(c.Request.Context(), spanName)
+	defer span.End()
+
+	id, err := campaignID(c)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	rs, err := h.campaignCtrl.Preview(ctx, id)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CampaignPreviewResponse{
+		Data: view.CampaignPreview{
+			Recipients: rs,
+		},
+	})
+}
+
+// SampleCampaign godoc
+// @Summary Render a sample of a campaign
+// @Description Render the mail of the campaign for its first recipient
+// @id sampleCampaign
+// @Tags Admin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path int true "Campaign ID"
+// @Success 200 {object} CampaignSampleResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/campaigns/{id}/sample [get]
+func (h Handler) SampleCampaign(c *gin.Context) {
+	const spanName = "sampleCampaignHandler"
+	ctx, span := h.monitor.Start(c.Request.Context(), spanName)
+	defer span.End()
+
+	id, err := campaignID(c)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	rs, err := h.campaignCtrl.Sample(ctx, id)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CampaignSampleResponse{
+		Data: view.CampaignSample{
+			To:      rs.To,
+			Subject: rs.Subject,
+			HTML:    rs.HTML,
+			Text:    rs.Text,
+		},
+	})
+}
+
+// campaignID returns the ID of the campaign in the path, an invalid ID matches no campaign.
+func campaignID(c *gin.Context) (int, error) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return 0, model.ErrNotFound
+	}
+
+	return id, nil
+}
+
+func toCampaignRequest(req view.CampaignRequest) model.CampaignRequest {
+	return model.CampaignRequest{
+		Name:     req.Name,
+		Template: req.Template,
+		Audience: model.AudienceFilter{
+			Role:        model.Role(req.Audience.Role),
+			Status:      model.Status(req.Audience.Status),
+			CreatedFrom: req.Audience.CreatedFrom,
+			CreatedTo:   req.Audience.CreatedTo,
+			Query:       req.Audience.Query,
+		},
+	}
+}
+
+func toCampaignView(c model.Campaign) view.Campaign {
+	return view.Campaign{
+		ID:       c.ID,
+		Name:     c.Name,
+		Template: c.Template,
+		Audience: view.Audience{
+			Role:        string(c.Audience.Role),
+			Status:      string(c.Audience.Status),
+			CreatedFrom: c.Audience.CreatedFrom,
+			CreatedTo:   c.Audience.CreatedTo,
+			Query:       c.Audience.Query,
+		},
+		Status:      string(c.Status),
+		ScheduledAt: c.ScheduledAt,
+		StartedAt:   c.StartedAt,
+		FinishedAt:  c.FinishedAt,
+		CreatedAt:   c.CreatedAt,
+	}
+}
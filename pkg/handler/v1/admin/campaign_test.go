@@ -0,0 +1,143 @@
+package admin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mocks "github.com/dwarvesf/go-api/mocks/pkg/controller/campaign"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/handler/testutil"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_Campaign(t *testing.T) {
+	createdAt := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	scheduledAt := time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)
+
+	type mocked struct {
+		expGet   bool
+		campaign *model.Campaign
+		getErr   error
+	}
+	type expected struct {
+		Status int
+		Body   string
+	}
+	tests := map[string]struct {
+		id       string
+		mocked   mocked
+		expected expected
+	}{
+		"success": {
+			id: "1",
+			mocked: mocked{
+				expGet: true,
+				campaign: &model.Campaign{
+					ID:          1,
+					Name:        "Welcome",
+					Template:    "sent_mail",
+					Audience:    model.AudienceFilter{Role: model.RoleUser, Query: "tom"},
+					Status:      model.CampaignStatusScheduled,
+					ScheduledAt: &scheduledAt,
+					CreatedAt:   createdAt,
+				},
+			},
+			expected: expected{
+				Status: http.StatusOK,
+				Body: `{"data":{"id":1,"name":"Welcome","template":"sent_mail",
+					"audience":{"role":"user","status":"","createdFrom":null,"createdTo":null,"query":"tom"},
+					"status":"scheduled","scheduledAt":"2026-10-20T09:00:00Z","startedAt":null,"finishedAt":null,
+					"createdAt":"2026-10-19T00:00:00Z"}}`,
+			},
+		},
+		"not found": {
+			id: "2",
+			mocked: mocked{
+				expGet: true,
+				getErr: model.ErrNotFound,
+			},
+			expected: expected{
+				Status: http.StatusNotFound,
+				Body:   `{"code":"NOT_FOUND","message":"not found","status":404,"traceID":"00000000000000000000000000000000"}`,
+			},
+		},
+		"invalid id": {
+			id: "abc",
+			expected: expected{
+				Status: http.StatusNotFound,
+				Body:   `{"code":"NOT_FOUND","message":"not found","status":404,"traceID":"00000000000000000000000000000000"}`,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ginCtx := testutil.NewRequest(w, testutil.MethodGet, nil, []gin.Param{{Key: "id", Value: tt.id}}, nil, nil)
+
+			ctrlMock := mocks.NewController(t)
+			if tt.mocked.expGet {
+				ctrlMock.EXPECT().Get(mock.Anything, mock.Anything).Return(tt.mocked.campaign, tt.mocked.getErr)
+			}
+
+			h := Handler{
+				log:          logger.NewLogger(),
+				cfg:          config.LoadTestConfig(),
+				monitor:      monitor.TestMonitor(),
+				campaignCtrl: ctrlMock,
+			}
+			h.Campaign(ginCtx)
+
+			assert.Equal(t, tt.expected.Status, w.Code)
+			assert.JSONEq(t, tt.expected.Body, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_ScheduleCampaign(t *testing.T) {
+	scheduledAt := time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)
+
+	w := httptest.NewRecorder()
+	ginCtx := testutil.NewRequest(w, testutil.MethodPost, nil, []gin.Param{{Key: "id", Value: "1"}}, nil,
+		map[string]any{"scheduledAt": scheduledAt})
+
+	ctrlMock := mocks.NewController(t)
+	ctrlMock.EXPECT().Schedule(mock.Anything, 1, scheduledAt).Return(nil, model.ErrCampaignNotDraft)
+
+	h := Handler{
+		log:          logger.NewLogger(),
+		cfg:          config.LoadTestConfig(),
+		monitor:      monitor.TestMonitor(),
+		campaignCtrl: ctrlMock,
+	}
+	h.ScheduleCampaign(ginCtx)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.JSONEq(t, `{"code":"CAMPAIGN_NOT_DRAFT","message":"campaign isn't a draft","status":409,"traceID":"00000000000000000000000000000000"}`, w.Body.String())
+}
+
+func TestHandler_PreviewCampaign(t *testing.T) {
+	w := httptest.NewRecorder()
+	ginCtx := testutil.NewRequest(w, testutil.MethodGet, nil, []gin.Param{{Key: "id", Value: "1"}}, nil, nil)
+
+	ctrlMock := mocks.NewController(t)
+	ctrlMock.EXPECT().Preview(mock.Anything, 1).Return(int64(42), nil)
+
+	h := Handler{
+		log:          logger.NewLogger(),
+		cfg:          config.LoadTestConfig(),
+		monitor:      monitor.TestMonitor(),
+		campaignCtrl: ctrlMock,
+	}
+	h.PreviewCampaign(ginCtx)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"data":{"recipients":42}}`, w.Body.String())
+}
@@ -0,0 +1,35 @@
+package admin
+
+import (
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/controller/campaign"
+	"github.com/dwarvesf/go-api/pkg/controller/schedule"
+	"github.com/dwarvesf/go-api/pkg/controller/user"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/service"
+	"github.com/dwarvesf/go-api/pkg/service/eventbus"
+)
+
+// Handler for admin
+type Handler struct {
+	cfg          config.Config
+	log          logger.Log
+	monitor      monitor.Tracer
+	scheduleCtrl schedule.Controller
+	campaignCtrl campaign.Controller
+	userCtrl     user.Controller
+}
+
+// New will return an instance of admin handler
+func New(cfg config.Config, l logger.Log, repo *repository.Repo, svc service.Service, bus eventbus.Bus, monitor monitor.Tracer) *Handler {
+	return &Handler{
+		cfg:          cfg,
+		log:          l,
+		monitor:      monitor,
+		scheduleCtrl: schedule.NewScheduleController(cfg, repo, monitor),
+		campaignCtrl: campaign.NewCampaignController(cfg, repo, svc, monitor),
+		userCtrl:     user.NewUserController(cfg, repo, svc, bus, monitor),
+	}
+}
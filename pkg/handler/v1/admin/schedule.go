@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/dwarvesf/go-api/pkg/handler/v1/view"
+	"github.com/dwarvesf/go-api/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// Schedules godoc
+// @Summary List the schedules
+// @Description List the periodic tasks with their last and next run
+// @id listSchedules
+// @Tags Admin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} SchedulesResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/schedules [get]
+func (h Handler) Schedules(c *gin.Context) {
+	const spanName = "listSchedulesHandler"
+	ctx, span := h.monitor.Start(c.Request.Context(), spanName)
+	defer span.End()
+
+	rs, err := h.scheduleCtrl.List(ctx)
+	if err != nil {
+		h.log.Error(err)
+		util.HandleError(c, err)
+		return
+	}
+
+	data := make([]view.Schedule, 0, len(rs))
+	for _, s := range rs {
+		data = append(data, view.Schedule{
+			Name:           s.Name,
+			Spec:           s.Spec,
+			NextRunAt:      s.NextRunAt,
+			LastRunAt:      s.LastRunAt,
+			LastStatus:     string(s.LastStatus),
+			LastError:      s.LastError,
+			LastDurationMS: s.LastDuration.Milliseconds(),
+		})
+	}
+
+	c.JSON(http.StatusOK, view.SchedulesResponse{
+		Data: data,
+	})
+}
@@ -0,0 +1,92 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mocks "github.com/dwarvesf/go-api/mocks/pkg/controller/schedule"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/handler/testutil"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_Schedules(t *testing.T) {
+	lastRun := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	nextRun := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+
+	type mocked struct {
+		schedules []model.Schedule
+		listErr   error
+	}
+	type expected struct {
+		Status int
+		Body   string
+	}
+	tests := map[string]struct {
+		mocked   mocked
+		expected expected
+	}{
+		"success": {
+			mocked: mocked{
+				schedules: []model.Schedule{
+					{
+						Name:         "user.sent_mail",
+						Spec:         "0 0 * * *",
+						NextRunAt:    &nextRun,
+						LastRunAt:    &lastRun,
+						LastStatus:   model.ScheduleStatusSucceeded,
+						LastDuration: 1500 * time.Millisecond,
+					},
+					{
+						Name: "never_run",
+						Spec: "0 * * * *",
+					},
+				},
+			},
+			expected: expected{
+				Status: http.StatusOK,
+				Body: `{"data":[
+					{"name":"user.sent_mail","spec":"0 0 * * *","nextRunAt":"2026-10-20T00:00:00Z","lastRunAt":"2026-10-19T00:00:00Z","lastStatus":"succeeded","lastError":"","lastDurationMs":1500},
+					{"name":"never_run","spec":"0 * * * *","nextRunAt":null,"lastRunAt":null,"lastStatus":"","lastError":"","lastDurationMs":0}
+				]}`,
+			},
+		},
+		"failed": {
+			mocked: mocked{
+				listErr: errors.New("connection refused"),
+			},
+			expected: expected{
+				Status: http.StatusInternalServerError,
+				Body:   `{"code":"INTERNAL_ERROR","message":"connection refused","status":500,"traceID":"00000000000000000000000000000000"}`,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ginCtx := testutil.NewRequest(w, testutil.MethodGet, nil, nil, nil, nil)
+
+			ctrlMock := mocks.NewController(t)
+			ctrlMock.EXPECT().List(mock.Anything).Return(tt.mocked.schedules, tt.mocked.listErr)
+
+			h := Handler{
+				log:          logger.NewLogger(),
+				cfg:          config.LoadTestConfig(),
+				monitor:      monitor.TestMonitor(),
+				scheduleCtrl: ctrlMock,
+			}
+			h.Schedules(ginCtx)
+
+			assert.Equal(t, tt.expected.Status, w.Code)
+			assert.JSONEq(t, tt.expected.Body, w.Body.String())
+		})
+	}
+}
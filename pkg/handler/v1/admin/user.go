@@ -0,0 +1,115 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/dwarvesf/go-api/pkg/handler/v1/view"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// Users godoc
+// @Summary List the users
+// @Description List the users by page, or by cursor for large lists: pass the nextCursor or prevCursor of the metadata to get the next or previous page
+// @id listUsers
+// @Tags Admin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param page query int false "Page, offset pagination only"
+// @Param pageSize query int false "Page size"
+// @Param sort query string false "Sort by id, email, name, created_at or updated_at, such as -created_at,+name"
+// @Param query query string false "Name to search, or name and email in search mode"
+// @Param filter query string false "Filters on id, email, name, status, role, created_at or updated_at with the operators eq, ne, in, gte, lte, like and is_null, such as filter[status]=active&filter[created_at][gte]=2026-10-01"
+// @Param paginate query string false "Pagination mode" Enums(offset, cursor)
+// @Param cursor query string false "Cursor of the page, cursor pagination only"
+// @Param skipCount query bool false "Skip counting the total records"
+// @Param search query bool false "Rank the users by relevance to the query, offset pagination only"
+// @Param fuzzy query bool false "Also match the misspelled queries in search mode"
+// @Param highlight query bool false "Highlight the matched terms in search mode"
+// @Success 200 {object} UsersResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/users [get]
+func (h Handler) Users(c *gin.Context) {
+	const spanName = "listUsersHandler"
+	ctx, span := h.monitor.Start(c.Request.Context(), spanName)
+	defer span.End()
+
+	var req view.ListQuery
+	if err := c.ShouldBindQuery(&req); err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	filters, err := util.ParseFilters(c.Request.URL.Query())
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	rs, err := h.userCtrl.List(ctx, toListQuery(req, filters))
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	data := make([]view.UserItem, 0, len(rs.Data))
+	for i, u := range rs.Data {
+		itm := view.UserItem{
+			User: view.User{
+				ID:       u.ID,
+				Email:    u.Email,
+				FullName: u.FullName,
+				Avatar:   u.Avatar,
+			},
+		}
+		if i < len(rs.Highlights) {
+			itm.Highlight = rs.Highlights[i]
+		}
+		data = append(data, itm)
+	}
+
+	c.JSON(http.StatusOK, view.UsersResponse{
+		Data:     data,
+		Metadata: toMetadata(rs.Pagination),
+	})
+}
+
+func toListQuery(req view.ListQuery, filters []model.Filter) model.ListQuery {
+	var search *model.SearchOptions
+	if req.Search {
+		search = &model.SearchOptions{
+			Fuzzy:     req.Fuzzy,
+			Highlight: req.Highlight,
+		}
+	}
+
+	return model.ListQuery{
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		Sort:       req.Sort,
+		Query:      req.Query,
+		Filters:    filters,
+		CursorMode: req.Paginate == "cursor" || req.Cursor != "",
+		Cursor:     req.Cursor,
+		SkipCount:  req.SkipCount,
+		Search:     search,
+	}
+}
+
+func toMetadata(p model.Pagination) view.Metadata {
+	return view.Metadata{
+		Page:         p.Page,
+		PageSize:     p.PageSize,
+		TotalPages:   p.TotalPages,
+		TotalRecords: p.TotalRecords,
+		Sort:         p.Sort,
+		HasNext:      p.HasNext,
+		NextCursor:   p.NextCursor,
+		PrevCursor:   p.PrevCursor,
+	}
+}
@@ -0,0 +1,153 @@
+package admin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	mocks "github.com/dwarvesf/go-api/mocks/pkg/controller/user"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/handler/testutil"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_Users(t *testing.T) {
+	type mocked struct {
+		query  model.ListQuery
+		result *model.ListResult[model.User]
+		err    error
+	}
+	type expected struct {
+		Status int
+		Body   string
+	}
+	tests := map[string]struct {
+		params   url.Values
+		mocked   mocked
+		expected expected
+	}{
+		"offset": {
+			params: url.Values{"page": {"2"}, "pageSize": {"1"}, "sort": {"-created_at"}},
+			mocked: mocked{
+				query: model.ListQuery{Page: 2, PageSize: 1, Sort: "-created_at"},
+				result: &model.ListResult[model.User]{
+					Data: []model.User{{ID: 2, Email: "b@d.foundation", FullName: "B"}},
+					Pagination: model.Pagination{
+						Page: 2, PageSize: 1, TotalRecords: 3, TotalPages: 3, Offset: 1, Sort: "created_at desc", HasNext: true,
+					},
+				},
+			},
+			expected: expected{
+				Status: http.StatusOK,
+				Body: `{"data":[{"id":2,"email":"b@d.foundation","fullName":"B","avatar":""}],
+					"metadata":{"page":2,"pageSize":1,"totalPages":3,"totalRecords":3,"sort":"created_at desc","hasNext":true}}`,
+			},
+		},
+		"cursor": {
+			params: url.Values{"cursor": {"next"}, "pageSize": {"1"}, "skipCount": {"true"}},
+			mocked: mocked{
+				query: model.ListQuery{PageSize: 1, CursorMode: true, Cursor: "next", SkipCount: true},
+				result: &model.ListResult[model.User]{
+					Data: []model.User{{ID: 1, Email: "a@d.foundation", FullName: "A"}},
+					Pagination: model.Pagination{
+						PageSize: 1, Sort: "created_at desc", PrevCursor: "prev",
+					},
+				},
+			},
+			expected: expected{
+				Status: http.StatusOK,
+				Body: `{"data":[{"id":1,"email":"a@d.foundation","fullName":"A","avatar":""}],
+					"metadata":{"page":0,"pageSize":1,"totalPages":0,"totalRecords":0,"sort":"created_at desc","prevCursor":"prev"}}`,
+			},
+		},
+		"filters": {
+			params: url.Values{"sort": {"-name"}, "filter[status]": {"active"}, "filter[created_at][gte]": {"2026-10-01"}},
+			mocked: mocked{
+				query: model.ListQuery{Sort: "-name", Filters: []model.Filter{
+					{Field: "created_at", Operator: model.FilterOperatorGte, Value: "2026-10-01"},
+					{Field: "status", Operator: model.FilterOperatorEq, Value: "active"},
+				}},
+				result: &model.ListResult[model.User]{
+					Pagination: model.Pagination{Page: 1, PageSize: 10, Sort: "name desc"},
+				},
+			},
+			expected: expected{
+				Status: http.StatusOK,
+				Body:   `{"data":[],"metadata":{"page":1,"pageSize":10,"totalPages":0,"totalRecords":0,"sort":"name desc"}}`,
+			},
+		},
+		"search": {
+			params: url.Values{"query": {"tom"}, "search": {"true"}, "highlight": {"true"}},
+			mocked: mocked{
+				query: model.ListQuery{Query: "tom", Search: &model.SearchOptions{Highlight: true}},
+				result: &model.ListResult[model.User]{
+					Data:       []model.User{{ID: 2, Email: "tom@d.foundation", FullName: "Tom"}},
+					Pagination: model.Pagination{Page: 1, PageSize: 10, TotalRecords: 1, TotalPages: 1, Sort: "created_at desc"},
+					Highlights: []map[string]string{{"name": "<b>Tom</b>", "email": "<b>tom</b>@d.foundation"}},
+				},
+			},
+			expected: expected{
+				Status: http.StatusOK,
+				Body: `{"data":[{"id":2,"email":"tom@d.foundation","fullName":"Tom","avatar":"","highlight":{"name":"<b>Tom</b>","email":"<b>tom</b>@d.foundation"}}],
+					"metadata":{"page":1,"pageSize":10,"totalPages":1,"totalRecords":1,"sort":"created_at desc"}}`,
+			},
+		},
+		"unknown filter field": {
+			params: url.Values{"filter[password]": {"x"}},
+			mocked: mocked{
+				query: model.ListQuery{Filters: []model.Filter{{Field: "password", Operator: model.FilterOperatorEq, Value: "x"}}},
+				err:   model.NewError(http.StatusBadRequest, "INVALID_FILTER", "unknown filter field password"),
+			},
+			expected: expected{
+				Status: http.StatusBadRequest,
+				Body:   `{"code":"INVALID_FILTER","message":"unknown filter field password","status":400,"traceID":"00000000000000000000000000000000"}`,
+			},
+		},
+		"invalid filter syntax": {
+			params: url.Values{"filter[status][eq][x]": {"active"}},
+			expected: expected{
+				Status: http.StatusBadRequest,
+				Body:   `{"code":"INVALID_FILTER","message":"invalid filter filter[status][eq][x]","status":400,"traceID":"00000000000000000000000000000000"}`,
+			},
+		},
+		"invalid cursor": {
+			params: url.Values{"paginate": {"cursor"}, "cursor": {"tampered"}},
+			mocked: mocked{
+				query: model.ListQuery{CursorMode: true, Cursor: "tampered"},
+				err:   model.ErrInvalidCursor,
+			},
+			expected: expected{
+				Status: http.StatusBadRequest,
+				Body:   `{"code":"INVALID_CURSOR","message":"invalid cursor","status":400,"traceID":"00000000000000000000000000000000"}`,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ginCtx := testutil.NewRequest(w, testutil.MethodGet, nil, nil, tt.params, nil)
+
+			ctrlMock := mocks.NewController(t)
+			if tt.mocked.result != nil || tt.mocked.err != nil {
+				ctrlMock.EXPECT().List(mock.Anything, tt.mocked.query).Return(tt.mocked.result, tt.mocked.err)
+			}
+
+			h := Handler{
+				log:      logger.NewLogger(),
+				cfg:      config.LoadTestConfig(),
+				monitor:  monitor.TestMonitor(),
+				userCtrl: ctrlMock,
+			}
+			h.Users(ginCtx)
+
+			assert.Equal(t, tt.expected.Status, w.Code)
+			assert.JSONEq(t, tt.expected.Body, w.Body.String())
+		})
+	}
+}
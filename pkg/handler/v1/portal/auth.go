@@ -34,8 +34,10 @@ func (h Handler) Login(c *gin.Context) {
 	}
 
 	rs, err := h.authCtrl.Login(ctx, model.LoginRequest{
-		Email:    req.Email,
-		Password: req.Password,
+		Email:     req.Email,
+		Password:  req.Password,
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
 	})
 	if err != nil {
 		h.log.Error(err)
@@ -0,0 +1,122 @@
+package portal
+
+import (
+	"net/http"
+
+	"github.com/dwarvesf/go-api/pkg/handler/v1/view"
+	"github.com/dwarvesf/go-api/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// MailPreference godoc
+// @Summary Retrieve my mail preferences
+// @Description Retrieve the mails I agreed to receive
+// @id getMailPreference
+// @Tags User
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} MailPreferenceResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /portal/users/mail-preferences [get]
+func (h Handler) MailPreference(c *gin.Context) {
+	const spanName = "getMailPreferenceHandler"
+	ctx, span := h.monitor.Start(c.Request.Context(), spanName)
+	defer span.End()
+
+	rs, err := h.userCtrl.GetMailPreference(ctx)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.MailPreferenceResponse{
+		Data: view.MailPreference{
+			Campaigns: rs.Campaigns,
+		},
+	})
+}
+
+// UpdateMailPreference godoc
+// @Summary Update my mail preferences
+// @Description Subscribe to or unsubscribe from the campaigns
+// @id updateMailPreference
+// @Tags User
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param body body UpdateMailPreferenceRequest true "Mail preferences"
+// @Success 200 {object} MailPreferenceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /portal/users/mail-preferences [put]
+func (h Handler) UpdateMailPreference(c *gin.Context) {
+	const spanName = "updateMailPreferenceHandler"
+	ctx, span := h.monitor.Start(c.Request.Context(), spanName)
+	defer span.End()
+
+	var req view.UpdateMailPreferenceRequest
+	err := c.ShouldBindJSON(&req)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	rs, err := h.userCtrl.UpdateMailPreference(ctx, *req.Campaigns)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.MailPreferenceResponse{
+		Data: view.MailPreference{
+			Campaigns: rs.Campaigns,
+		},
+	})
+}
+
+// Unsubscribe godoc
+// @Summary Unsubscribe from the campaigns
+// @Description Unsubscribe the user of the link sent in the campaigns, the token is read from the query or the body
+// @id unsubscribe
+// @Tags User
+// @Accept  json
+// @Produce  json
+// @Param token query string false "Unsubscribe token"
+// @Param body body UnsubscribeRequest false "Unsubscribe token"
+// @Success 200 {object} MessageResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /portal/mail/unsubscribe [post]
+func (h Handler) Unsubscribe(c *gin.Context) {
+	const spanName = "unsubscribeHandler"
+	ctx, span := h.monitor.Start(c.Request.Context(), spanName)
+	defer span.End()
+
+	// the link of the mails is a GET with the token in the query
+	var req view.UnsubscribeRequest
+	var err error
+	if c.Request.Method == http.MethodGet {
+		err = c.ShouldBindQuery(&req)
+	} else {
+		err = c.ShouldBindJSON(&req)
+	}
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	err = h.userCtrl.Unsubscribe(ctx, req.Token)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.MessageResponse{
+		Data: view.Message{
+			Message: "success",
+		},
+	})
+}
@@ -0,0 +1,97 @@
+package portal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	mocks "github.com/dwarvesf/go-api/mocks/pkg/controller/user"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/handler/testutil"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_UpdateMailPreference(t *testing.T) {
+	w := httptest.NewRecorder()
+	ginCtx := testutil.NewRequest(w, testutil.MethodPut, nil, nil, nil, map[string]any{"campaigns": false})
+	testutil.UpdateJWT(ginCtx, 1, "user")
+
+	ctrlMock := mocks.NewController(t)
+	ctrlMock.EXPECT().UpdateMailPreference(mock.Anything, false).Return(&model.MailPreference{UserID: 1, Campaigns: false}, nil)
+
+	h := Handler{
+		log:      logger.NewLogger(),
+		cfg:      config.LoadTestConfig(),
+		monitor:  monitor.TestMonitor(),
+		userCtrl: ctrlMock,
+	}
+	h.UpdateMailPreference(ginCtx)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"data":{"campaigns":false}}`, w.Body.String())
+}
+
+func TestHandler_Unsubscribe(t *testing.T) {
+	type expected struct {
+		Status int
+		Body   string
+	}
+	tests := map[string]struct {
+		method   testutil.HTTPMethod
+		query    url.Values
+		body     any
+		ctrlErr  error
+		expected expected
+	}{
+		"link": {
+			method: testutil.MethodGet,
+			query:  url.Values{"token": {"1.signature"}},
+			expected: expected{
+				Status: http.StatusOK,
+				Body:   `{"data":{"message":"success"}}`,
+			},
+		},
+		"json body": {
+			method: testutil.MethodPost,
+			body:   map[string]any{"token": "1.signature"},
+			expected: expected{
+				Status: http.StatusOK,
+				Body:   `{"data":{"message":"success"}}`,
+			},
+		},
+		"invalid token": {
+			method:  testutil.MethodGet,
+			query:   url.Values{"token": {"1.signature"}},
+			ctrlErr: model.ErrInvalidToken,
+			expected: expected{
+				Status: http.StatusUnauthorized,
+				Body:   `{"code":"Unauthorized","message":"Unauthorized","status":401,"traceID":"00000000000000000000000000000000"}`,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ginCtx := testutil.NewRequest(w, tt.method, nil, nil, tt.query, tt.body)
+
+			ctrlMock := mocks.NewController(t)
+			ctrlMock.EXPECT().Unsubscribe(mock.Anything, "1.signature").Return(tt.ctrlErr)
+
+			h := Handler{
+				log:      logger.NewLogger(),
+				cfg:      config.LoadTestConfig(),
+				monitor:  monitor.TestMonitor(),
+				userCtrl: ctrlMock,
+			}
+			h.Unsubscribe(ginCtx)
+
+			assert.Equal(t, tt.expected.Status, w.Code)
+			assert.JSONEq(t, tt.expected.Body, w.Body.String())
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/dwarvesf/go-api/pkg/logger/monitor"
 	"github.com/dwarvesf/go-api/pkg/repository"
 	"github.com/dwarvesf/go-api/pkg/service"
+	"github.com/dwarvesf/go-api/pkg/service/eventbus"
 )
 
 // Handler for app
@@ -21,13 +22,13 @@ type Handler struct {
 }
 
 // New will return an instance of Auth struct
-func New(cfg config.Config, l logger.Log, repo *repository.Repo, svc service.Service, monitor monitor.Tracer) *Handler {
+func New(cfg config.Config, l logger.Log, repo *repository.Repo, svc service.Service, bus eventbus.Bus, monitor monitor.Tracer) *Handler {
 	return &Handler{
 		cfg:      cfg,
 		log:      l,
 		svc:      svc,
 		monitor:  monitor,
-		authCtrl: auth.NewAuthController(cfg, repo, monitor),
-		userCtrl: user.NewUserController(cfg, repo, monitor),
+		authCtrl: auth.NewAuthController(cfg, l, repo, svc, bus, monitor),
+		userCtrl: user.NewUserController(cfg, repo, svc, bus, monitor),
 	}
 }
@@ -9,6 +9,11 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	headerETag    = "ETag"
+	headerIfMatch = "If-Match"
+)
+
 // Me godoc
 // @Summary Retrieve my information
 // @Description Retrieve my information
@@ -18,6 +23,7 @@ import (
 // @Produce  json
 // @Security BearerAuth
 // @Success 200 {object} MeResponse
+// @Header 200 {string} ETag "the version of the user, sent back in If-Match to update it"
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
@@ -33,6 +39,7 @@ func (h Handler) Me(c *gin.Context) {
 		return
 	}
 
+	c.Header(headerETag, util.ETag(rs.Version))
 	c.JSON(http.StatusOK, view.MeResponse{
 		Data: view.Me{
 			ID:    rs.ID,
@@ -49,10 +56,14 @@ func (h Handler) Me(c *gin.Context) {
 // @Accept  json
 // @Produce  json
 // @Security BearerAuth
+// @Param If-Match header string true "ETag of the user the changes were made on, * to overwrite any version"
 // @Param body body UpdateUserRequest true "Update user"
 // @Success 200 {object} UserResponse
+// @Header 200 {string} ETag "the new version of the user"
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 428 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /portal/users [put]
 func (h Handler) UpdateUser(c *gin.Context) {
@@ -67,17 +78,27 @@ func (h Handler) UpdateUser(c *gin.Context) {
 		return
 	}
 
+	// the update is rejected when the user changed since the client read it,
+	// or when the client didn't say which version it read
+	version, err := util.ParseIfMatch(c.GetHeader(headerIfMatch))
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
 	rs, err := h.userCtrl.UpdateUser(
 		ctx,
 		model.UpdateUserRequest{
 			FullName: req.FullName,
 			Avatar:   req.Avatar,
+			Version:  version,
 		})
 	if err != nil {
 		util.HandleError(c, err)
 		return
 	}
 
+	c.Header(headerETag, util.ETag(rs.Version))
 	c.JSON(http.StatusOK, view.UserResponse{
 		Data: view.User{
 			ID:       rs.ID,
@@ -29,6 +29,7 @@ func TestHandler_Me(t *testing.T) {
 	type expected struct {
 		Status int
 		Body   string
+		ETag   string
 	}
 	tests := map[string]struct {
 		mocked   mocked
@@ -40,13 +41,15 @@ func TestHandler_Me(t *testing.T) {
 				userID:       1,
 				expGetUser:   true,
 				user: &model.User{
-					ID:    1,
-					Email: "admin@email.com",
+					ID:      1,
+					Email:   "admin@email.com",
+					Version: 3,
 				},
 			},
 			expected: expected{
 				Status: 200,
 				Body:   "admin",
+				ETag:   `"3"`,
 			},
 		},
 	}
@@ -76,6 +79,7 @@ func TestHandler_Me(t *testing.T) {
 			h.Me(ginCtx)
 
 			assert.Equal(t, tt.expected.Status, w.Code)
+			assert.Equal(t, tt.expected.ETag, w.Header().Get("ETag"))
 			resBody := w.Body.String()
 			assert.Contains(t, resBody, tt.expected.Body)
 		})
@@ -88,34 +92,39 @@ func TestHandler_UpdateUser(t *testing.T) {
 		userID        int
 		role          string
 		expUpdateUser bool
+		version       int
 		user          *model.User
 		userErr       error
 	}
 
 	type args struct {
-		input view.UpdateUserRequest
+		headers map[string]string
+		input   view.UpdateUserRequest
 	}
 
 	type expected struct {
 		Status int
-		Body   view.UserResponse
+		ETag   string
+		Body   *view.UserResponse
 	}
 	tests := map[string]struct {
 		args     args
 		mocked   mocked
 		expected expected
 	}{
-		"success": {
+		"any version": {
 			mocked: mocked{
 				expUpdateJWT:  true,
 				userID:        1,
 				expUpdateUser: true,
 				user: &model.User{
-					ID:    1,
-					Email: "admin@email.com",
+					ID:      1,
+					Email:   "admin@email.com",
+					Version: 2,
 				},
 			},
 			args: args{
+				headers: map[string]string{"If-Match": "*"},
 				input: view.UpdateUserRequest{
 					FullName: "Admin",
 					Avatar:   "https://www.google.com",
@@ -123,7 +132,8 @@ func TestHandler_UpdateUser(t *testing.T) {
 			},
 			expected: expected{
 				Status: 200,
-				Body: view.UserResponse{
+				ETag:   `"2"`,
+				Body: &view.UserResponse{
 					Data: view.User{
 						ID:    1,
 						Email: "admin@email.com",
@@ -131,11 +141,87 @@ func TestHandler_UpdateUser(t *testing.T) {
 				},
 			},
 		},
+		"if-match": {
+			mocked: mocked{
+				expUpdateJWT:  true,
+				userID:        1,
+				expUpdateUser: true,
+				version:       3,
+				user: &model.User{
+					ID:      1,
+					Email:   "admin@email.com",
+					Version: 4,
+				},
+			},
+			args: args{
+				headers: map[string]string{"If-Match": `"3"`},
+				input: view.UpdateUserRequest{
+					FullName: "Admin",
+				},
+			},
+			expected: expected{
+				Status: 200,
+				ETag:   `"4"`,
+				Body: &view.UserResponse{
+					Data: view.User{
+						ID:    1,
+						Email: "admin@email.com",
+					},
+				},
+			},
+		},
+		"stale if-match": {
+			mocked: mocked{
+				expUpdateJWT:  true,
+				userID:        1,
+				expUpdateUser: true,
+				version:       3,
+				userErr:       model.ErrVersionConflict,
+			},
+			args: args{
+				headers: map[string]string{"If-Match": `"3"`},
+				input: view.UpdateUserRequest{
+					FullName: "Admin",
+				},
+			},
+			expected: expected{
+				Status: 409,
+			},
+		},
+		"missing if-match": {
+			mocked: mocked{
+				expUpdateJWT: true,
+				userID:       1,
+			},
+			args: args{
+				input: view.UpdateUserRequest{
+					FullName: "Admin",
+				},
+			},
+			expected: expected{
+				Status: 428,
+			},
+		},
+		"invalid if-match": {
+			mocked: mocked{
+				expUpdateJWT: true,
+				userID:       1,
+			},
+			args: args{
+				headers: map[string]string{"If-Match": `W/"3"`},
+				input: view.UpdateUserRequest{
+					FullName: "Admin",
+				},
+			},
+			expected: expected{
+				Status: 400,
+			},
+		},
 	}
 	for name, tt := range tests {
 		w := httptest.NewRecorder()
 		cfg := config.LoadTestConfig()
-		ginCtx := testutil.NewRequest(w, testutil.MethodPut, nil, nil, nil, tt.args.input)
+		ginCtx := testutil.NewRequest(w, testutil.MethodPut, tt.args.headers, nil, nil, tt.args.input)
 
 		if tt.mocked.expUpdateJWT {
 			testutil.UpdateJWT(ginCtx, tt.mocked.userID, tt.mocked.role)
@@ -146,7 +232,11 @@ func TestHandler_UpdateUser(t *testing.T) {
 		)
 
 		if tt.mocked.expUpdateUser {
-			ctrlMock.EXPECT().UpdateUser(mock.Anything, mock.Anything).Return(tt.mocked.user, tt.mocked.userErr)
+			ctrlMock.EXPECT().
+				UpdateUser(mock.Anything, mock.MatchedBy(func(req model.UpdateUserRequest) bool {
+					return req.Version == tt.mocked.version
+				})).
+				Return(tt.mocked.user, tt.mocked.userErr)
 		}
 		t.Run(name, func(t *testing.T) {
 			h := Handler{
@@ -158,10 +248,13 @@ func TestHandler_UpdateUser(t *testing.T) {
 			h.UpdateUser(ginCtx)
 
 			assert.Equal(t, tt.expected.Status, w.Code)
-			resBody := w.Body.String()
-			body, err := json.Marshal(tt.expected.Body)
-			assert.Nil(t, err)
-			assert.Equal(t, resBody, string(body))
+			assert.Equal(t, tt.expected.ETag, w.Header().Get("ETag"))
+			if tt.expected.Body != nil {
+				resBody := w.Body.String()
+				body, err := json.Marshal(tt.expected.Body)
+				assert.Nil(t, err)
+				assert.Equal(t, resBody, string(body))
+			}
 		})
 	}
 }
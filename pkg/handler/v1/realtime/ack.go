@@ -0,0 +1,62 @@
+package realtime
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/dwarvesf/go-api/pkg/handler/v1/view"
+	"github.com/dwarvesf/go-api/pkg/middleware"
+	rt "github.com/dwarvesf/go-api/pkg/realtime"
+	"github.com/dwarvesf/go-api/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// Ack godoc
+// @Summary Acknowledge offline messages
+// @Description Remove the delivered offline messages from the queue, used by SSE clients which can't send an ack frame
+// @id ackRealtimeMessages
+// @Tags Realtime
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param body body AckMessagesRequest true "Acknowledged messages"
+// @Success 200 {object} MessageResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /realtime/ack [post]
+func (h Handler) Ack(c *gin.Context) {
+	const spanName = "ackMessagesHandler"
+	ctx, span := h.monitor.Start(c.Request.Context(), spanName)
+	defer span.End()
+
+	uID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	var req view.AckMessagesRequest
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	if h.offline != nil {
+		for _, id := range req.IDs {
+			err = h.offline.Ack(ctx, rt.PrefixUser+strconv.Itoa(uID), id)
+			if err != nil {
+				h.log.Error(err)
+				util.HandleError(c, err)
+				return
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, view.MessageResponse{
+		Data: view.Message{
+			Message: "success",
+		},
+	})
+}
@@ -0,0 +1,103 @@
+package realtime
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mocks "github.com/dwarvesf/go-api/mocks/pkg/realtime"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/handler/testutil"
+	"github.com/dwarvesf/go-api/pkg/handler/v1/view"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_Ack(t *testing.T) {
+	type mocked struct {
+		expUpdateJWT bool
+		userID       int
+		ackIDs       []string
+		ackErr       error
+	}
+	type expected struct {
+		Status int
+		Body   string
+	}
+	tests := map[string]struct {
+		body     any
+		mocked   mocked
+		expected expected
+	}{
+		"success": {
+			body: view.AckMessagesRequest{IDs: []string{"1", "2"}},
+			mocked: mocked{
+				expUpdateJWT: true,
+				userID:       1,
+				ackIDs:       []string{"1", "2"},
+			},
+			expected: expected{
+				Status: http.StatusOK,
+				Body:   "success",
+			},
+		},
+		"unauthorized": {
+			body: view.AckMessagesRequest{IDs: []string{"1"}},
+			expected: expected{
+				Status: http.StatusUnauthorized,
+				Body:   "Unauthorized",
+			},
+		},
+		"invalid body": {
+			body: map[string]string{},
+			mocked: mocked{
+				expUpdateJWT: true,
+				userID:       1,
+			},
+			expected: expected{
+				Status: http.StatusInternalServerError,
+				Body:   "required",
+			},
+		},
+		"failed to ack": {
+			body: view.AckMessagesRequest{IDs: []string{"1"}},
+			mocked: mocked{
+				expUpdateJWT: true,
+				userID:       1,
+				ackIDs:       []string{"1"},
+				ackErr:       errors.New("failed to ack"),
+			},
+			expected: expected{
+				Status: http.StatusInternalServerError,
+				Body:   "failed to ack",
+			},
+		},
+	}
+	for name, tt := range tests {
+		w := httptest.NewRecorder()
+		ginCtx := testutil.NewRequest(w, testutil.MethodPost, nil, nil, nil, tt.body)
+		if tt.mocked.expUpdateJWT {
+			testutil.UpdateJWT(ginCtx, tt.mocked.userID, "user")
+		}
+		var (
+			offlineMock = mocks.NewOfflineQueue(t)
+		)
+		for _, id := range tt.mocked.ackIDs {
+			offlineMock.EXPECT().Ack(mock.Anything, "user-1", id).Return(tt.mocked.ackErr)
+		}
+		t.Run(name, func(t *testing.T) {
+			h := Handler{
+				log:     logger.NewLogger(),
+				cfg:     config.LoadTestConfig(),
+				monitor: monitor.TestMonitor(),
+				offline: offlineMock,
+			}
+			h.Ack(ginCtx)
+			assert.Equal(t, tt.expected.Status, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expected.Body)
+		})
+	}
+}
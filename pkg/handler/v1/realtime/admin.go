@@ -0,0 +1,130 @@
+package realtime
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/handler/v1/view"
+	"github.com/dwarvesf/go-api/pkg/model"
+	rt "github.com/dwarvesf/go-api/pkg/realtime"
+	"github.com/dwarvesf/go-api/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// Connections godoc
+// @Summary List the realtime connections
+// @Description List the connected devices of the WebSocket and SSE servers with the channels they are subscribed to
+// @id listRealtimeConnections
+// @Tags Admin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} RealtimeConnectionsResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /admin/realtime/connections [get]
+func (h Handler) Connections(c *gin.Context) {
+	const spanName = "listConnectionsHandler"
+	_, span := h.monitor.Start(c.Request.Context(), spanName)
+	defer span.End()
+
+	rs := []view.RealtimeConnection{}
+	for server, s := range h.servers() {
+		for _, conn := range s.Connections() {
+			rs = append(rs, view.RealtimeConnection{
+				Server:        server,
+				UserID:        conn.UserID,
+				DeviceID:      conn.DeviceID,
+				IsGuest:       conn.IsGuest,
+				IP:            conn.IP,
+				ConnectedAt:   conn.ConnectedAt,
+				Subscriptions: h.topics.Subscriptions(conn.DeviceID),
+			})
+		}
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].ConnectedAt.Before(rs[j].ConnectedAt)
+	})
+
+	c.JSON(http.StatusOK, view.RealtimeConnectionsResponse{
+		Data: rs,
+	})
+}
+
+// Disconnect godoc
+// @Summary Force disconnect a realtime user
+// @Description Send auth.session_revoked then close every connection of the user, or a single device when deviceId is set
+// @id disconnectRealtimeUser
+// @Tags Admin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param userID path string true "Realtime user ID, e.g. user-1 or guest-abc"
+// @Param deviceId query string false "Device ID"
+// @Success 200 {object} MessageResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/realtime/connections/{userID} [delete]
+func (h Handler) Disconnect(c *gin.Context) {
+	const spanName = "disconnectHandler"
+	_, span := h.monitor.Start(c.Request.Context(), spanName)
+	defer span.End()
+
+	userID := c.Param("userID")
+	deviceID := c.Query("deviceId")
+
+	msg, err := rt.NewMessage(string(model.EventSessionRevoked), view.UserEvent{
+		Type:       string(model.EventSessionRevoked),
+		OccurredAt: time.Now(),
+	})
+	if err != nil {
+		h.log.Error(err)
+		util.HandleError(c, err)
+		return
+	}
+
+	found := false
+	for name, s := range h.servers() {
+		for _, conn := range s.Connections() {
+			if conn.UserID != userID || (deviceID != "" && conn.DeviceID != deviceID) {
+				continue
+			}
+
+			found = true
+			u := rt.User{ID: conn.UserID, DeviceID: conn.DeviceID}
+			// the revocation is sent before the disconnection so the device signs out
+			// instead of reconnecting, guests have no session to revoke
+			if !conn.IsGuest {
+				if err := s.SendDeviceData(u, msg); err != nil {
+					h.log.Errorf(err, "failed to send the session revocation to the %s device %s", name, conn.DeviceID)
+				}
+			}
+			err := s.DisconnectUser(u)
+			if err != nil {
+				h.log.Error(err)
+				util.HandleError(c, err)
+				return
+			}
+		}
+	}
+	if !found {
+		util.HandleError(c, model.ErrNotFound)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.MessageResponse{
+		Data: view.Message{
+			Message: "success",
+		},
+	})
+}
+
+// servers returns the realtime servers by name.
+func (h Handler) servers() map[string]rt.Server {
+	return map[string]rt.Server{
+		"ws":  h.wsServer,
+		"sse": h.sseServer,
+	}
+}
@@ -0,0 +1,167 @@
+package realtime
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	mocks "github.com/dwarvesf/go-api/mocks/pkg/realtime"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/handler/testutil"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/model"
+	rt "github.com/dwarvesf/go-api/pkg/realtime"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_Connections(t *testing.T) {
+	now := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	w := httptest.NewRecorder()
+	ginCtx := testutil.NewRequest(w, testutil.MethodGet, nil, nil, nil, nil)
+
+	wsMock := mocks.NewServer(t)
+	wsMock.EXPECT().Connections().Return([]rt.ConnectionInfo{
+		{UserID: "user-1", DeviceID: "user-1-a", IP: "1.1.1.1", ConnectedAt: now.Add(time.Minute)},
+	})
+	sseMock := mocks.NewServer(t)
+	sseMock.EXPECT().Connections().Return([]rt.ConnectionInfo{
+		{UserID: "guest-b", DeviceID: "guest-b", IsGuest: true, IP: "2.2.2.2", ConnectedAt: now},
+	})
+
+	topics := rt.NewTopics()
+	topics.Subscribe(rt.User{ID: "user-1", DeviceID: "user-1-a"}, "campaigns")
+
+	h := Handler{
+		log:       logger.NewLogger(),
+		cfg:       config.LoadTestConfig(),
+		monitor:   monitor.TestMonitor(),
+		wsServer:  wsMock,
+		sseServer: sseMock,
+		topics:    topics,
+	}
+	h.Connections(ginCtx)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"data":[
+		{"server":"sse","userId":"guest-b","deviceId":"guest-b","isGuest":true,"ip":"2.2.2.2","connectedAt":"2026-10-19T00:00:00Z","subscriptions":[]},
+		{"server":"ws","userId":"user-1","deviceId":"user-1-a","isGuest":false,"ip":"1.1.1.1","connectedAt":"2026-10-19T00:01:00Z","subscriptions":["campaigns"]}
+	]}`, w.Body.String())
+}
+
+func TestHandler_Disconnect(t *testing.T) {
+	conns := []rt.ConnectionInfo{
+		{UserID: "user-1", DeviceID: "user-1-a"},
+		{UserID: "user-1", DeviceID: "user-1-b"},
+		{UserID: "user-2", DeviceID: "user-2-a"},
+		{UserID: "guest-a", DeviceID: "guest-a", IsGuest: true},
+	}
+	type mocked struct {
+		revoked       []rt.User
+		disconnected  []rt.User
+		disconnectErr error
+	}
+	type expected struct {
+		Status int
+		Body   string
+	}
+	tests := map[string]struct {
+		userID   string
+		deviceID string
+		mocked   mocked
+		expected expected
+	}{
+		"every device of the user": {
+			userID: "user-1",
+			mocked: mocked{
+				revoked:      []rt.User{{ID: "user-1", DeviceID: "user-1-a"}, {ID: "user-1", DeviceID: "user-1-b"}},
+				disconnected: []rt.User{{ID: "user-1", DeviceID: "user-1-a"}, {ID: "user-1", DeviceID: "user-1-b"}},
+			},
+			expected: expected{
+				Status: http.StatusOK,
+				Body:   "success",
+			},
+		},
+		"single device": {
+			userID:   "user-1",
+			deviceID: "user-1-b",
+			mocked: mocked{
+				revoked:      []rt.User{{ID: "user-1", DeviceID: "user-1-b"}},
+				disconnected: []rt.User{{ID: "user-1", DeviceID: "user-1-b"}},
+			},
+			expected: expected{
+				Status: http.StatusOK,
+				Body:   "success",
+			},
+		},
+		"guest has no session to revoke": {
+			userID: "guest-a",
+			mocked: mocked{
+				disconnected: []rt.User{{ID: "guest-a", DeviceID: "guest-a"}},
+			},
+			expected: expected{
+				Status: http.StatusOK,
+				Body:   "success",
+			},
+		},
+		"not connected": {
+			userID: "user-3",
+			expected: expected{
+				Status: http.StatusNotFound,
+				Body:   "not found",
+			},
+		},
+		"failed to disconnect": {
+			userID:   "user-2",
+			deviceID: "user-2-a",
+			mocked: mocked{
+				revoked:       []rt.User{{ID: "user-2", DeviceID: "user-2-a"}},
+				disconnected:  []rt.User{{ID: "user-2", DeviceID: "user-2-a"}},
+				disconnectErr: errors.New("failed to disconnect"),
+			},
+			expected: expected{
+				Status: http.StatusInternalServerError,
+				Body:   "failed to disconnect",
+			},
+		},
+	}
+	for name, tt := range tests {
+		w := httptest.NewRecorder()
+		q := url.Values{}
+		if tt.deviceID != "" {
+			q.Set("deviceId", tt.deviceID)
+		}
+		ginCtx := testutil.NewRequest(w, testutil.MethodDelete, nil, []gin.Param{{Key: "userID", Value: tt.userID}}, q, nil)
+
+		wsMock := mocks.NewServer(t)
+		wsMock.EXPECT().Connections().Return(conns).Maybe()
+		for _, u := range tt.mocked.revoked {
+			wsMock.EXPECT().SendDeviceData(u, mock.MatchedBy(func(m rt.Message) bool {
+				return m.Type == string(model.EventSessionRevoked)
+			})).Return(nil)
+		}
+		for _, u := range tt.mocked.disconnected {
+			wsMock.EXPECT().DisconnectUser(u).Return(tt.mocked.disconnectErr)
+		}
+		sseMock := mocks.NewServer(t)
+		sseMock.EXPECT().Connections().Return(nil).Maybe()
+
+		t.Run(name, func(t *testing.T) {
+			h := Handler{
+				log:       logger.NewLogger(),
+				cfg:       config.LoadTestConfig(),
+				monitor:   monitor.TestMonitor(),
+				wsServer:  wsMock,
+				sseServer: sseMock,
+			}
+			h.Disconnect(ginCtx)
+			assert.Equal(t, tt.expected.Status, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expected.Body)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package realtime
+
+import (
+	"github.com/dwarvesf/go-api/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// WebSocket godoc
+// @Summary Open a WebSocket connection
+// @Description Upgrade to a WebSocket connection, browsers authenticate with a ticket because they can't set the Authorization header
+// @id connectWebSocket
+// @Tags Realtime
+// @Param ticket query string false "One-time connection ticket"
+// @Success 101
+// @Failure 401 {object} ErrorResponse
+// @Router /ws [get]
+func (h Handler) WebSocket(c *gin.Context) {
+	u, err := h.wsServer.HandleConnection(c)
+	if err != nil {
+		h.log.Error(err, "failed to handle websocket connection")
+		// the upgrader already replied when the upgrade itself failed
+		if !c.Writer.Written() {
+			util.HandleError(c, err)
+		}
+		return
+	}
+
+	h.log.Infof("user %s connected", u.ID)
+	h.wsServer.HandleEvent(c, *u, h.router.Callback(h.wsServer, *u))
+	h.topics.RemoveDevice(*u)
+}
+
+// SSE godoc
+// @Summary Open a server-sent events stream
+// @Description Open a server-sent events stream, browsers authenticate with a ticket because they can't set the Authorization header
+// @id connectSSE
+// @Tags Realtime
+// @Produce text/event-stream
+// @Param ticket query string false "One-time connection ticket"
+// @Success 200
+// @Failure 401 {object} ErrorResponse
+// @Router /sse [get]
+func (h Handler) SSE(c *gin.Context) {
+	u, err := h.sseServer.HandleConnection(c)
+	if err != nil {
+		h.log.Error(err, "failed to handle sse connection")
+		// the stream already ended with an error event when a limit was reached
+		if !c.Writer.Written() {
+			util.HandleError(c, err)
+		}
+		return
+	}
+
+	h.log.Infof("user %s connected", u.ID)
+	h.sseServer.HandleEvent(c, *u, h.router.Callback(h.sseServer, *u))
+	h.topics.RemoveDevice(*u)
+}
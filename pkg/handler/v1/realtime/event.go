@@ -0,0 +1,65 @@
+package realtime
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/dwarvesf/go-api/pkg/handler/v1/view"
+	"github.com/dwarvesf/go-api/pkg/model"
+	rt "github.com/dwarvesf/go-api/pkg/realtime"
+)
+
+// ForwardEvent sends the domain event to every connected device of the user
+// so that the open tabs stay in sync. The event is queued once when no device
+// received it and the offline queue is enabled, otherwise it's dropped.
+// The servers only queue the event for the writer of every device, so a slow
+// device never blocks the event bus.
+//
+// The event bus and the presence of the devices are in-process, so the offline
+// queue is only correct with a single replica: a user connected to another
+// replica would get the event queued here once more when reconnecting.
+func (h Handler) ForwardEvent(e model.Event) error {
+	msg, err := rt.NewMessage(string(e.Type), toUserEvent(e))
+	if err != nil {
+		return err
+	}
+
+	userID := rt.PrefixUser + strconv.Itoa(e.UserID)
+	sent := 0
+	for name, s := range h.servers() {
+		n, err := s.SendConnected(userID, msg)
+		if err != nil {
+			h.log.Errorf(err, "failed to send %s event to the %s devices of %s", e.Type, name, userID)
+		}
+		sent += n
+	}
+
+	if sent == 0 && h.offline != nil {
+		return h.offline.Push(context.Background(), userID, msg)
+	}
+
+	return nil
+}
+
+func toUserEvent(e model.Event) view.UserEvent {
+	rs := view.UserEvent{
+		Type:       string(e.Type),
+		OccurredAt: e.OccurredAt,
+	}
+	if e.User != nil {
+		rs.User = &view.User{
+			ID:       e.User.ID,
+			Email:    e.User.Email,
+			FullName: e.User.FullName,
+			Avatar:   e.User.Avatar,
+		}
+	}
+	if e.Device != nil {
+		rs.Device = &view.Device{
+			IP:        e.Device.IP,
+			UserAgent: e.Device.UserAgent,
+		}
+	}
+
+	return rs
+}
@@ -0,0 +1,112 @@
+package realtime
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	mocks "github.com/dwarvesf/go-api/mocks/pkg/realtime"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/model"
+	rt "github.com/dwarvesf/go-api/pkg/realtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_ForwardEvent(t *testing.T) {
+	now := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	event := model.Event{
+		Type:       model.EventProfileUpdated,
+		UserID:     1,
+		OccurredAt: now,
+		User: &model.User{
+			ID:             1,
+			Email:          "admin@d.foundation",
+			FullName:       "admin",
+			Avatar:         "https://d.foundation/avatar.png",
+			HashedPassword: "hash",
+		},
+	}
+	want := rt.Message{
+		Version: rt.ProtocolVersion,
+		Type:    "user.profile_updated",
+		Payload: json.RawMessage(`{"type":"user.profile_updated","occurredAt":"2026-10-19T00:00:00Z",` +
+			`"user":{"id":1,"email":"admin@d.foundation","fullName":"admin","avatar":"https://d.foundation/avatar.png"}}`),
+	}
+
+	type mocked struct {
+		wsSent    int
+		wsErr     error
+		sseSent   int
+		offline   bool
+		expQueued bool
+	}
+	tests := map[string]struct {
+		mocked  mocked
+		wantErr bool
+	}{
+		"connected to both servers": {
+			mocked: mocked{
+				wsSent:  1,
+				sseSent: 1,
+				offline: true,
+			},
+		},
+		"connected to sse only": {
+			mocked: mocked{
+				sseSent: 2,
+				offline: true,
+			},
+		},
+		"offline with queue": {
+			mocked: mocked{
+				offline:   true,
+				expQueued: true,
+			},
+		},
+		"offline without queue": {
+			mocked: mocked{},
+		},
+		"ws send failed": {
+			mocked: mocked{
+				wsErr:   errors.New("failed"),
+				sseSent: 1,
+				offline: true,
+			},
+		},
+		"send failed on every device": {
+			mocked: mocked{
+				wsErr:     errors.New("failed"),
+				offline:   true,
+				expQueued: true,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			wsMock := mocks.NewServer(t)
+			wsMock.EXPECT().SendConnected("user-1", want).Return(tt.mocked.wsSent, tt.mocked.wsErr)
+
+			sseMock := mocks.NewServer(t)
+			sseMock.EXPECT().SendConnected("user-1", want).Return(tt.mocked.sseSent, nil)
+
+			h := Handler{
+				log:       logger.NewLogger(),
+				wsServer:  wsMock,
+				sseServer: sseMock,
+			}
+			if tt.mocked.offline {
+				offlineMock := mocks.NewOfflineQueue(t)
+				if tt.mocked.expQueued {
+					offlineMock.EXPECT().Push(mock.Anything, "user-1", want).Return(nil)
+				}
+				h.offline = offlineMock
+			}
+
+			err := h.ForwardEvent(event)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
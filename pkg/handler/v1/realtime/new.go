@@ -0,0 +1,36 @@
+package realtime
+
+import (
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	rt "github.com/dwarvesf/go-api/pkg/realtime"
+)
+
+// Handler for realtime
+type Handler struct {
+	cfg       config.Config
+	log       logger.Log
+	monitor   monitor.Tracer
+	wsServer  rt.Server
+	sseServer rt.Server
+	tickets   rt.TicketStore
+	offline   rt.OfflineQueue
+	router    *rt.Router
+	topics    *rt.Topics
+}
+
+// New will return an instance of realtime handler
+func New(cfg config.Config, l logger.Log, monitor monitor.Tracer, wsServer rt.Server, sseServer rt.Server, tickets rt.TicketStore, offline rt.OfflineQueue, router *rt.Router, topics *rt.Topics) *Handler {
+	return &Handler{
+		cfg:       cfg,
+		log:       l,
+		monitor:   monitor,
+		wsServer:  wsServer,
+		sseServer: sseServer,
+		tickets:   tickets,
+		offline:   offline,
+		router:    router,
+		topics:    topics,
+	}
+}
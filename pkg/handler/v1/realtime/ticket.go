@@ -0,0 +1,48 @@
+package realtime
+
+import (
+	"net/http"
+
+	"github.com/dwarvesf/go-api/pkg/handler/v1/view"
+	"github.com/dwarvesf/go-api/pkg/middleware"
+	"github.com/dwarvesf/go-api/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// Ticket godoc
+// @Summary Issue a realtime connection ticket
+// @Description Issue a short-lived one-time ticket to open a WebSocket or SSE connection
+// @id issueRealtimeTicket
+// @Tags Realtime
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} TicketResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /realtime/ticket [post]
+func (h Handler) Ticket(c *gin.Context) {
+	const spanName = "issueTicketHandler"
+	ctx, span := h.monitor.Start(c.Request.Context(), spanName)
+	defer span.End()
+
+	uID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	t, err := h.tickets.Issue(ctx, uID)
+	if err != nil {
+		h.log.Error(err)
+		util.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.TicketResponse{
+		Data: view.Ticket{
+			Ticket:    t.Value,
+			ExpiresAt: t.ExpiresAt,
+		},
+	})
+}
@@ -0,0 +1,95 @@
+package realtime
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mocks "github.com/dwarvesf/go-api/mocks/pkg/realtime"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/handler/testutil"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	rt "github.com/dwarvesf/go-api/pkg/realtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_Ticket(t *testing.T) {
+	type mocked struct {
+		expUpdateJWT bool
+		userID       int
+		expIssue     bool
+		ticket       *rt.Ticket
+		issueErr     error
+	}
+	type expected struct {
+		Status int
+		Body   string
+	}
+	tests := map[string]struct {
+		mocked   mocked
+		expected expected
+	}{
+		"success": {
+			mocked: mocked{
+				expUpdateJWT: true,
+				userID:       1,
+				expIssue:     true,
+				ticket: &rt.Ticket{
+					Value:     "ticket",
+					UserID:    1,
+					ExpiresAt: time.Now(),
+				},
+			},
+			expected: expected{
+				Status: http.StatusOK,
+				Body:   `"ticket":"ticket"`,
+			},
+		},
+		"unauthorized": {
+			expected: expected{
+				Status: http.StatusUnauthorized,
+				Body:   "Unauthorized",
+			},
+		},
+		"failed to issue": {
+			mocked: mocked{
+				expUpdateJWT: true,
+				userID:       1,
+				expIssue:     true,
+				issueErr:     errors.New("failed to issue"),
+			},
+			expected: expected{
+				Status: http.StatusInternalServerError,
+				Body:   "failed to issue",
+			},
+		},
+	}
+	for name, tt := range tests {
+		w := httptest.NewRecorder()
+		ginCtx := testutil.NewRequest(w, testutil.MethodPost, nil, nil, nil, nil)
+		if tt.mocked.expUpdateJWT {
+			testutil.UpdateJWT(ginCtx, tt.mocked.userID, "user")
+		}
+		var (
+			ticketMock = mocks.NewTicketStore(t)
+		)
+		if tt.mocked.expIssue {
+			ticketMock.EXPECT().Issue(mock.Anything, tt.mocked.userID).Return(tt.mocked.ticket, tt.mocked.issueErr)
+		}
+		t.Run(name, func(t *testing.T) {
+			h := Handler{
+				log:     logger.NewLogger(),
+				cfg:     config.LoadTestConfig(),
+				monitor: monitor.TestMonitor(),
+				tickets: ticketMock,
+			}
+			h.Ticket(ginCtx)
+			assert.Equal(t, tt.expected.Status, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expected.Body)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package view
+
+import "time"
+
+// CampaignsResponse represent the campaigns response
+type CampaignsResponse = Response[[]Campaign] // @name CampaignsResponse
+
+// CampaignResponse represent the campaign response
+type CampaignResponse = Response[Campaign] // @name CampaignResponse
+
+// Campaign represent a mail sent to an audience at a scheduled time
+type Campaign struct {
+	ID          int        `json:"id" validate:"required"`
+	Name        string     `json:"name" validate:"required"`
+	Template    string     `json:"template" validate:"required"`
+	Audience    Audience   `json:"audience" validate:"required"`
+	Status      string     `json:"status" validate:"required"`
+	ScheduledAt *time.Time `json:"scheduledAt"`
+	StartedAt   *time.Time `json:"startedAt"`
+	FinishedAt  *time.Time `json:"finishedAt"`
+	CreatedAt   time.Time  `json:"createdAt" validate:"required"`
+} // @name Campaign
+
+// Audience represent the filter of the users a campaign is sent to
+type Audience struct {
+	Role        string     `json:"role"`
+	Status      string     `json:"status"`
+	CreatedFrom *time.Time `json:"createdFrom"`
+	CreatedTo   *time.Time `json:"createdTo"`
+	Query       string     `json:"query"`
+} // @name Audience
+
+// CampaignRequest represent the create and update campaign request
+type CampaignRequest struct {
+	Name     string   `json:"name" binding:"required"`
+	Template string   `json:"template" binding:"required"`
+	Audience Audience `json:"audience"`
+} // @name CampaignRequest
+
+// ScheduleCampaignRequest represent the schedule campaign request
+type ScheduleCampaignRequest struct {
+	ScheduledAt time.Time `json:"scheduledAt" binding:"required"`
+} // @name ScheduleCampaignRequest
+
+// CampaignPreviewResponse represent the campaign preview response
+type CampaignPreviewResponse = Response[CampaignPreview] // @name CampaignPreviewResponse
+
+// CampaignPreview represent the number of users a campaign would be sent to
+type CampaignPreview struct {
+	Recipients int64 `json:"recipients" validate:"required"`
+} // @name CampaignPreview
+
+// CampaignSampleResponse represent the campaign sample response
+type CampaignSampleResponse = Response[CampaignSample] // @name CampaignSampleResponse
+
+// CampaignSample represent the mail of a campaign rendered for a recipient
+type CampaignSample struct {
+	To      []string `json:"to" validate:"required"`
+	Subject string   `json:"subject" validate:"required"`
+	HTML    string   `json:"html"`
+	Text    string   `json:"text"`
+} // @name CampaignSample
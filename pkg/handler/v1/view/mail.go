@@ -0,0 +1,14 @@
+package view
+
+// MailEventsRequest represent the events reported by the mail provider
+type MailEventsRequest struct {
+	Events []MailEvent `json:"events" binding:"required"`
+} // @name MailEventsRequest
+
+// MailEvent represent a bounce or a complaint
+type MailEvent struct {
+	Type      string `json:"type" binding:"required" enums:"bounce,complaint"`
+	Email     string `json:"email" binding:"required"`
+	MessageID string `json:"messageId"`
+	Detail    string `json:"detail"`
+} // @name MailEvent
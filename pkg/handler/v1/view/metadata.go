@@ -2,10 +2,28 @@ package view
 
 // Metadata is the response for metadata
 type Metadata struct {
-	Page         int  `json:"page" validate:"required"`
-	PageSize     int  `json:"pageSize" validate:"required"`
-	TotalPages   int  `json:"totalPages" validate:"required"`
-	TotalRecords int  `json:"totalRecords" validate:"required"`
-	Sort         int  `json:"sort,omitempty"`
-	HasNext      bool `json:"hasNext,omitempty"`
+	Page         int    `json:"page" validate:"required"`
+	PageSize     int    `json:"pageSize" validate:"required"`
+	TotalPages   int    `json:"totalPages" validate:"required"`
+	TotalRecords int    `json:"totalRecords" validate:"required"`
+	Sort         string `json:"sort,omitempty"`
+	HasNext      bool   `json:"hasNext,omitempty"`
+	NextCursor   string `json:"nextCursor,omitempty"`
+	PrevCursor   string `json:"prevCursor,omitempty"`
 } // @name Metadata
+
+// ListQuery represent the query of a list, paginated by page or by cursor
+type ListQuery struct {
+	Page     int    `form:"page"`
+	PageSize int    `form:"pageSize"`
+	Sort     string `form:"sort"`
+	Query    string `form:"query"`
+	// Paginate is offset or cursor, cursor when a cursor is given
+	Paginate  string `form:"paginate" binding:"omitempty,oneof=offset cursor"`
+	Cursor    string `form:"cursor"`
+	SkipCount bool   `form:"skipCount"`
+	// Search ranks the results by relevance to the query, Fuzzy and Highlight are its options
+	Search    bool `form:"search"`
+	Fuzzy     bool `form:"fuzzy"`
+	Highlight bool `form:"highlight"`
+} // @name ListQuery
@@ -0,0 +1,45 @@
+package view
+
+import "time"
+
+// TicketResponse represent the realtime ticket response
+type TicketResponse = Response[Ticket] // @name TicketResponse
+
+// Ticket represent the one-time ticket to open a realtime connection
+type Ticket struct {
+	Ticket    string    `json:"ticket" validate:"required"`
+	ExpiresAt time.Time `json:"expiresAt" validate:"required"`
+} // @name Ticket
+
+// AckMessagesRequest represent the request to acknowledge offline messages
+type AckMessagesRequest struct {
+	IDs []string `json:"ids" binding:"required"`
+} // @name AckMessagesRequest
+
+// RealtimeConnectionsResponse represent the realtime connections response
+type RealtimeConnectionsResponse = Response[[]RealtimeConnection] // @name RealtimeConnectionsResponse
+
+// RealtimeConnection represent a connected realtime device
+type RealtimeConnection struct {
+	Server        string    `json:"server" validate:"required"`
+	UserID        string    `json:"userId" validate:"required"`
+	DeviceID      string    `json:"deviceId" validate:"required"`
+	IsGuest       bool      `json:"isGuest" validate:"required"`
+	IP            string    `json:"ip" validate:"required"`
+	ConnectedAt   time.Time `json:"connectedAt" validate:"required"`
+	Subscriptions []string  `json:"subscriptions" validate:"required"`
+} // @name RealtimeConnection
+
+// UserEvent represent a domain event sent to the devices of the user
+type UserEvent struct {
+	Type       string    `json:"type" validate:"required"`
+	OccurredAt time.Time `json:"occurredAt" validate:"required"`
+	User       *User     `json:"user,omitempty"`
+	Device     *Device   `json:"device,omitempty"`
+} // @name UserEvent
+
+// Device represent the device a request was made from
+type Device struct {
+	IP        string `json:"ip" validate:"required"`
+	UserAgent string `json:"userAgent" validate:"required"`
+} // @name Device
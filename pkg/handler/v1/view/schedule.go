@@ -0,0 +1,17 @@
+package view
+
+import "time"
+
+// SchedulesResponse represent the schedules response
+type SchedulesResponse = Response[[]Schedule] // @name SchedulesResponse
+
+// Schedule represent a periodic task with its last and next run
+type Schedule struct {
+	Name           string     `json:"name" validate:"required"`
+	Spec           string     `json:"spec" validate:"required"`
+	NextRunAt      *time.Time `json:"nextRunAt"`
+	LastRunAt      *time.Time `json:"lastRunAt"`
+	LastStatus     string     `json:"lastStatus"`
+	LastError      string     `json:"lastError"`
+	LastDurationMS int64      `json:"lastDurationMs"`
+} // @name Schedule
@@ -24,6 +24,16 @@ type UpdatePasswordRequest struct {
 // UserResponse represent the user response
 type UserResponse = Response[User] // @name UserResponse
 
+// UsersResponse represent the list of users response
+type UsersResponse = ListResponse[UserItem] // @name UsersResponse
+
+// UserItem represent a user of a list
+type UserItem struct {
+	User
+	// Highlight is the name and email with the matched terms in <b> tags, when searching with highlight
+	Highlight map[string]string `json:"highlight,omitempty"`
+} // @name UserItem
+
 // User represent the user
 type User struct {
 	ID       int    `json:"id" validate:"required"`
@@ -31,3 +41,21 @@ type User struct {
 	FullName string `json:"fullName" validate:"required"`
 	Avatar   string `json:"avatar" validate:"required"`
 } // @name User
+
+// MailPreferenceResponse represent the mail preference response
+type MailPreferenceResponse = Response[MailPreference] // @name MailPreferenceResponse
+
+// MailPreference represent the mails the user agreed to receive
+type MailPreference struct {
+	Campaigns bool `json:"campaigns" validate:"required"`
+} // @name MailPreference
+
+// UpdateMailPreferenceRequest represent the update mail preference request
+type UpdateMailPreferenceRequest struct {
+	Campaigns *bool `json:"campaigns" binding:"required"`
+} // @name UpdateMailPreferenceRequest
+
+// UnsubscribeRequest represent the unsubscribe request
+type UnsubscribeRequest struct {
+	Token string `json:"token" form:"token" binding:"required"`
+} // @name UnsubscribeRequest
@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/dwarvesf/go-api/pkg/handler/v1/view"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// headerWebhookSecret is the header carrying the secret shared with the mail provider
+const headerWebhookSecret = "X-Webhook-Secret"
+
+// MailEvents godoc
+// @Summary Ingest the events of the mail provider
+// @Description Mark the addresses of the bounces and complaints as undeliverable, the request is authenticated by the X-Webhook-Secret header
+// @id mailEvents
+// @Tags Webhook
+// @Accept  json
+// @Produce  json
+// @Param X-Webhook-Secret header string true "Webhook secret"
+// @Param body body MailEventsRequest true "Events"
+// @Success 200 {object} MessageResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /webhooks/mail [post]
+func (h Handler) MailEvents(c *gin.Context) {
+	const spanName = "mailEventsHandler"
+	ctx, span := h.monitor.Start(c.Request.Context(), spanName)
+	defer span.End()
+
+	// the webhook is disabled until a secret is configured
+	secret := c.GetHeader(headerWebhookSecret)
+	if h.cfg.MailWebhookSecret == "" || subtle.ConstantTimeCompare([]byte(secret), []byte(h.cfg.MailWebhookSecret)) != 1 {
+		util.HandleError(c, model.ErrInvalidToken)
+		return
+	}
+
+	var req view.MailEventsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		util.HandleError(c, err)
+		return
+	}
+
+	events := make([]model.MailEvent, 0, len(req.Events))
+	for _, e := range req.Events {
+		events = append(events, model.MailEvent{
+			Type:      model.MailEventType(e.Type),
+			Email:     e.Email,
+			MessageID: e.MessageID,
+			Detail:    e.Detail,
+		})
+	}
+
+	if err := h.mailCtrl.HandleEvents(ctx, events); err != nil {
+		h.log.Error(err, "failed to handle mail events")
+		util.HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.MessageResponse{
+		Data: view.Message{
+			Message: "success",
+		},
+	})
+}
@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mocks "github.com/dwarvesf/go-api/mocks/pkg/controller/mail"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/handler/testutil"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_MailEvents(t *testing.T) {
+	body := map[string]any{
+		"events": []map[string]any{
+			{"type": "bounce", "email": "admin@d.foundation", "messageId": "<1@d.foundation>", "detail": "mailbox full"},
+		},
+	}
+
+	type expected struct {
+		Status int
+		Body   string
+	}
+	tests := map[string]struct {
+		secret   string
+		header   string
+		expCall  bool
+		ctrlErr  error
+		expected expected
+	}{
+		"success": {
+			secret:  "secret",
+			header:  "secret",
+			expCall: true,
+			expected: expected{
+				Status: http.StatusOK,
+				Body:   `{"data":{"message":"success"}}`,
+			},
+		},
+		"invalid event": {
+			secret:  "secret",
+			header:  "secret",
+			expCall: true,
+			ctrlErr: model.ErrInvalidMailEvent,
+			expected: expected{
+				Status: http.StatusBadRequest,
+				Body:   `{"code":"INVALID_MAIL_EVENT","message":"invalid mail event","status":400,"traceID":"00000000000000000000000000000000"}`,
+			},
+		},
+		"wrong secret": {
+			secret: "secret",
+			header: "guess",
+			expected: expected{
+				Status: http.StatusUnauthorized,
+				Body:   `{"code":"Unauthorized","message":"Unauthorized","status":401,"traceID":"00000000000000000000000000000000"}`,
+			},
+		},
+		"webhook disabled": {
+			expected: expected{
+				Status: http.StatusUnauthorized,
+				Body:   `{"code":"Unauthorized","message":"Unauthorized","status":401,"traceID":"00000000000000000000000000000000"}`,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ginCtx := testutil.NewRequest(w, testutil.MethodPost, map[string]string{headerWebhookSecret: tt.header}, nil, nil, body)
+
+			ctrlMock := mocks.NewController(t)
+			if tt.expCall {
+				ctrlMock.EXPECT().HandleEvents(mock.Anything, []model.MailEvent{
+					{Type: model.MailEventTypeBounce, Email: "admin@d.foundation", MessageID: "<1@d.foundation>", Detail: "mailbox full"},
+				}).Return(tt.ctrlErr)
+			}
+
+			cfg := config.LoadTestConfig()
+			cfg.MailWebhookSecret = tt.secret
+			h := Handler{
+				log:      logger.NewLogger(),
+				cfg:      cfg,
+				monitor:  monitor.TestMonitor(),
+				mailCtrl: ctrlMock,
+			}
+			h.MailEvents(ginCtx)
+
+			assert.Equal(t, tt.expected.Status, w.Code)
+			assert.JSONEq(t, tt.expected.Body, w.Body.String())
+		})
+	}
+}
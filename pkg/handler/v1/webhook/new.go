@@ -0,0 +1,27 @@
+package webhook
+
+import (
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/controller/mail"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/repository"
+)
+
+// Handler for the webhooks of the third-party services
+type Handler struct {
+	cfg      config.Config
+	log      logger.Log
+	monitor  monitor.Tracer
+	mailCtrl mail.Controller
+}
+
+// New will return an instance of webhook handler
+func New(cfg config.Config, l logger.Log, repo *repository.Repo, monitor monitor.Tracer) *Handler {
+	return &Handler{
+		cfg:      cfg,
+		log:      l,
+		monitor:  monitor,
+		mailCtrl: mail.NewMailController(cfg, repo, monitor),
+	}
+}
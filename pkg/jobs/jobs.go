@@ -0,0 +1,105 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/job"
+)
+
+// DefaultMaxAttempts is the default number of times a job runs before it's dead
+const DefaultMaxAttempts = 5
+
+// Queue enqueues the background jobs
+type Queue interface {
+	Enqueue(ctx context.Context, name string, payload any, opts ...Option) (*model.Job, error)
+}
+
+// Option configures an enqueued job
+type Option func(j *model.Job)
+
+// RunAt schedules the job to run at the given time instead of now.
+func RunAt(t time.Time) Option {
+	return func(j *model.Job) {
+		j.RunAt = t
+	}
+}
+
+// UniqueKey makes enqueuing idempotent, the job already enqueued with the
+// same key is returned instead of creating a new one.
+func UniqueKey(key string) Option {
+	return func(j *model.Job) {
+		j.UniqueKey = key
+	}
+}
+
+// MaxAttempts overrides the number of times the job runs before it's dead.
+func MaxAttempts(n int) Option {
+	return func(j *model.Job) {
+		j.MaxAttempts = n
+	}
+}
+
+type queue struct {
+	repo        job.Repo
+	maxAttempts int
+}
+
+// NewQueue creates a queue which stores the jobs in the database.
+func NewQueue(repo job.Repo, maxAttempts int) Queue {
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+
+	return &queue{
+		repo:        repo,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Enqueue stores the job with the payload encoded as JSON.
+func (q *queue) Enqueue(ctx context.Context, name string, payload any, opts ...Option) (*model.Job, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	j := model.Job{
+		Name:        name,
+		Payload:     body,
+		MaxAttempts: q.maxAttempts,
+		RunAt:       time.Now(),
+	}
+	for _, opt := range opts {
+		opt(&j)
+	}
+
+	return q.repo.Create(db.FromContext(ctx), j)
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks the error of a handler as not retryable, the job is dead right away.
+func Permanent(err error) error {
+	return permanentError{err: err}
+}
+
+// isPermanent reports whether the job shouldn't be retried after the error.
+func isPermanent(err error) bool {
+	var e permanentError
+	return errors.As(err, &e)
+}
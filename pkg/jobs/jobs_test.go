@@ -0,0 +1,54 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocks "github.com/dwarvesf/go-api/mocks/pkg/repository/job"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_queue_Enqueue(t *testing.T) {
+	runAt := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		opts []Option
+		want func(j model.Job) bool
+	}{
+		"default options": {
+			want: func(j model.Job) bool {
+				return j.MaxAttempts == DefaultMaxAttempts && j.UniqueKey == "" && !j.RunAt.IsZero()
+			},
+		},
+		"custom options": {
+			opts: []Option{RunAt(runAt), UniqueKey("daily"), MaxAttempts(1)},
+			want: func(j model.Job) bool {
+				return j.MaxAttempts == 1 && j.UniqueKey == "daily" && j.RunAt.Equal(runAt)
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			repoMock := mocks.NewRepo(t)
+			repoMock.EXPECT().
+				Create(mock.Anything, mock.MatchedBy(func(j model.Job) bool {
+					return j.Name == "send" && string(j.Payload) == `{"email":"admin@d.foundation"}` && tt.want(j)
+				})).
+				Return(&model.Job{ID: 1}, nil)
+
+			_, err := db.Init(config.LoadTestConfig())
+			require.NoError(t, err)
+
+			q := NewQueue(repoMock, 0)
+			got, err := q.Enqueue(context.Background(), "send", map[string]string{"email": "admin@d.foundation"}, tt.opts...)
+			require.NoError(t, err)
+			require.Equal(t, int64(1), got.ID)
+		})
+	}
+}
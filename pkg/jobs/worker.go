@@ -0,0 +1,364 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/job"
+	"github.com/dwarvesf/go-api/pkg/util"
+)
+
+const (
+	// DefaultConcurrency is the default number of jobs running at the same time
+	DefaultConcurrency = 5
+
+	// DefaultPollInterval is the default wait before looking for jobs again when the queue is empty
+	DefaultPollInterval = time.Second
+
+	// DefaultLockTimeout is the default time after which a running job is
+	// considered abandoned by a dead worker and runs again, the worker running
+	// the job extends its lock every third of it
+	DefaultLockTimeout = 10 * time.Minute
+
+	// baseBackoff and maxBackoff bound the wait before retrying a failed job
+	baseBackoff = 10 * time.Second
+	maxBackoff  = time.Hour
+)
+
+// HandlerFunc runs a job, the job is retried when an error is returned
+type HandlerFunc func(ctx context.Context, j model.Job) error
+
+// DeadFunc runs once the job is dead, lastErr is the error which killed it
+type DeadFunc func(ctx context.Context, j model.Job, lastErr string) error
+
+// Worker runs the jobs of the registered handlers with a pool of goroutines
+type Worker struct {
+	repo         job.Repo
+	handlers     map[string]HandlerFunc
+	deadHandlers map[string]DeadFunc
+	mutex        sync.RWMutex
+	id           string
+	concurrency  int
+	pollInterval time.Duration
+	lockTimeout  time.Duration
+	log          logger.Log
+	now          func() time.Time
+}
+
+// NewWorker creates a worker configured by the JOBS_* settings.
+func NewWorker(cfg config.Config, repo job.Repo, l logger.Log) *Worker {
+	w := &Worker{
+		repo:         repo,
+		handlers:     make(map[string]HandlerFunc),
+		deadHandlers: make(map[string]DeadFunc),
+		id:           workerID(),
+		concurrency:  cfg.JobsConcurrency,
+		pollInterval: time.Duration(cfg.JobsPollInterval) * time.Millisecond,
+		lockTimeout:  time.Duration(cfg.JobsLockTimeout) * time.Second,
+		log:          l,
+		now:          time.Now,
+	}
+	if w.concurrency <= 0 {
+		w.concurrency = DefaultConcurrency
+	}
+	if w.pollInterval <= 0 {
+		w.pollInterval = DefaultPollInterval
+	}
+	if w.lockTimeout <= 0 {
+		w.lockTimeout = DefaultLockTimeout
+	}
+
+	return w
+}
+
+// workerID identifies the worker in the locked_by column.
+func workerID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "worker"
+	}
+
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), util.RandomString(6))
+}
+
+// Handle registers the handler for the job name.
+func (w *Worker) Handle(name string, h HandlerFunc) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.handlers[name] = h
+}
+
+// On registers a handler which receives the payload decoded into T.
+// A payload which can't be decoded kills the job as retrying won't help.
+func On[T any](w *Worker, name string, h func(ctx context.Context, payload T) error) {
+	w.Handle(name, func(ctx context.Context, j model.Job) error {
+		var payload T
+		if len(j.Payload) > 0 {
+			if err := json.Unmarshal(j.Payload, &payload); err != nil {
+				return Permanent(fmt.Errorf("invalid payload: %w", err))
+			}
+		}
+
+		return h(ctx, payload)
+	})
+}
+
+// HandleDead registers the handler run when a job of the name is dead,
+// it lets the owner of the job leave the state waiting for the job.
+func (w *Worker) HandleDead(name string, h DeadFunc) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.deadHandlers[name] = h
+}
+
+// OnDead registers a dead handler which receives the payload decoded into T.
+func OnDead[T any](w *Worker, name string, h func(ctx context.Context, payload T, lastErr string) error) {
+	w.HandleDead(name, func(ctx context.Context, j model.Job, lastErr string) error {
+		var payload T
+		if len(j.Payload) > 0 {
+			if err := json.Unmarshal(j.Payload, &payload); err != nil {
+				return fmt.Errorf("invalid payload: %w", err)
+			}
+		}
+
+		return h(ctx, payload, lastErr)
+	})
+}
+
+// names returns the names of the registered handlers.
+func (w *Worker) names() []string {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	rs := make([]string, 0, len(w.handlers))
+	for name := range w.handlers {
+		rs = append(rs, name)
+	}
+	sort.Strings(rs)
+
+	return rs
+}
+
+func (w *Worker) handler(name string) (HandlerFunc, bool) {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	h, ok := w.handlers[name]
+	return h, ok
+}
+
+func (w *Worker) deadHandler(name string) (DeadFunc, bool) {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	h, ok := w.deadHandlers[name]
+	return h, ok
+}
+
+// Run processes the jobs until the context is done, then waits for the
+// running jobs to finish. The jobs run with their own context so that a
+// shutdown doesn't abort them halfway.
+func (w *Worker) Run(ctx context.Context) {
+	w.log.Infof("job worker %s started with %d goroutines", w.id, w.concurrency)
+
+	var wg sync.WaitGroup
+	for i := 0; i < w.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.poll(ctx)
+		}()
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		w.releaseStale(ctx)
+	}()
+
+	wg.Wait()
+	w.log.Infof("job worker %s stopped", w.id)
+}
+
+// poll runs the due jobs one after the other and sleeps when there is none.
+func (w *Worker) poll(ctx context.Context) {
+	for {
+		ran, err := w.runNext(context.Background())
+		if err != nil {
+			w.log.Error(err, "failed to run job")
+		}
+		if ran {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.pollInterval):
+		}
+	}
+}
+
+// releaseStale puts back the jobs of dead workers periodically.
+func (w *Worker) releaseStale(ctx context.Context) {
+	ticker := time.NewTicker(w.lockTimeout / 2)
+	defer ticker.Stop()
+
+	for {
+		n, err := w.repo.ReleaseStale(db.FromContext(ctx), w.now().Add(-w.lockTimeout))
+		if err != nil && ctx.Err() == nil {
+			w.log.Error(err, "failed to release stale jobs")
+		}
+		if n > 0 {
+			w.log.Infof("released %d stale jobs", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runNext claims the next due job and runs it, it reports whether a job was found.
+func (w *Worker) runNext(ctx context.Context) (bool, error) {
+	names := w.names()
+	if len(names) == 0 {
+		return false, nil
+	}
+
+	j, err := w.repo.Claim(db.FromContext(ctx), w.id, names, w.now())
+	if err != nil {
+		return false, err
+	}
+	if j == nil {
+		return false, nil
+	}
+
+	return true, w.process(ctx, *j)
+}
+
+// process runs the job and records the outcome: succeeded, retried later with
+// an exponential backoff, or dead once it ran out of attempts.
+func (w *Worker) process(ctx context.Context, j model.Job) error {
+	dbCtx := db.FromContext(ctx)
+
+	// a job released by ReleaseStale may already have used all its attempts
+	if j.Attempts > j.MaxAttempts {
+		return w.fail(ctx, j, "ran out of attempts")
+	}
+
+	h, ok := w.handler(j.Name)
+	if !ok {
+		return w.fail(ctx, j, "no handler registered for "+j.Name)
+	}
+
+	jobCtx, stop := w.heartbeat(ctx, j)
+	err := w.call(jobCtx, h, j)
+	stop()
+	if err == nil {
+		return w.repo.Complete(dbCtx, j.ID, w.id, w.now())
+	}
+
+	w.log.Errorf(err, "job %d (%s) failed on attempt %d/%d", j.ID, j.Name, j.Attempts, j.MaxAttempts)
+	if isPermanent(err) || j.Attempts >= j.MaxAttempts {
+		return w.fail(ctx, j, err.Error())
+	}
+
+	now := w.now()
+	return w.repo.Retry(dbCtx, j.ID, w.id, now, now.Add(backoff(j.Attempts)), err.Error())
+}
+
+// heartbeat extends the lock of the job every third of the lock timeout while
+// it runs, a long job isn't released by ReleaseStale and run by another worker.
+// The context of the job is cancelled once its lock is lost.
+func (w *Worker) heartbeat(ctx context.Context, j model.Job) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(w.lockTimeout / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			err := w.repo.Heartbeat(db.FromContext(ctx), j.ID, w.id, w.now())
+			if errors.Is(err, model.ErrJobLockLost) {
+				w.log.Errorf(err, "job %d (%s) lost its lock while running", j.ID, j.Name)
+				cancel()
+				return
+			}
+			if err != nil {
+				w.log.Errorf(err, "failed to extend the lock of job %d (%s)", j.ID, j.Name)
+			}
+		}
+	}()
+
+	return ctx, func() {
+		close(done)
+		<-stopped
+		cancel()
+	}
+}
+
+// fail marks the job dead and runs its dead handler.
+func (w *Worker) fail(ctx context.Context, j model.Job, lastErr string) error {
+	if err := w.repo.Fail(db.FromContext(ctx), j.ID, w.id, w.now(), lastErr); err != nil {
+		return err
+	}
+
+	h, ok := w.deadHandler(j.Name)
+	if !ok {
+		return nil
+	}
+
+	return w.call(ctx, func(ctx context.Context, j model.Job) error {
+		return h(ctx, j, lastErr)
+	}, j)
+}
+
+// call runs the handler and turns a panic into an error.
+func (w *Worker) call(ctx context.Context, h HandlerFunc, j model.Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return h(ctx, j)
+}
+
+// backoff returns the wait before the next attempt, it doubles on every attempt.
+func backoff(attempt int) time.Duration {
+	d := baseBackoff
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if d >= maxBackoff {
+			return maxBackoff
+		}
+	}
+
+	return d
+}
@@ -0,0 +1,262 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mocks "github.com/dwarvesf/go-api/mocks/pkg/repository/job"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_backoff(t *testing.T) {
+	tests := map[string]struct {
+		attempt int
+		want    time.Duration
+	}{
+		"first attempt":  {attempt: 1, want: 10 * time.Second},
+		"second attempt": {attempt: 2, want: 20 * time.Second},
+		"fifth attempt":  {attempt: 5, want: 160 * time.Second},
+		"capped":         {attempt: 20, want: time.Hour},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, backoff(tt.attempt))
+		})
+	}
+}
+
+func TestWorker_process(t *testing.T) {
+	now := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	type payload struct {
+		Email string `json:"email"`
+	}
+	type mocked struct {
+		expComplete bool
+		expRetryAt  time.Time
+		expFail     string
+	}
+	tests := map[string]struct {
+		job      model.Job
+		handler  func(ctx context.Context, p payload) error
+		mocked   mocked
+		wantDead string
+		wantErr  bool
+	}{
+		"succeeded": {
+			job: model.Job{ID: 1, Name: "send", Payload: []byte(`{"email":"admin@d.foundation"}`), Attempts: 1, MaxAttempts: 3},
+			handler: func(ctx context.Context, p payload) error {
+				if p.Email != "admin@d.foundation" {
+					return errors.New("unexpected payload")
+				}
+				return nil
+			},
+			mocked: mocked{expComplete: true},
+		},
+		"retried with backoff": {
+			job: model.Job{ID: 1, Name: "send", Attempts: 2, MaxAttempts: 3},
+			handler: func(ctx context.Context, p payload) error {
+				return errors.New("smtp unavailable")
+			},
+			mocked: mocked{expRetryAt: now.Add(20 * time.Second)},
+		},
+		"dead after the last attempt": {
+			job: model.Job{ID: 1, Name: "send", Attempts: 3, MaxAttempts: 3},
+			handler: func(ctx context.Context, p payload) error {
+				return errors.New("smtp unavailable")
+			},
+			mocked:   mocked{expFail: "smtp unavailable"},
+			wantDead: "smtp unavailable",
+		},
+		"dead on permanent error": {
+			job: model.Job{ID: 1, Name: "send", Attempts: 1, MaxAttempts: 3},
+			handler: func(ctx context.Context, p payload) error {
+				return Permanent(errors.New("invalid email"))
+			},
+			mocked:   mocked{expFail: "invalid email"},
+			wantDead: "invalid email",
+		},
+		"dead on invalid payload": {
+			job: model.Job{ID: 1, Name: "send", Payload: []byte(`{"email":1}`), Attempts: 1, MaxAttempts: 3},
+			handler: func(ctx context.Context, p payload) error {
+				return nil
+			},
+			mocked: mocked{expFail: "invalid payload: json: cannot unmarshal number into Go struct field payload.email of type string"},
+			// the dead handler can't decode the payload either
+			wantErr: true,
+		},
+		"retried on panic": {
+			job: model.Job{ID: 1, Name: "send", Attempts: 1, MaxAttempts: 3},
+			handler: func(ctx context.Context, p payload) error {
+				panic("boom")
+			},
+			mocked: mocked{expRetryAt: now.Add(10 * time.Second)},
+		},
+		"released after the last attempt": {
+			job: model.Job{ID: 1, Name: "send", Attempts: 4, MaxAttempts: 3},
+			handler: func(ctx context.Context, p payload) error {
+				return errors.New("should not run")
+			},
+			mocked:   mocked{expFail: "ran out of attempts"},
+			wantDead: "ran out of attempts",
+		},
+		"no handler": {
+			job:    model.Job{ID: 1, Name: "unknown", Attempts: 1, MaxAttempts: 3},
+			mocked: mocked{expFail: "no handler registered for unknown"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			repoMock := mocks.NewRepo(t)
+			if tt.mocked.expComplete {
+				repoMock.EXPECT().Complete(mock.Anything, tt.job.ID, "worker-1", now).Return(nil)
+			}
+			if !tt.mocked.expRetryAt.IsZero() {
+				repoMock.EXPECT().Retry(mock.Anything, tt.job.ID, "worker-1", now, tt.mocked.expRetryAt, mock.Anything).Return(nil)
+			}
+			if tt.mocked.expFail != "" {
+				repoMock.EXPECT().Fail(mock.Anything, tt.job.ID, "worker-1", now, tt.mocked.expFail).Return(nil)
+			}
+
+			cfg := config.LoadTestConfig()
+			_, err := db.Init(cfg)
+			require.NoError(t, err)
+
+			w := NewWorker(cfg, repoMock, logger.NewLogger())
+			w.id = "worker-1"
+			w.now = func() time.Time { return now }
+			if tt.handler != nil {
+				On(w, "send", tt.handler)
+			}
+			var dead string
+			OnDead(w, "send", func(ctx context.Context, p payload, lastErr string) error {
+				dead = lastErr
+				return nil
+			})
+
+			err = w.process(context.Background(), tt.job)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantDead, dead)
+		})
+	}
+}
+
+func TestWorker_runNext(t *testing.T) {
+	now := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		claimed  *model.Job
+		claimErr error
+		want     bool
+		wantErr  bool
+	}{
+		"job claimed": {
+			claimed: &model.Job{ID: 1, Name: "a", Attempts: 1, MaxAttempts: 3},
+			want:    true,
+		},
+		"queue empty": {
+			want: false,
+		},
+		"claim failed": {
+			claimErr: errors.New("connection refused"),
+			wantErr:  true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			repoMock := mocks.NewRepo(t)
+			repoMock.EXPECT().Claim(mock.Anything, "worker-1", []string{"a", "b"}, now).Return(tt.claimed, tt.claimErr)
+			if tt.claimed != nil {
+				repoMock.EXPECT().Complete(mock.Anything, tt.claimed.ID, "worker-1", now).Return(nil)
+			}
+
+			cfg := config.LoadTestConfig()
+			_, err := db.Init(cfg)
+			require.NoError(t, err)
+
+			w := NewWorker(cfg, repoMock, logger.NewLogger())
+			w.id = "worker-1"
+			w.now = func() time.Time { return now }
+			w.Handle("b", func(ctx context.Context, j model.Job) error { return nil })
+			w.Handle("a", func(ctx context.Context, j model.Job) error { return nil })
+
+			got, err := w.runNext(context.Background())
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWorker_heartbeat(t *testing.T) {
+	now := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		heartbeatErr error
+		handler      func(ctx context.Context, beats <-chan struct{}) error
+		wantErr      error
+	}{
+		"lock extended while the job runs": {
+			handler: func(ctx context.Context, beats <-chan struct{}) error {
+				<-beats
+				<-beats
+				return ctx.Err()
+			},
+		},
+		"job cancelled once the lock is lost": {
+			heartbeatErr: model.ErrJobLockLost,
+			handler: func(ctx context.Context, beats <-chan struct{}) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+			wantErr: model.ErrJobLockLost,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			job := model.Job{ID: 1, Name: "send", Attempts: 1, MaxAttempts: 3}
+			beats := make(chan struct{}, 10)
+			repoMock := mocks.NewRepo(t)
+			repoMock.EXPECT().Heartbeat(mock.Anything, job.ID, "worker-1", now).
+				RunAndReturn(func(db.Context, int64, string, time.Time) error {
+					beats <- struct{}{}
+					return tt.heartbeatErr
+				})
+			if tt.wantErr == nil {
+				repoMock.EXPECT().Complete(mock.Anything, job.ID, "worker-1", now).Return(nil)
+			} else {
+				repoMock.EXPECT().Retry(mock.Anything, job.ID, "worker-1", now, mock.Anything, context.Canceled.Error()).Return(tt.wantErr)
+			}
+
+			cfg := config.LoadTestConfig()
+			_, err := db.Init(cfg)
+			require.NoError(t, err)
+
+			w := NewWorker(cfg, repoMock, logger.NewLogger())
+			w.id = "worker-1"
+			w.now = func() time.Time { return now }
+			w.lockTimeout = 30 * time.Millisecond
+			w.Handle("send", func(ctx context.Context, j model.Job) error {
+				return tt.handler(ctx, beats)
+			})
+
+			err = w.process(context.Background(), job)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
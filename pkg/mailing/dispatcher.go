@@ -0,0 +1,160 @@
+package mailing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/service/mailer"
+	"github.com/dwarvesf/go-api/pkg/util"
+)
+
+const (
+	// DefaultOutboxPollInterval is the default wait before looking for mails again when the outbox is empty
+	DefaultOutboxPollInterval = time.Second
+
+	// outboxLockTimeout is the time after which a mail claimed by a dead dispatcher is sent again
+	outboxLockTimeout = 5 * time.Minute
+
+	// outboxBaseBackoff and outboxMaxBackoff bound the wait before retrying a failed mail
+	outboxBaseBackoff = 30 * time.Second
+	outboxMaxBackoff  = time.Hour
+)
+
+// errUndeliverable is recorded on the mails to an address reported as bounced or complaining
+const errUndeliverable = "address is undeliverable"
+
+// Dispatcher sends the mails of the outbox through the mailer
+type Dispatcher struct {
+	repo         *repository.Repo
+	mailer       mailer.Mailer
+	id           string
+	pollInterval time.Duration
+	log          logger.Log
+	now          func() time.Time
+}
+
+// NewDispatcher creates a dispatcher configured by the MAIL_OUTBOX_* settings.
+func NewDispatcher(cfg config.Config, repo *repository.Repo, m mailer.Mailer, l logger.Log) *Dispatcher {
+	d := &Dispatcher{
+		repo:         repo,
+		mailer:       m,
+		id:           dispatcherID(),
+		pollInterval: time.Duration(cfg.MailOutboxPollInterval) * time.Millisecond,
+		log:          l,
+		now:          time.Now,
+	}
+	if d.pollInterval <= 0 {
+		d.pollInterval = DefaultOutboxPollInterval
+	}
+
+	return d
+}
+
+// dispatcherID identifies the dispatcher in the locked_by column.
+func dispatcherID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "dispatcher"
+	}
+
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), util.RandomString(6))
+}
+
+// Run sends the due mails until the context is done. The mails are sent with
+// their own context so that a shutdown doesn't abort one halfway.
+func (d *Dispatcher) Run(ctx context.Context) {
+	d.log.Info("mail dispatcher started")
+	defer d.log.Info("mail dispatcher stopped")
+
+	for {
+		sent, err := d.dispatchNext(context.Background())
+		if err != nil {
+			d.log.Error(err, "failed to dispatch mail")
+		}
+		if sent {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.pollInterval):
+		}
+	}
+}
+
+// dispatchNext claims the next due mail and sends it, it reports whether a mail was found.
+func (d *Dispatcher) dispatchNext(ctx context.Context) (bool, error) {
+	dbCtx := db.FromContext(ctx)
+	now := d.now()
+	m, err := d.repo.MailOutbox.Claim(dbCtx, d.id, now, now.Add(-outboxLockTimeout))
+	if err != nil {
+		return false, err
+	}
+	if m == nil {
+		return false, nil
+	}
+
+	return true, d.dispatch(ctx, *m)
+}
+
+// dispatch sends the mail and records the outcome: sent, retried later with
+// an exponential backoff, or failed once it ran out of attempts.
+func (d *Dispatcher) dispatch(ctx context.Context, m model.MailOutboxMessage) error {
+	dbCtx := db.FromContext(ctx)
+
+	// a mail released after a lock timeout may already have used all its attempts
+	if m.Attempts > m.MaxAttempts {
+		return d.repo.MailOutbox.Fail(dbCtx, m.ID, d.id, d.now(), "ran out of attempts")
+	}
+
+	suppressed, err := d.repo.MailSuppression.IsSuppressed(dbCtx, m.To)
+	if err != nil {
+		return err
+	}
+	if suppressed {
+		return d.repo.MailOutbox.Fail(dbCtx, m.ID, d.id, d.now(), errUndeliverable)
+	}
+
+	err = d.mailer.Send(ctx, mailer.Message{
+		To:      []string{m.To},
+		Subject: m.Subject,
+		HTML:    m.HTML,
+		Text:    m.Text,
+		Headers: map[string]string{"Message-ID": m.MessageID},
+	})
+	if err == nil {
+		return d.repo.MailOutbox.MarkSent(dbCtx, m.ID, d.id, d.now())
+	}
+
+	d.log.Errorf(err, "mail %d (%s) failed on attempt %d/%d", m.ID, m.Template, m.Attempts, m.MaxAttempts)
+	if m.Attempts >= m.MaxAttempts {
+		return d.repo.MailOutbox.Fail(dbCtx, m.ID, d.id, d.now(), err.Error())
+	}
+
+	now := d.now()
+	return d.repo.MailOutbox.Retry(dbCtx, m.ID, d.id, now, now.Add(outboxBackoff(m.Attempts)), err.Error())
+}
+
+// outboxBackoff returns the wait before the next attempt, it doubles on every attempt.
+func outboxBackoff(attempt int) time.Duration {
+	d := outboxBaseBackoff
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if d >= outboxMaxBackoff {
+			return outboxMaxBackoff
+		}
+	}
+
+	return d
+}
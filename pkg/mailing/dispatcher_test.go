@@ -0,0 +1,136 @@
+package mailing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	outboxmocks "github.com/dwarvesf/go-api/mocks/pkg/repository/mailoutbox"
+	suppressionmocks "github.com/dwarvesf/go-api/mocks/pkg/repository/mailsuppression"
+	mailermocks "github.com/dwarvesf/go-api/mocks/pkg/service/mailer"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/service/mailer"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatcher_dispatch(t *testing.T) {
+	now := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	msg := model.MailOutboxMessage{
+		ID:          1,
+		Template:    "welcome",
+		To:          "admin@d.foundation",
+		Subject:     "Welcome admin",
+		Text:        "Hi admin",
+		MessageID:   "<1.abc@d.foundation>",
+		Attempts:    1,
+		MaxAttempts: 3,
+	}
+
+	type mocked struct {
+		suppressed bool
+		expSend    bool
+		sendErr    error
+		expSent    bool
+		markErr    error
+		expRetryAt time.Time
+		expFailErr string
+	}
+	tests := map[string]struct {
+		attempts int
+		mocked   mocked
+		wantErr  error
+	}{
+		"sent": {
+			attempts: 1,
+			mocked: mocked{
+				expSend: true,
+				expSent: true,
+			},
+		},
+		"lock lost to another dispatcher": {
+			attempts: 1,
+			mocked: mocked{
+				expSend: true,
+				expSent: true,
+				markErr: model.ErrMailLockLost,
+			},
+			wantErr: model.ErrMailLockLost,
+		},
+		"retried with a backoff": {
+			attempts: 2,
+			mocked: mocked{
+				expSend:    true,
+				sendErr:    errors.New("connection refused"),
+				expRetryAt: now.Add(time.Minute),
+			},
+		},
+		"failed on the last attempt": {
+			attempts: 3,
+			mocked: mocked{
+				expSend:    true,
+				sendErr:    errors.New("connection refused"),
+				expFailErr: "connection refused",
+			},
+		},
+		"undeliverable address": {
+			attempts: 1,
+			mocked: mocked{
+				suppressed: true,
+				expFailErr: errUndeliverable,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			outboxMock := outboxmocks.NewRepo(t)
+			suppressionMock := suppressionmocks.NewRepo(t)
+			mailerMock := mailermocks.NewMailer(t)
+
+			m := msg
+			m.Attempts = tt.attempts
+			suppressionMock.EXPECT().IsSuppressed(mock.Anything, m.To).Return(tt.mocked.suppressed, nil)
+			if tt.mocked.expSend {
+				mailerMock.EXPECT().Send(mock.Anything, mock.MatchedBy(func(got mailer.Message) bool {
+					return got.To[0] == m.To && got.Subject == m.Subject && got.Headers["Message-ID"] == m.MessageID
+				})).Return(tt.mocked.sendErr)
+			}
+			if tt.mocked.expSent {
+				outboxMock.EXPECT().MarkSent(mock.Anything, m.ID, "dispatcher-1", now).Return(tt.mocked.markErr)
+			}
+			if !tt.mocked.expRetryAt.IsZero() {
+				outboxMock.EXPECT().Retry(mock.Anything, m.ID, "dispatcher-1", now, tt.mocked.expRetryAt, "connection refused").Return(nil)
+			}
+			if tt.mocked.expFailErr != "" {
+				outboxMock.EXPECT().Fail(mock.Anything, m.ID, "dispatcher-1", now, tt.mocked.expFailErr).Return(nil)
+			}
+
+			d := &Dispatcher{
+				repo: &repository.Repo{
+					MailOutbox:      outboxMock,
+					MailSuppression: suppressionMock,
+				},
+				mailer: mailerMock,
+				id:     "dispatcher-1",
+				log:    logger.NewLogger(),
+				now:    func() time.Time { return now },
+			}
+			_, err := db.Init(config.LoadTestConfig())
+			require.NoError(t, err)
+
+			err = d.dispatch(context.Background(), m)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_outboxBackoff(t *testing.T) {
+	require.Equal(t, 30*time.Second, outboxBackoff(1))
+	require.Equal(t, time.Minute, outboxBackoff(2))
+	require.Equal(t, time.Hour, outboxBackoff(20))
+}
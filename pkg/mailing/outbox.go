@@ -0,0 +1,59 @@
+package mailing
+
+import (
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/service/mailer"
+)
+
+// defaultOutboxMaxAttempts is the default number of times a mail of the outbox is sent before it fails
+const defaultOutboxMaxAttempts = 5
+
+// Outbox writes the transactional mails to the outbox, they're sent by the
+// dispatcher only once the transaction of the context is committed
+type Outbox interface {
+	Enqueue(ctx db.Context, to string, template string, data any) (*model.MailOutboxMessage, error)
+}
+
+type outbox struct {
+	cfg         config.Config
+	repo        *repository.Repo
+	templates   mailer.Renderer
+	maxAttempts int
+}
+
+// NewOutbox creates an outbox which renders the mails from the templates.
+func NewOutbox(cfg config.Config, repo *repository.Repo, templates mailer.Renderer) Outbox {
+	maxAttempts := cfg.MailOutboxMaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultOutboxMaxAttempts
+	}
+
+	return &outbox{
+		cfg:         cfg,
+		repo:        repo,
+		templates:   templates,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Enqueue renders the mail and writes it in the transaction of the context,
+// a template error fails the caller before anything is committed.
+func (o *outbox) Enqueue(ctx db.Context, to string, template string, data any) (*model.MailOutboxMessage, error) {
+	msg, err := o.templates.Render(template, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.repo.MailOutbox.Create(ctx, model.MailOutboxMessage{
+		Template:    template,
+		To:          to,
+		Subject:     msg.Subject,
+		HTML:        msg.HTML,
+		Text:        msg.Text,
+		MessageID:   mailer.MessageID(o.cfg.MailFrom),
+		MaxAttempts: o.maxAttempts,
+	})
+}
@@ -0,0 +1,37 @@
+package mailing
+
+import (
+	"testing"
+
+	outboxmocks "github.com/dwarvesf/go-api/mocks/pkg/repository/mailoutbox"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/service/mailer"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_outbox_Enqueue(t *testing.T) {
+	cfg := config.LoadTestConfig()
+	cfg.MailFrom = "no-reply@d.foundation"
+
+	outboxMock := outboxmocks.NewRepo(t)
+	outboxMock.EXPECT().Create(mock.Anything, mock.MatchedBy(func(m model.MailOutboxMessage) bool {
+		return m.To == "admin@d.foundation" &&
+			m.Template == "welcome" &&
+			m.Subject == "Welcome admin" &&
+			m.MaxAttempts == defaultOutboxMaxAttempts &&
+			m.MessageID != ""
+	})).Return(&model.MailOutboxMessage{ID: 1}, nil)
+
+	o := NewOutbox(cfg, &repository.Repo{MailOutbox: outboxMock}, mailer.DefaultRenderer())
+	got, err := o.Enqueue(db.Context{}, "admin@d.foundation", "welcome", map[string]string{"Name": "admin"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), got.ID)
+
+	// an unknown template fails before anything is written
+	_, err = o.Enqueue(db.Context{}, "admin@d.foundation", "unknown", nil)
+	require.ErrorIs(t, err, mailer.ErrTemplateNotFound)
+}
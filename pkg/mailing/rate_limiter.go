@@ -0,0 +1,41 @@
+package mailing
+
+import (
+	"context"
+	"time"
+)
+
+// rateLimiter spaces the mails evenly so the provider limit isn't exceeded
+type rateLimiter struct {
+	ticker *time.Ticker
+}
+
+// newRateLimiter creates a limiter allowing perSecond calls per second,
+// it doesn't limit anything when perSecond isn't positive.
+func newRateLimiter(perSecond int) *rateLimiter {
+	if perSecond <= 0 {
+		return &rateLimiter{}
+	}
+
+	return &rateLimiter{ticker: time.NewTicker(time.Second / time.Duration(perSecond))}
+}
+
+// wait blocks until the next call is allowed or the context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l.ticker == nil {
+		return ctx.Err()
+	}
+
+	select {
+	case <-l.ticker.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *rateLimiter) stop() {
+	if l.ticker != nil {
+		l.ticker.Stop()
+	}
+}
@@ -0,0 +1,39 @@
+package mailing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_rateLimiter_wait(t *testing.T) {
+	t.Run("limited", func(t *testing.T) {
+		l := newRateLimiter(100)
+		defer l.stop()
+
+		start := time.Now()
+		for i := 0; i < 5; i++ {
+			require.NoError(t, l.wait(context.Background()))
+		}
+		assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+	})
+
+	t.Run("unlimited", func(t *testing.T) {
+		l := newRateLimiter(0)
+		defer l.stop()
+
+		require.NoError(t, l.wait(context.Background()))
+	})
+
+	t.Run("context done", func(t *testing.T) {
+		l := newRateLimiter(1)
+		defer l.stop()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, l.wait(ctx), context.Canceled)
+	})
+}
@@ -0,0 +1,230 @@
+package mailing
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/service/mailer"
+)
+
+const defaultBatchSize = 100
+
+// Delivery represent a mail sent to every user of an audience,
+// the progress is saved under the name of the campaign
+type Delivery struct {
+	Campaign string
+	Template string
+	Audience model.AudienceFilter
+
+	// Unsubscribe adds the unsubscribe link to the mails
+	Unsubscribe bool
+}
+
+// Sender sends the mails of a delivery
+type Sender interface {
+	Send(ctx context.Context, d Delivery) (*model.SentMailReport, error)
+	Render(d Delivery, u model.User) (*mailer.Message, error)
+}
+
+type sender struct {
+	cfg       config.Config
+	repo      *repository.Repo
+	mailer    mailer.Mailer
+	templates mailer.Renderer
+}
+
+// NewSender creates a sender which sends the mails through the mailer.
+func NewSender(cfg config.Config, repo *repository.Repo, m mailer.Mailer, templates mailer.Renderer) Sender {
+	return &sender{
+		cfg:       cfg,
+		repo:      repo,
+		mailer:    m,
+		templates: templates,
+	}
+}
+
+// Send sends the mail to every user of the audience in order of ID.
+// The progress is saved after every batch so a failed run resumes where it stopped,
+// and the users the campaign was already sent to are skipped.
+func (s *sender) Send(ctx context.Context, d Delivery) (*model.SentMailReport, error) {
+	dbCtx := db.FromContext(ctx)
+	cp, err := s.repo.MailDelivery.GetCheckpoint(dbCtx, d.Campaign)
+	if err != nil {
+		return nil, err
+	}
+	if cp.FinishedAt != nil {
+		return toReport(*cp), nil
+	}
+
+	batchSize := s.cfg.MailBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	limiter := newRateLimiter(s.cfg.MailRateLimit)
+	defer limiter.stop()
+
+	for {
+		users, err := s.repo.User.GetListAfter(dbCtx, d.Audience, cp.LastUserID, batchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(users) == 0 {
+			break
+		}
+
+		if err := s.sendBatch(ctx, d, cp, users, limiter); err != nil {
+			return nil, err
+		}
+
+		cp.LastUserID = users[len(users)-1].ID
+		if err := s.repo.MailDelivery.SaveCheckpoint(dbCtx, *cp); err != nil {
+			return nil, err
+		}
+	}
+
+	finishedAt := time.Now()
+	cp.FinishedAt = &finishedAt
+	if err := s.repo.MailDelivery.SaveCheckpoint(dbCtx, *cp); err != nil {
+		return nil, err
+	}
+
+	return toReport(*cp), nil
+}
+
+// Render renders the mail of the delivery sent to the user.
+func (s *sender) Render(d Delivery, u model.User) (*mailer.Message, error) {
+	data := map[string]string{
+		"Name":  u.FullName,
+		"Email": u.Email,
+	}
+	var unsubscribeURL string
+	if d.Unsubscribe {
+		unsubscribeURL = UnsubscribeURL(s.cfg, u.ID)
+		data["UnsubscribeURL"] = unsubscribeURL
+	}
+
+	msg, err := s.templates.Render(d.Template, data)
+	if err != nil {
+		return nil, err
+	}
+	msg.To = []string{u.Email}
+	if unsubscribeURL != "" {
+		msg.Headers = map[string]string{"List-Unsubscribe": "<" + unsubscribeURL + ">"}
+	}
+
+	return msg, nil
+}
+
+// sendBatch sends the mail to the users of the batch the campaign wasn't sent to yet
+// and updates the counters of the checkpoint. A failed mail is recorded and counted,
+// only the errors of the repository stop the campaign.
+func (s *sender) sendBatch(ctx context.Context, d Delivery, cp *model.MailCheckpoint, users []model.User, limiter *rateLimiter) error {
+	dbCtx := db.FromContext(ctx)
+
+	userIDs := make([]int, 0, len(users))
+	for _, u := range users {
+		userIDs = append(userIDs, u.ID)
+	}
+	sentIDs, err := s.repo.MailDelivery.GetSentUserIDs(dbCtx, cp.Campaign, userIDs)
+	if err != nil {
+		return err
+	}
+	sent := make(map[int]bool, len(sentIDs))
+	for _, id := range sentIDs {
+		sent[id] = true
+	}
+
+	concurrency := s.cfg.MailConcurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mutex    sync.Mutex
+		firstErr error
+		waitErr  error
+		sem      = make(chan struct{}, concurrency)
+	)
+	for _, u := range users {
+		if sent[u.ID] {
+			cp.Skipped++
+			continue
+		}
+		if waitErr = limiter.wait(ctx); waitErr != nil {
+			break
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(u model.User) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			rs := model.MailDelivery{
+				Campaign: cp.Campaign,
+				UserID:   u.ID,
+				Email:    u.Email,
+				Status:   model.MailDeliveryStatusSent,
+			}
+			if err := s.send(ctx, d, u); err != nil {
+				rs.Status = model.MailDeliveryStatusFailed
+				rs.Error = err.Error()
+			}
+			err := s.repo.MailDelivery.Record(dbCtx, rs)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			if rs.Status == model.MailDeliveryStatusSent {
+				cp.Sent++
+			} else {
+				cp.Failed++
+			}
+		}(u)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return waitErr
+}
+
+// send renders the mail of the user and sends it.
+func (s *sender) send(ctx context.Context, d Delivery, u model.User) error {
+	msg, err := s.Render(d, u)
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, *msg)
+}
+
+func toReport(cp model.MailCheckpoint) *model.SentMailReport {
+	rs := &model.SentMailReport{
+		Campaign:  cp.Campaign,
+		Sent:      cp.Sent,
+		Skipped:   cp.Skipped,
+		Failed:    cp.Failed,
+		StartedAt: cp.StartedAt,
+	}
+	if cp.FinishedAt != nil {
+		rs.FinishedAt = *cp.FinishedAt
+		rs.Duration = cp.FinishedAt.Sub(cp.StartedAt)
+	}
+
+	return rs
+}
@@ -0,0 +1,223 @@
+package mailing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	deliverymocks "github.com/dwarvesf/go-api/mocks/pkg/repository/maildelivery"
+	mocks "github.com/dwarvesf/go-api/mocks/pkg/repository/user"
+	mailermocks "github.com/dwarvesf/go-api/mocks/pkg/service/mailer"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/service/mailer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_sender_Send(t *testing.T) {
+	startedAt := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	finishedAt := startedAt.Add(time.Minute)
+	users := []model.User{
+		{ID: 2, Email: "admin2@d.foundation", FullName: "admin2"},
+		{ID: 3, Email: "admin3@d.foundation", FullName: "admin3"},
+	}
+
+	type mocked struct {
+		checkpoint    *model.MailCheckpoint
+		checkpointErr error
+		users         []model.User
+		getListErr    error
+		sentUserIDs   []int
+		sendTo        []string
+		sendErr       error
+		recordErr     error
+		saveCalls     int
+	}
+	tests := map[string]struct {
+		mocked  mocked
+		want    *model.SentMailReport
+		wantErr bool
+	}{
+		"resume from the checkpoint": {
+			mocked: mocked{
+				checkpoint:  &model.MailCheckpoint{Campaign: "welcome", LastUserID: 1, Sent: 1, StartedAt: startedAt},
+				users:       users,
+				sentUserIDs: []int{2},
+				sendTo:      []string{"admin3@d.foundation"},
+				saveCalls:   2,
+			},
+			want: &model.SentMailReport{Campaign: "welcome", Sent: 2, Skipped: 1, StartedAt: startedAt},
+		},
+		"failed mail is counted": {
+			mocked: mocked{
+				checkpoint: &model.MailCheckpoint{Campaign: "welcome", StartedAt: startedAt},
+				users:      users[1:],
+				sendTo:     []string{"admin3@d.foundation"},
+				sendErr:    errors.New("mailbox unavailable"),
+				saveCalls:  2,
+			},
+			want: &model.SentMailReport{Campaign: "welcome", Failed: 1, StartedAt: startedAt},
+		},
+		"finished campaign": {
+			mocked: mocked{
+				checkpoint: &model.MailCheckpoint{Campaign: "welcome", Sent: 3, StartedAt: startedAt, FinishedAt: &finishedAt},
+			},
+			want: &model.SentMailReport{Campaign: "welcome", Sent: 3, StartedAt: startedAt, FinishedAt: finishedAt, Duration: time.Minute},
+		},
+		"failed to get checkpoint": {
+			mocked: mocked{
+				checkpointErr: errors.New("failed to get checkpoint"),
+			},
+			wantErr: true,
+		},
+		"failed to get list": {
+			mocked: mocked{
+				checkpoint: &model.MailCheckpoint{Campaign: "welcome", StartedAt: startedAt},
+				getListErr: errors.New("failed to get list"),
+			},
+			wantErr: true,
+		},
+		"failed to record delivery": {
+			mocked: mocked{
+				checkpoint: &model.MailCheckpoint{Campaign: "welcome", StartedAt: startedAt},
+				users:      users[1:],
+				sendTo:     []string{"admin3@d.foundation"},
+				recordErr:  errors.New("failed to record"),
+			},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var (
+				userRepoMock     = mocks.NewRepo(t)
+				deliveryRepoMock = deliverymocks.NewRepo(t)
+				mailerMock       = mailermocks.NewMailer(t)
+			)
+
+			deliveryRepoMock.
+				EXPECT().
+				GetCheckpoint(mock.Anything, "welcome").
+				Return(tt.mocked.checkpoint, tt.mocked.checkpointErr)
+
+			if tt.mocked.users != nil || tt.mocked.getListErr != nil {
+				cp := tt.mocked.checkpoint
+				userRepoMock.
+					EXPECT().
+					GetListAfter(mock.Anything, model.AudienceFilter{Role: model.RoleUser}, cp.LastUserID, 100).
+					Return(tt.mocked.users, tt.mocked.getListErr)
+			}
+			if tt.mocked.users != nil {
+				last := tt.mocked.users[len(tt.mocked.users)-1].ID
+				userRepoMock.
+					EXPECT().
+					GetListAfter(mock.Anything, model.AudienceFilter{Role: model.RoleUser}, last, 100).
+					Return([]model.User{}, nil).
+					Maybe()
+
+				deliveryRepoMock.
+					EXPECT().
+					GetSentUserIDs(mock.Anything, "welcome", mock.Anything).
+					Return(tt.mocked.sentUserIDs, nil)
+			}
+			for _, to := range tt.mocked.sendTo {
+				to := to
+				mailerMock.
+					EXPECT().
+					Send(mock.Anything, mock.MatchedBy(func(msg mailer.Message) bool {
+						return msg.To[0] == to
+					})).
+					Return(tt.mocked.sendErr)
+				deliveryRepoMock.
+					EXPECT().
+					Record(mock.Anything, mock.MatchedBy(func(d model.MailDelivery) bool {
+						return d.Email == to && (d.Status == model.MailDeliveryStatusSent) == (tt.mocked.sendErr == nil)
+					})).
+					Return(tt.mocked.recordErr)
+			}
+			if tt.mocked.saveCalls > 0 {
+				deliveryRepoMock.
+					EXPECT().
+					SaveCheckpoint(mock.Anything, mock.Anything).
+					Return(nil).
+					Times(tt.mocked.saveCalls)
+			}
+
+			cfg := config.LoadTestConfig()
+			cfg.MailConcurrency = 2
+			s := &sender{
+				cfg: cfg,
+				repo: &repository.Repo{
+					User:         userRepoMock,
+					MailDelivery: deliveryRepoMock,
+				},
+				mailer:    mailerMock,
+				templates: mailer.DefaultRenderer(),
+			}
+
+			_, err := db.Init(s.cfg)
+			require.NoError(t, err)
+
+			got, err := s.Send(context.Background(), Delivery{
+				Campaign: "welcome",
+				Template: "sent_mail",
+				Audience: model.AudienceFilter{Role: model.RoleUser},
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("sender.Send() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if tt.want.FinishedAt.IsZero() {
+				// the campaign finished during the call
+				require.False(t, got.FinishedAt.IsZero())
+				got.FinishedAt = time.Time{}
+				got.Duration = 0
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_sender_Render(t *testing.T) {
+	cfg := config.LoadTestConfig()
+	cfg.BaseURL = "api.d.foundation"
+	cfg.SecretKey = "secret"
+	s := &sender{
+		cfg:       cfg,
+		templates: mailer.DefaultRenderer(),
+	}
+	u := model.User{ID: 1, Email: "admin@d.foundation", FullName: "admin"}
+
+	t.Run("without unsubscribe link", func(t *testing.T) {
+		got, err := s.Render(Delivery{Template: "sent_mail"}, u)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"admin@d.foundation"}, got.To)
+		assert.Equal(t, "Hello admin", got.Subject)
+		assert.NotContains(t, got.Text, "Unsubscribe")
+		assert.Empty(t, got.Headers)
+	})
+
+	t.Run("with unsubscribe link", func(t *testing.T) {
+		got, err := s.Render(Delivery{Template: "sent_mail", Unsubscribe: true}, u)
+		require.NoError(t, err)
+
+		link := UnsubscribeURL(cfg, 1)
+		assert.Contains(t, got.Text, "Unsubscribe: "+link)
+		assert.Contains(t, got.HTML, "Unsubscribe</a>")
+		assert.Equal(t, map[string]string{"List-Unsubscribe": "<" + link + ">"}, got.Headers)
+	})
+
+	t.Run("unknown template", func(t *testing.T) {
+		_, err := s.Render(Delivery{Template: "unknown"}, u)
+		assert.ErrorIs(t, err, mailer.ErrTemplateNotFound)
+	})
+}
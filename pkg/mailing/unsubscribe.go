@@ -0,0 +1,60 @@
+package mailing
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/model"
+)
+
+// UnsubscribePath is the path of the endpoint the unsubscribe links point to
+const UnsubscribePath = "/api/v1/portal/mail/unsubscribe"
+
+// UnsubscribeToken returns the token of the unsubscribe link of the user.
+// It's signed with the secret key but isn't a JWT so it can't be used as an access token.
+func UnsubscribeToken(secret string, userID int) string {
+	id := strconv.Itoa(userID)
+	return id + "." + signature(secret, id)
+}
+
+// ParseUnsubscribeToken returns the user the token was issued for.
+func ParseUnsubscribeToken(secret string, token string) (int, error) {
+	id, sig, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(signature(secret, id))) {
+		return 0, model.ErrInvalidToken
+	}
+
+	userID, err := strconv.Atoi(id)
+	if err != nil {
+		return 0, model.ErrInvalidToken
+	}
+
+	return userID, nil
+}
+
+// UnsubscribeURL returns the unsubscribe link of the user.
+func UnsubscribeURL(cfg config.Config, userID int) string {
+	scheme := "https"
+	if cfg.Env == "local" {
+		scheme = "http"
+	}
+
+	u := url.URL{
+		Scheme:   scheme,
+		Host:     cfg.BaseURL,
+		Path:     UnsubscribePath,
+		RawQuery: url.Values{"token": {UnsubscribeToken(cfg.SecretKey, userID)}}.Encode(),
+	}
+	return u.String()
+}
+
+func signature(secret string, id string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("unsubscribe:" + id))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
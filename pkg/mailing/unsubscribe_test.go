@@ -0,0 +1,60 @@
+package mailing
+
+import (
+	"testing"
+
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUnsubscribeToken(t *testing.T) {
+	token := UnsubscribeToken("secret", 42)
+
+	tests := map[string]struct {
+		secret  string
+		token   string
+		want    int
+		wantErr error
+	}{
+		"valid": {
+			secret: "secret",
+			token:  token,
+			want:   42,
+		},
+		"other secret": {
+			secret:  "other",
+			token:   token,
+			wantErr: model.ErrInvalidToken,
+		},
+		"other user": {
+			secret:  "secret",
+			token:   "43" + token[2:],
+			wantErr: model.ErrInvalidToken,
+		},
+		"malformed": {
+			secret:  "secret",
+			token:   "42",
+			wantErr: model.ErrInvalidToken,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseUnsubscribeToken(tt.secret, tt.token)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUnsubscribeURL(t *testing.T) {
+	cfg := config.Config{Env: "local", BaseURL: "localhost:8080", SecretKey: "secret"}
+
+	got := UnsubscribeURL(cfg, 42)
+	assert.Equal(t, "http://localhost:8080/api/v1/portal/mail/unsubscribe?token="+UnsubscribeToken("secret", 42), got)
+}
@@ -46,6 +46,20 @@ func UserIDFromContext(ctx context.Context) (int, error) {
 	return val, nil
 }
 
+// RoleFromContext get role from context
+func RoleFromContext(ctx context.Context) (model.Role, error) {
+	role := ctx.Value(RoleCtxKey)
+	if role == nil {
+		return "", model.ErrInvalidToken
+	}
+	val, ok := role.(string)
+	if !ok {
+		return "", model.ErrInvalidToken
+	}
+
+	return model.Role(val), nil
+}
+
 // UserIDFromJWTClaims get userID from context
 func UserIDFromJWTClaims(jwtClaims map[string]any) (int, error) {
 	userID := jwtClaims[subKey]
@@ -73,6 +87,26 @@ func (amw AuthMiddleware) WithAuth(c *gin.Context) {
 	c.Next()
 }
 
+// WithRole a middleware to check the role of the user, it must be used after WithAuth
+func WithRole(roles ...model.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, err := RoleFromContext(c.Request.Context())
+		if err != nil {
+			c.AbortWithStatusJSON(401, err)
+			return
+		}
+
+		for _, r := range roles {
+			if r == role {
+				c.Next()
+				return
+			}
+		}
+
+		c.AbortWithStatusJSON(403, model.ErrForbidden)
+	}
+}
+
 func populateContext(ctx context.Context, jwtClaims map[string]any) (context.Context, error) {
 	ID, ok := jwtClaims[subKey]
 	if !ok {
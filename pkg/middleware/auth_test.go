@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithRole(t *testing.T) {
+	tests := map[string]struct {
+		role     string
+		roles    []model.Role
+		expected int
+	}{
+		"allowed": {
+			role:     "admin",
+			roles:    []model.Role{model.RoleAdmin},
+			expected: http.StatusOK,
+		},
+		"forbidden": {
+			role:     "user",
+			roles:    []model.Role{model.RoleAdmin},
+			expected: http.StatusForbidden,
+		},
+		"unauthenticated": {
+			roles:    []model.Role{model.RoleAdmin},
+			expected: http.StatusUnauthorized,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ginCtx, _ := gin.CreateTestContext(w)
+			ginCtx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.role != "" {
+				ctx := context.WithValue(ginCtx.Request.Context(), RoleCtxKey, tt.role)
+				ginCtx.Request = ginCtx.Request.WithContext(ctx)
+			}
+
+			WithRole(tt.roles...)(ginCtx)
+			if !ginCtx.IsAborted() {
+				ginCtx.Status(http.StatusOK)
+				ginCtx.Writer.WriteHeaderNow()
+			}
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
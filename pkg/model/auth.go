@@ -2,8 +2,10 @@ package model
 
 // LoginRequest represent the login request
 type LoginRequest struct {
-	Email    string
-	Password string
+	Email     string
+	Password  string
+	IP        string
+	UserAgent string
 }
 
 // LoginResponse represent the login response
@@ -1,9 +1,14 @@
 package model
 
+import "net/http"
+
 // ListResult represent the list result
 type ListResult[T any] struct {
 	Data       []T
 	Pagination Pagination
+	// Highlights are the fields of each row of Data with the terms of the search highlighted,
+	// in search mode with highlighting only
+	Highlights []map[string]string
 }
 
 // ListQuery represent the list request
@@ -12,4 +17,74 @@ type ListQuery struct {
 	PageSize int
 	Sort     string
 	Query    string
+	// Filters are the conditions the rows must all match, on the filterable fields of the list
+	Filters []Filter
+
+	// CursorMode paginates with cursors instead of pages, a page starts after the row of Cursor
+	// so the rows inserted meanwhile don't shift the next pages
+	CursorMode bool
+	// Cursor is the NextCursor or PrevCursor of the previous page, empty for the first page
+	Cursor string
+	// SkipCount skips counting the total records, TotalRecords and TotalPages are left empty
+	SkipCount bool
+	// Search ranks the rows by their relevance to Query before the sort, when set
+	Search *SearchOptions
+}
+
+// SearchOptions represent the options of the search mode of a list
+type SearchOptions struct {
+	// Fuzzy also matches the rows similar to the query, so the misspelled queries find them
+	Fuzzy bool
+	// Highlight returns the fields of the rows with the matched terms in <b> tags
+	Highlight bool
 }
+
+// FilterOperator represent the comparison of a filter
+type FilterOperator string
+
+const (
+	// FilterOperatorEq matches the value
+	FilterOperatorEq FilterOperator = "eq"
+	// FilterOperatorNe matches anything but the value
+	FilterOperatorNe FilterOperator = "ne"
+	// FilterOperatorIn matches one of the comma separated values
+	FilterOperatorIn FilterOperator = "in"
+	// FilterOperatorGte matches the values greater than or equal to the value
+	FilterOperatorGte FilterOperator = "gte"
+	// FilterOperatorLte matches the values less than or equal to the value
+	FilterOperatorLte FilterOperator = "lte"
+	// FilterOperatorLike matches the texts containing the value, ignoring the case
+	FilterOperatorLike FilterOperator = "like"
+	// FilterOperatorIsNull matches the null values when the value is true, the others when false
+	FilterOperatorIsNull FilterOperator = "is_null"
+)
+
+// Filter represent a condition on a field of a list, such as filter[created_at][gte]=2026-10-19
+type Filter struct {
+	Field    string
+	Operator FilterOperator
+	Value    string
+}
+
+var (
+	// ErrInvalidFilter is the error for a filter on an unknown field, with an unknown operator or an invalid value
+	ErrInvalidFilter = Error{
+		Status:  http.StatusBadRequest,
+		Code:    "INVALID_FILTER",
+		Message: "invalid filter",
+	}
+
+	// ErrInvalidSearch is the error for a search on a list which doesn't support it
+	ErrInvalidSearch = Error{
+		Status:  http.StatusBadRequest,
+		Code:    "INVALID_SEARCH",
+		Message: "invalid search",
+	}
+
+	// ErrInvalidSort is the error for a sort on an unknown field
+	ErrInvalidSort = Error{
+		Status:  http.StatusBadRequest,
+		Code:    "INVALID_SORT",
+		Message: "invalid sort",
+	}
+)
@@ -0,0 +1,97 @@
+package model
+
+import (
+	"net/http"
+	"time"
+)
+
+// CampaignStatus represent the state of a campaign
+type CampaignStatus string
+
+const (
+	// CampaignStatusDraft is the status of a campaign being edited
+	CampaignStatusDraft CampaignStatus = "draft"
+	// CampaignStatusScheduled is the status of a campaign waiting for its send time
+	CampaignStatusScheduled CampaignStatus = "scheduled"
+	// CampaignStatusSending is the status of a campaign being sent
+	CampaignStatusSending CampaignStatus = "sending"
+	// CampaignStatusDone is the status of a campaign sent to its whole audience
+	CampaignStatusDone CampaignStatus = "done"
+	// CampaignStatusFailed is the status of a campaign whose send job died
+	CampaignStatusFailed CampaignStatus = "failed"
+)
+
+var (
+	// ErrCampaignNotDraft is the error for changing a campaign which isn't a draft
+	ErrCampaignNotDraft = Error{
+		Status:  http.StatusConflict,
+		Code:    "CAMPAIGN_NOT_DRAFT",
+		Message: "campaign isn't a draft",
+	}
+
+	// ErrCampaignNotScheduled is the error for unscheduling a campaign which isn't scheduled
+	ErrCampaignNotScheduled = Error{
+		Status:  http.StatusConflict,
+		Code:    "CAMPAIGN_NOT_SCHEDULED",
+		Message: "campaign isn't scheduled",
+	}
+
+	// ErrCampaignNotStarted is the error for sending a campaign whose send time hasn't come
+	ErrCampaignNotStarted = Error{
+		Status:  http.StatusConflict,
+		Code:    "CAMPAIGN_NOT_STARTED",
+		Message: "campaign hasn't started",
+	}
+
+	// ErrInvalidScheduleTime is the error for scheduling a campaign in the past
+	ErrInvalidScheduleTime = Error{
+		Status:  http.StatusBadRequest,
+		Code:    "INVALID_SCHEDULE_TIME",
+		Message: "scheduled time must be in the future",
+	}
+
+	// ErrTemplateNotFound is the error for a campaign using an unknown template
+	ErrTemplateNotFound = Error{
+		Status:  http.StatusBadRequest,
+		Code:    "TEMPLATE_NOT_FOUND",
+		Message: "template not found",
+	}
+)
+
+// AudienceFilter represent the users a mail is sent to, the zero value matches every user
+type AudienceFilter struct {
+	Role        Role
+	Status      Status
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Query       string
+
+	// Subscribed excludes the users who unsubscribed from the campaigns
+	Subscribed bool
+}
+
+// Campaign represent a mail sent to an audience at a scheduled time
+type Campaign struct {
+	ID          int
+	Name        string
+	Template    string
+	Audience    AudienceFilter
+	Status      CampaignStatus
+	ScheduledAt *time.Time
+	StartedAt   *time.Time
+	FinishedAt  *time.Time
+	CreatedAt   time.Time
+}
+
+// CampaignRequest represent the create and update campaign request
+type CampaignRequest struct {
+	Name     string
+	Template string
+	Audience AudienceFilter
+}
+
+// MailPreference represent the mails a user agreed to receive
+type MailPreference struct {
+	UserID    int
+	Campaigns bool
+}
@@ -23,6 +23,13 @@ var (
 		Message: "Unexpected authorization headers",
 	}
 
+	// ErrForbidden is the error for users without the required role
+	ErrForbidden = Error{
+		Status:  http.StatusForbidden,
+		Code:    "FORBIDDEN",
+		Message: "Forbidden",
+	}
+
 	// ErrInvalidCredentials is the error for invalid credentials
 	ErrInvalidCredentials = Error{
 		Status:  http.StatusBadRequest,
@@ -37,12 +44,40 @@ var (
 		Message: "not found",
 	}
 
+	// ErrServerShuttingDown is the error for requests received while the server is shutting down
+	ErrServerShuttingDown = Error{
+		Status:  http.StatusServiceUnavailable,
+		Code:    "SERVER_SHUTTING_DOWN",
+		Message: "server is shutting down",
+	}
+
 	// ErrEmailExisted is the error for email existed
 	ErrEmailExisted = Error{
 		Status:  http.StatusBadRequest,
 		Code:    "EMAIL_EXISTED",
 		Message: "email existed",
 	}
+
+	// ErrVersionConflict is the error for updating a stale version of a resource
+	ErrVersionConflict = Error{
+		Status:  http.StatusConflict,
+		Code:    "VERSION_CONFLICT",
+		Message: "the resource was changed by another request",
+	}
+
+	// ErrPreconditionRequired is the error for an update made without the If-Match header
+	ErrPreconditionRequired = Error{
+		Status:  http.StatusPreconditionRequired,
+		Code:    "PRECONDITION_REQUIRED",
+		Message: "If-Match header is required",
+	}
+
+	// ErrInvalidIfMatch is the error for an If-Match header which isn't an ETag of the API
+	ErrInvalidIfMatch = Error{
+		Status:  http.StatusBadRequest,
+		Code:    "INVALID_IF_MATCH",
+		Message: "invalid If-Match header",
+	}
 )
 
 // Error in server
@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// EventType represent the type of a domain event
+type EventType string
+
+const (
+	// EventProfileUpdated is published when the user updates the profile
+	EventProfileUpdated EventType = "user.profile_updated"
+	// EventPasswordChanged is published when the user changes the password
+	EventPasswordChanged EventType = "user.password_changed"
+	// EventNewLogin is published when the user logs in from a device never used before
+	EventNewLogin EventType = "auth.new_login"
+	// EventSessionRevoked is published when the sessions of the user are revoked,
+	// either the password changed or an admin disconnected the user
+	EventSessionRevoked EventType = "auth.session_revoked"
+)
+
+// Event represent a domain event which happened to a user
+type Event struct {
+	Type       EventType
+	UserID     int
+	OccurredAt time.Time
+	User       *User
+	Device     *Device
+}
+
+// Device represent the device a request was made from
+type Device struct {
+	IP        string
+	UserAgent string
+}
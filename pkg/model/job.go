@@ -0,0 +1,45 @@
+package model
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// JobStatus represent the status of a background job
+type JobStatus string
+
+const (
+	// JobStatusPending is the status of a job waiting to run
+	JobStatusPending JobStatus = "pending"
+	// JobStatusRunning is the status of a job claimed by a worker
+	JobStatusRunning JobStatus = "running"
+	// JobStatusSucceeded is the status of a job which ran successfully
+	JobStatusSucceeded JobStatus = "succeeded"
+	// JobStatusDead is the status of a job which ran out of attempts
+	JobStatusDead JobStatus = "dead"
+)
+
+// ErrJobLockLost is the error for recording the outcome of a job which isn't locked by the worker anymore
+var ErrJobLockLost = Error{
+	Status:  http.StatusConflict,
+	Code:    "JOB_LOCK_LOST",
+	Message: "the job isn't locked by the worker anymore",
+}
+
+// Job represent a background job
+type Job struct {
+	ID          int64
+	Name        string
+	Payload     json.RawMessage
+	Status      JobStatus
+	UniqueKey   string
+	Attempts    int
+	MaxAttempts int
+	RunAt       time.Time
+	LockedAt    *time.Time
+	LockedBy    string
+	LastError   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,125 @@
+package model
+
+import (
+	"net/http"
+	"time"
+)
+
+// MailDeliveryStatus represent the outcome of sending a mail to a recipient
+type MailDeliveryStatus string
+
+const (
+	// MailDeliveryStatusSent is the status of a mail accepted by the mailer
+	MailDeliveryStatusSent MailDeliveryStatus = "sent"
+	// MailDeliveryStatusFailed is the status of a mail the mailer failed to send
+	MailDeliveryStatusFailed MailDeliveryStatus = "failed"
+)
+
+// MailDelivery represent the mail of a campaign sent to a user
+type MailDelivery struct {
+	Campaign string
+	UserID   int
+	Email    string
+	Status   MailDeliveryStatus
+	Error    string
+}
+
+// MailCheckpoint represent the progress of a campaign, the users are
+// processed in order of ID so the campaign resumes after LastUserID
+type MailCheckpoint struct {
+	Campaign   string
+	LastUserID int
+	Sent       int
+	Skipped    int
+	Failed     int
+	StartedAt  time.Time
+	FinishedAt *time.Time
+}
+
+// SentMailReport represent the summary of a campaign
+type SentMailReport struct {
+	Campaign   string
+	Sent       int
+	Skipped    int
+	Failed     int
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Duration   time.Duration
+}
+
+// MailOutboxStatus represent the status of a mail of the outbox
+type MailOutboxStatus string
+
+const (
+	// MailOutboxStatusQueued is the status of a mail waiting to be sent or retried
+	MailOutboxStatusQueued MailOutboxStatus = "queued"
+	// MailOutboxStatusSent is the status of a mail accepted by the mailer
+	MailOutboxStatusSent MailOutboxStatus = "sent"
+	// MailOutboxStatusFailed is the status of a mail which ran out of attempts or
+	// was addressed to an undeliverable address
+	MailOutboxStatusFailed MailOutboxStatus = "failed"
+	// MailOutboxStatusBounced is the status of a sent mail the provider reported as bounced
+	MailOutboxStatusBounced MailOutboxStatus = "bounced"
+)
+
+// ErrMailLockLost is the error for recording the outcome of a mail which isn't locked by the dispatcher anymore
+var ErrMailLockLost = Error{
+	Status:  http.StatusConflict,
+	Code:    "MAIL_LOCK_LOST",
+	Message: "the mail isn't locked by the dispatcher anymore",
+}
+
+// MailOutboxMessage represent a mail written to the outbox, it's rendered when
+// enqueued and sent by the dispatcher once the transaction is committed
+type MailOutboxMessage struct {
+	ID            int64
+	Template      string
+	To            string
+	Subject       string
+	HTML          string
+	Text          string
+	MessageID     string
+	Status        MailOutboxStatus
+	Attempts      int
+	MaxAttempts   int
+	NextAttemptAt time.Time
+	LockedBy      string
+	LastError     string
+	SentAt        *time.Time
+	CreatedAt     time.Time
+}
+
+// MailEventType represent the type of an event reported by the mail provider
+type MailEventType string
+
+const (
+	// MailEventTypeBounce is the event of a mail rejected by the server of the recipient
+	MailEventTypeBounce MailEventType = "bounce"
+	// MailEventTypeComplaint is the event of a recipient marking a mail as spam
+	MailEventTypeComplaint MailEventType = "complaint"
+)
+
+// MailEvent represent a bounce or a complaint reported by the mail provider,
+// the message ID is the Message-ID header of the mail when the provider knows it
+type MailEvent struct {
+	Type      MailEventType
+	Email     string
+	MessageID string
+	Detail    string
+}
+
+// MailSuppression represent an address which is never mailed again
+type MailSuppression struct {
+	Email  string
+	Reason MailEventType
+	Detail string
+}
+
+var (
+	// ErrInvalidMailEvent is the error for an event of an unknown type or without address
+	ErrInvalidMailEvent = Error{
+		Status:  http.StatusBadRequest,
+		Code:    "INVALID_MAIL_EVENT",
+		Message: "invalid mail event",
+	}
+)
@@ -1,5 +1,7 @@
 package model
 
+import "net/http"
+
 // Pagination represent the pagination
 type Pagination struct {
 	Page         int
@@ -9,4 +11,16 @@ type Pagination struct {
 	Offset       int
 	Sort         string
 	HasNext      bool
+
+	// NextCursor and PrevCursor are the cursors of the next and previous pages in cursor mode,
+	// empty when there is no such page
+	NextCursor string
+	PrevCursor string
+}
+
+// ErrInvalidCursor is the error for a cursor which is malformed, tampered with or of another sort
+var ErrInvalidCursor = Error{
+	Status:  http.StatusBadRequest,
+	Code:    "INVALID_CURSOR",
+	Message: "invalid cursor",
 }
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// OfflineMessage represent a realtime message waiting for the user to connect
+type OfflineMessage struct {
+	ID        int64
+	UserID    int
+	Payload   json.RawMessage
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// RealtimeTicket represent a connection ticket, only the hash of the ticket is stored
+type RealtimeTicket struct {
+	TokenHash string
+	UserID    int
+	ExpiresAt time.Time
+}
@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+// ScheduleStatus represent the outcome of the last run of a schedule
+type ScheduleStatus string
+
+const (
+	// ScheduleStatusRunning is the status of a schedule whose task is running
+	ScheduleStatusRunning ScheduleStatus = "running"
+	// ScheduleStatusSucceeded is the status of a schedule whose last run succeeded
+	ScheduleStatusSucceeded ScheduleStatus = "succeeded"
+	// ScheduleStatusFailed is the status of a schedule whose last run failed
+	ScheduleStatusFailed ScheduleStatus = "failed"
+)
+
+// Schedule represent a periodic task run by the scheduler
+type Schedule struct {
+	Name         string
+	Spec         string
+	NextRunAt    *time.Time
+	LastRunAt    *time.Time
+	LastStatus   ScheduleStatus
+	LastError    string
+	LastDuration time.Duration
+	UpdatedAt    time.Time
+}
+
+// ScheduleRun represent the outcome of a run of a schedule
+type ScheduleRun struct {
+	StartedAt time.Time
+	NextRunAt time.Time
+	Status    ScheduleStatus
+	Error     string
+	Duration  time.Duration
+}
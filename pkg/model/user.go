@@ -24,6 +24,8 @@ const (
 type UpdateUserRequest struct {
 	FullName string
 	Avatar   string
+	// Version is the version of the user the changes were made on, 0 is the current one
+	Version int
 }
 
 // UpdatePasswordRequest represent the update password request
@@ -42,4 +44,5 @@ type User struct {
 	Status         string
 	Avatar         string
 	Role           string
+	Version        int
 }
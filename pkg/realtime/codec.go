@@ -0,0 +1,100 @@
+package realtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	"github.com/gorilla/websocket"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// SubprotocolMsgpack is the WebSocket subprotocol encoding the frames as MessagePack
+const SubprotocolMsgpack = "msgpack"
+
+func init() {
+	// encode the raw JSON payloads as native MessagePack values instead of binary strings
+	msgpack.Register(json.RawMessage(nil),
+		func(e *msgpack.Encoder, v reflect.Value) error {
+			raw := v.Interface().(json.RawMessage)
+			if len(raw) == 0 {
+				return e.EncodeNil()
+			}
+
+			var val any
+			if err := json.Unmarshal(raw, &val); err != nil {
+				return err
+			}
+			return e.Encode(val)
+		},
+		func(d *msgpack.Decoder, v reflect.Value) error {
+			val, err := d.DecodeInterface()
+			if err != nil {
+				return err
+			}
+
+			raw, err := json.Marshal(val)
+			if err != nil {
+				return err
+			}
+			v.SetBytes(raw)
+			return nil
+		},
+	)
+}
+
+// codec encodes the outbound data and decodes the inbound frames of a connection
+type codec interface {
+	// encode returns the frame type and the encoded data
+	encode(v any) (int, []byte, error)
+
+	// decode converts an inbound frame to JSON, which is what the router understands
+	decode(messageType int, data []byte) ([]byte, error)
+}
+
+// codecFor returns the codec of the negotiated subprotocol, JSON is the default.
+func codecFor(subprotocol string) codec {
+	if subprotocol == SubprotocolMsgpack {
+		return msgpackCodec{}
+	}
+	return jsonCodec{}
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) encode(v any) (int, []byte, error) {
+	body, err := json.Marshal(v)
+	return websocket.TextMessage, body, err
+}
+
+func (jsonCodec) decode(messageType int, data []byte) ([]byte, error) {
+	return data, nil
+}
+
+type msgpackCodec struct{}
+
+func (msgpackCodec) encode(v any) (int, []byte, error) {
+	var buf bytes.Buffer
+	enc := msgpack.NewEncoder(&buf)
+	// the field names are the same as the JSON frames
+	enc.SetCustomStructTag("json")
+	if err := enc.Encode(v); err != nil {
+		return 0, nil, err
+	}
+
+	return websocket.BinaryMessage, buf.Bytes(), nil
+}
+
+// decode accepts the text frames as JSON so the clients can mix both.
+func (msgpackCodec) decode(messageType int, data []byte) ([]byte, error) {
+	if messageType != websocket.BinaryMessage {
+		return data, nil
+	}
+
+	var val any
+	if err := msgpack.Unmarshal(data, &val); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(val)
+}
@@ -0,0 +1,155 @@
+package realtime
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/middleware"
+	"github.com/dwarvesf/go-api/pkg/service/jwthelper"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func Test_msgpackCodec_encode(t *testing.T) {
+	msg := Message{
+		Version: ProtocolVersion,
+		Type:    TypeAck,
+		ID:      "1",
+		Payload: json.RawMessage(`{"text":"hello","count":2}`),
+	}
+
+	messageType, body, err := msgpackCodec{}.encode(msg)
+	require.NoError(t, err)
+	assert.Equal(t, websocket.BinaryMessage, messageType)
+
+	// the payload is a native map and the field names follow the JSON tags
+	var got map[string]any
+	require.NoError(t, msgpack.Unmarshal(body, &got))
+	assert.Equal(t, map[string]any{
+		"version": int8(1),
+		"type":    "ack",
+		"id":      "1",
+		"payload": map[string]any{"text": "hello", "count": float64(2)},
+	}, got)
+}
+
+func Test_msgpackCodec_decode(t *testing.T) {
+	binary, err := msgpack.Marshal(map[string]any{"version": 1, "type": "ping", "payload": map[string]any{"text": "hello"}})
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		messageType int
+		data        []byte
+		want        string
+		wantErr     bool
+	}{
+		"binary frame": {
+			messageType: websocket.BinaryMessage,
+			data:        binary,
+			want:        `{"version":1,"type":"ping","payload":{"text":"hello"}}`,
+		},
+		"text frame": {
+			messageType: websocket.TextMessage,
+			data:        []byte(`{"version":1,"type":"ping"}`),
+			want:        `{"version":1,"type":"ping"}`,
+		},
+		"malformed binary frame": {
+			messageType: websocket.BinaryMessage,
+			data:        []byte{0xc1},
+			wantErr:     true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := msgpackCodec{}.decode(tt.messageType, tt.data)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+func Test_ws_subprotocol(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s := New(config.Config{}, middleware.NewAuthMiddleware(jwthelper.NewHelper("secret")), nil, nil, logger.NewLogger())
+	router := NewRouter()
+	router.Handle("ping", func(c *gin.Context, u User, msg Message) (any, error) {
+		return map[string]string{"text": "pong"}, nil
+	})
+
+	// the hijacked connections aren't tracked by the test server, the handlers
+	// are waited for so none of them still runs once the test is done
+	var handlers sync.WaitGroup
+	r := gin.New()
+	r.GET("/ws", func(c *gin.Context) {
+		handlers.Add(1)
+		defer handlers.Done()
+		u, err := s.HandleConnection(c)
+		if err != nil {
+			return
+		}
+		s.HandleEvent(c, *u, router.Callback(s, *u))
+	})
+	srv := httptest.NewServer(r)
+	t.Cleanup(func() {
+		srv.Close()
+		handlers.Wait()
+	})
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws"
+
+	tests := map[string]struct {
+		subprotocols []string
+		request      func(conn *websocket.Conn) error
+		wantType     int
+	}{
+		"json": {
+			request: func(conn *websocket.Conn) error {
+				return conn.WriteMessage(websocket.TextMessage, []byte(`{"version":1,"type":"ping","id":"1","ack":true}`))
+			},
+			wantType: websocket.TextMessage,
+		},
+		"msgpack": {
+			subprotocols: []string{SubprotocolMsgpack},
+			request: func(conn *websocket.Conn) error {
+				body, err := msgpack.Marshal(map[string]any{"version": 1, "type": "ping", "id": "1", "ack": true})
+				if err != nil {
+					return err
+				}
+				return conn.WriteMessage(websocket.BinaryMessage, body)
+			},
+			wantType: websocket.BinaryMessage,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			dialer := websocket.Dialer{
+				Subprotocols:      tt.subprotocols,
+				EnableCompression: true,
+			}
+			conn, resp, err := dialer.Dial(url, nil)
+			require.NoError(t, err)
+			t.Cleanup(func() { conn.Close() })
+			assert.Contains(t, resp.Header.Get("Sec-WebSocket-Extensions"), "permessage-deflate")
+
+			require.NoError(t, tt.request(conn))
+			messageType, data, err := conn.ReadMessage()
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantType, messageType)
+
+			got, err := codecFor(conn.Subprotocol()).decode(messageType, data)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"version":1,"type":"ack","id":"1","payload":{"text":"pong"}}`, string(got))
+		})
+	}
+}
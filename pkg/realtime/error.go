@@ -11,4 +11,31 @@ var (
 
 	// ErrDeviceNotFound is returned when a device is not found.
 	ErrDeviceNotFound = errors.New("device not found")
+
+	// ErrDeviceDisconnected is returned when a device disconnects before answering a request.
+	ErrDeviceDisconnected = errors.New("device disconnected")
+
+	// ErrRequestNotSupported is returned when the server can't receive the answer of a request.
+	ErrRequestNotSupported = errors.New("request is not supported")
+
+	// ErrInvalidMessage is returned when a message envelope is malformed.
+	ErrInvalidMessage = errors.New("invalid message")
+
+	// ErrUnknownMessageType is returned when no handler is registered for a message type.
+	ErrUnknownMessageType = errors.New("unknown message type")
+
+	// ErrGuestsDisabled is returned when a guest connects while guests are disabled.
+	ErrGuestsDisabled = errors.New("guests are disabled")
+
+	// ErrTooManyGuests is returned when the guest connection cap is reached.
+	ErrTooManyGuests = errors.New("too many guest connections")
+
+	// ErrTooManyConnections is returned when the connection limit of a user or an IP is reached.
+	ErrTooManyConnections = errors.New("too many connections")
+
+	// ErrMessageTooLarge is returned when an inbound message exceeds the size limit.
+	ErrMessageTooLarge = errors.New("message too large")
+
+	// ErrRateLimited is returned when a connection sends messages too fast.
+	ErrRateLimited = errors.New("rate limit exceeded")
 )
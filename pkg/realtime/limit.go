@@ -0,0 +1,170 @@
+package realtime
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/gorilla/websocket"
+)
+
+// Limits represents the limits of the realtime connections, a zero value means unlimited
+type Limits struct {
+	MaxConnsPerUser int
+	MaxConnsPerIP   int
+	MaxGuests       int
+	DisableGuests   bool
+
+	// MaxMessageSize is the maximum size in bytes of an inbound message
+	MaxMessageSize int64
+
+	// MessageRate is the number of inbound messages per second allowed on a
+	// connection, MessageBurst is the number of messages allowed at once
+	MessageRate  float64
+	MessageBurst int
+}
+
+// LimitsFromConfig returns the limits set in the config.
+func LimitsFromConfig(cfg config.Config) Limits {
+	return Limits{
+		MaxConnsPerUser: cfg.RealtimeMaxConnsPerUser,
+		MaxConnsPerIP:   cfg.RealtimeMaxConnsPerIP,
+		MaxGuests:       cfg.RealtimeMaxGuests,
+		DisableGuests:   cfg.RealtimeDisableGuests,
+		MaxMessageSize:  int64(cfg.RealtimeMaxMessageSize),
+		MessageRate:     float64(cfg.RealtimeMessageRate),
+		MessageBurst:    cfg.RealtimeMessageBurst,
+	}
+}
+
+// closeCode returns the WebSocket close code of a limit violation.
+func closeCode(err error) int {
+	switch {
+	case errors.Is(err, ErrTooManyConnections), errors.Is(err, ErrTooManyGuests):
+		return websocket.CloseTryAgainLater
+	case errors.Is(err, ErrMessageTooLarge):
+		return websocket.CloseMessageTooBig
+	default:
+		return websocket.ClosePolicyViolation
+	}
+}
+
+// connLimiter counts the open connections by user, IP and guest
+type connLimiter struct {
+	limits Limits
+	users  map[string]int
+	ips    map[string]int
+	guests int
+	mutex  sync.Mutex
+}
+
+func newConnLimiter(limits Limits) *connLimiter {
+	return &connLimiter{
+		limits: limits,
+		users:  make(map[string]int),
+		ips:    make(map[string]int),
+	}
+}
+
+// acquire reserves a connection slot, a nil limiter never limits.
+func (l *connLimiter) acquire(userID string, ip string, isGuest bool) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if isGuest {
+		if l.limits.DisableGuests {
+			return ErrGuestsDisabled
+		}
+		if l.limits.MaxGuests > 0 && l.guests >= l.limits.MaxGuests {
+			return ErrTooManyGuests
+		}
+	}
+	if l.limits.MaxConnsPerUser > 0 && l.users[userID] >= l.limits.MaxConnsPerUser {
+		return ErrTooManyConnections
+	}
+	if l.limits.MaxConnsPerIP > 0 && l.ips[ip] >= l.limits.MaxConnsPerIP {
+		return ErrTooManyConnections
+	}
+
+	l.users[userID]++
+	l.ips[ip]++
+	if isGuest {
+		l.guests++
+	}
+
+	return nil
+}
+
+// release frees the connection slot reserved by acquire.
+func (l *connLimiter) release(userID string, ip string, isGuest bool) {
+	if l == nil {
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.users[userID]--; l.users[userID] <= 0 {
+		delete(l.users, userID)
+	}
+	if l.ips[ip]--; l.ips[ip] <= 0 {
+		delete(l.ips, ip)
+	}
+	if isGuest && l.guests > 0 {
+		l.guests--
+	}
+}
+
+// tokenBucket limits the rate of the inbound messages of a connection,
+// it's only used by the goroutine reading the connection
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// newTokenBucket creates a full bucket, it returns nil when the rate is unlimited.
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if rate <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+		now:    time.Now,
+	}
+}
+
+// allow takes a token from the bucket, a nil bucket always allows.
+func (b *tokenBucket) allow() bool {
+	if b == nil {
+		return true
+	}
+
+	now := b.now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+
+	return true
+}
@@ -0,0 +1,196 @@
+package realtime
+
+import (
+	"errors"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/handler/testutil"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_connLimiter_acquire(t *testing.T) {
+	type conn struct {
+		userID  string
+		ip      string
+		isGuest bool
+	}
+	tests := map[string]struct {
+		limits  Limits
+		opened  []conn
+		conn    conn
+		wantErr error
+	}{
+		"unlimited": {
+			opened: []conn{{"user-1", "1.1.1.1", false}, {"user-1", "1.1.1.1", false}},
+			conn:   conn{"user-1", "1.1.1.1", false},
+		},
+		"too many connections of the user": {
+			limits:  Limits{MaxConnsPerUser: 2},
+			opened:  []conn{{"user-1", "1.1.1.1", false}, {"user-1", "2.2.2.2", false}},
+			conn:    conn{"user-1", "3.3.3.3", false},
+			wantErr: ErrTooManyConnections,
+		},
+		"too many connections of the ip": {
+			limits:  Limits{MaxConnsPerIP: 2},
+			opened:  []conn{{"user-1", "1.1.1.1", false}, {"guest-a", "1.1.1.1", true}},
+			conn:    conn{"user-2", "1.1.1.1", false},
+			wantErr: ErrTooManyConnections,
+		},
+		"guests disabled": {
+			limits:  Limits{DisableGuests: true},
+			conn:    conn{"guest-a", "1.1.1.1", true},
+			wantErr: ErrGuestsDisabled,
+		},
+		"guests disabled doesn't limit users": {
+			limits: Limits{DisableGuests: true},
+			conn:   conn{"user-1", "1.1.1.1", false},
+		},
+		"too many guests": {
+			limits:  Limits{MaxGuests: 1},
+			opened:  []conn{{"guest-a", "1.1.1.1", true}},
+			conn:    conn{"guest-b", "2.2.2.2", true},
+			wantErr: ErrTooManyGuests,
+		},
+		"guest cap doesn't limit users": {
+			limits: Limits{MaxGuests: 1},
+			opened: []conn{{"guest-a", "1.1.1.1", true}},
+			conn:   conn{"user-1", "2.2.2.2", false},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			l := newConnLimiter(tt.limits)
+			for _, c := range tt.opened {
+				require.NoError(t, l.acquire(c.userID, c.ip, c.isGuest))
+			}
+
+			err := l.acquire(tt.conn.userID, tt.conn.ip, tt.conn.isGuest)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func Test_connLimiter_release(t *testing.T) {
+	l := newConnLimiter(Limits{MaxConnsPerUser: 1, MaxConnsPerIP: 1, MaxGuests: 1})
+
+	require.NoError(t, l.acquire("guest-a", "1.1.1.1", true))
+	require.Equal(t, ErrTooManyGuests, l.acquire("guest-b", "2.2.2.2", true))
+
+	l.release("guest-a", "1.1.1.1", true)
+	require.NoError(t, l.acquire("guest-b", "1.1.1.1", true))
+	assert.Equal(t, map[string]int{"guest-b": 1}, l.users)
+}
+
+func Test_tokenBucket_allow(t *testing.T) {
+	now := time.Now()
+	b := newTokenBucket(2, 3)
+	b.last = now
+	b.now = func() time.Time { return now }
+
+	// the burst is allowed at once
+	for i := 0; i < 3; i++ {
+		require.True(t, b.allow())
+	}
+	require.False(t, b.allow())
+
+	// the bucket is refilled at the rate
+	now = now.Add(500 * time.Millisecond)
+	require.True(t, b.allow())
+	require.False(t, b.allow())
+
+	// the bucket never holds more than the burst
+	now = now.Add(time.Minute)
+	for i := 0; i < 3; i++ {
+		require.True(t, b.allow())
+	}
+	require.False(t, b.allow())
+
+	// unlimited
+	require.Nil(t, newTokenBucket(0, 0))
+	require.True(t, (*tokenBucket)(nil).allow())
+}
+
+func Test_ws_HandleEvent_rateLimited(t *testing.T) {
+	mockConnection := &recordSocket{}
+	s := &ws{
+		clients: map[string]map[string]*Conn{
+			"user-1": {
+				"user-1-device": {
+					Socket:   mockConnection,
+					DeviceID: "user-1-device",
+					limiter:  newTokenBucket(1, 2),
+				},
+			},
+		},
+		mutex: sync.RWMutex{},
+		log:   logger.NewLogger(),
+	}
+
+	received := 0
+	s.HandleEvent(&gin.Context{}, User{ID: "user-1", DeviceID: "user-1-device"}, func(*gin.Context, any) error {
+		received++
+		return nil
+	})
+
+	assert.Equal(t, 2, received)
+	require.NotEmpty(t, mockConnection.frames)
+	last := mockConnection.frames[len(mockConnection.frames)-1]
+	assert.Equal(t, websocket.CloseMessage, last.messageType)
+	assert.Equal(t, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, ErrRateLimited.Error()), last.data)
+	assert.Empty(t, s.clients["user-1"])
+}
+
+func Test_sse_HandleConnection_limited(t *testing.T) {
+	w := httptest.NewRecorder()
+	ginCtx := testutil.NewRequest(w, testutil.MethodGet, nil, nil, nil, nil)
+
+	s := &sse{
+		clients: make(map[string]map[string]*SSEConn),
+		limiter: newConnLimiter(Limits{DisableGuests: true}),
+	}
+
+	u, err := s.HandleConnection(ginCtx)
+
+	require.Nil(t, u)
+	require.True(t, errors.Is(err, ErrGuestsDisabled))
+	assert.Equal(t, "event:error\ndata:{\"version\":1,\"type\":\"error\",\"error\":{\"code\":\"GUESTS_DISABLED\",\"message\":\"guests are disabled\"}}\n\n", w.Body.String())
+}
+
+type frame struct {
+	messageType int
+	data        []byte
+}
+
+// recordSocket records the written frames, it always reads the same message
+type recordSocket struct {
+	frames []frame
+	closed bool
+}
+
+func (m *recordSocket) ReadMessage() (int, []byte, error) {
+	if m.closed {
+		return 0, nil, errors.New("closed")
+	}
+	return websocket.TextMessage, []byte(`{"version":1,"type":"ping"}`), nil
+}
+
+func (m *recordSocket) WriteMessage(messageType int, data []byte) error {
+	m.frames = append(m.frames, frame{messageType, data})
+	return nil
+}
+
+func (m *recordSocket) WriteJSON(v interface{}) error {
+	return nil
+}
+
+func (m *recordSocket) Close() error {
+	m.closed = true
+	return nil
+}
@@ -0,0 +1,143 @@
+package realtime
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+)
+
+const (
+	// ProtocolVersion is the current version of the message envelope
+	ProtocolVersion = 1
+
+	// TypeAck is the message type used to acknowledge a request
+	TypeAck = "ack"
+
+	// TypeError is the message type used to report an error
+	TypeError = "error"
+)
+
+// Message represents the envelope of every realtime message
+type Message struct {
+	Version int             `json:"version"`
+	Type    string          `json:"type"`
+	ID      string          `json:"id,omitempty"`
+	Channel string          `json:"channel,omitempty"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+	Ack     bool            `json:"ack,omitempty"`
+	Error   *MessageError   `json:"error,omitempty"`
+}
+
+// MessageError represents the error of an error frame
+type MessageError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error returns the error reported by the other side.
+func (e *MessageError) Error() string {
+	return e.Code + ": " + e.Message
+}
+
+// NewMessage creates a new message with the payload encoded as JSON.
+func NewMessage(msgType string, payload any) (Message, error) {
+	msg := Message{
+		Version: ProtocolVersion,
+		Type:    msgType,
+	}
+	if payload == nil {
+		return msg, nil
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return Message{}, err
+	}
+	msg.Payload = body
+
+	return msg, nil
+}
+
+// ParseMessage decodes and validates a message envelope.
+func ParseMessage(data []byte) (Message, error) {
+	var msg Message
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return Message{}, fmt.Errorf("%w: %v", ErrInvalidMessage, err)
+	}
+
+	if err := msg.Validate(); err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
+
+// Validate validates the message envelope.
+func (m Message) Validate() error {
+	if m.Version != ProtocolVersion {
+		return fmt.Errorf("%w: unsupported version %d", ErrInvalidMessage, m.Version)
+	}
+	if m.Type == "" {
+		return fmt.Errorf("%w: type is required", ErrInvalidMessage)
+	}
+	if m.Ack && m.ID == "" {
+		return fmt.Errorf("%w: id is required when ack is requested", ErrInvalidMessage)
+	}
+
+	return nil
+}
+
+// Decode decodes the payload of the message into v, an empty payload leaves v untouched.
+func (m Message) Decode(v any) error {
+	if len(m.Payload) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(m.Payload, v); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidMessage, err)
+	}
+
+	return nil
+}
+
+// ackMessage creates the acknowledgement of a message.
+func ackMessage(req Message, result any) (*Message, error) {
+	msg, err := NewMessage(TypeAck, result)
+	if err != nil {
+		return nil, err
+	}
+	msg.ID = req.ID
+	msg.Channel = req.Channel
+
+	return &msg, nil
+}
+
+// errorMessage creates the error frame of a message.
+func errorMessage(req Message, err error) *Message {
+	return &Message{
+		Version: ProtocolVersion,
+		Type:    TypeError,
+		ID:      req.ID,
+		Channel: req.Channel,
+		Error:   toMessageError(err),
+	}
+}
+
+func toMessageError(err error) *MessageError {
+	var e model.Error
+	switch {
+	case errors.Is(err, ErrInvalidMessage):
+		return &MessageError{Code: "INVALID_MESSAGE", Message: err.Error()}
+	case errors.Is(err, ErrUnknownMessageType):
+		return &MessageError{Code: "UNKNOWN_TYPE", Message: err.Error()}
+	case errors.Is(err, ErrGuestsDisabled):
+		return &MessageError{Code: "GUESTS_DISABLED", Message: err.Error()}
+	case errors.Is(err, ErrTooManyGuests), errors.Is(err, ErrTooManyConnections):
+		return &MessageError{Code: "TOO_MANY_CONNECTIONS", Message: err.Error()}
+	case errors.As(err, &e):
+		return &MessageError{Code: e.Code, Message: e.Message}
+	default:
+		return &MessageError{Code: "INTERNAL_ERROR", Message: err.Error()}
+	}
+}
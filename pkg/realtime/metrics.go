@@ -0,0 +1,73 @@
+package realtime
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	serverWS  = "ws"
+	serverSSE = "sse"
+
+	connTypeUser  = "user"
+	connTypeGuest = "guest"
+
+	reasonNoDevice     = "no_device"
+	reasonWriteFailed  = "write_failed"
+	reasonRateLimited  = "rate_limited"
+	reasonTooLarge     = "too_large"
+	reasonBufferFull   = "buffer_full"
+	reasonDisconnected = "disconnected"
+)
+
+var (
+	connectionsGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "realtime",
+		Name:      "connections",
+		Help:      "Number of open realtime connections.",
+	}, []string{"server", "type"})
+
+	messagesInCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "realtime",
+		Name:      "messages_received_total",
+		Help:      "Number of messages received from the clients.",
+	}, []string{"server"})
+
+	messagesOutCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "realtime",
+		Name:      "messages_sent_total",
+		Help:      "Number of messages sent to the clients.",
+	}, []string{"server"})
+
+	bytesInCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "realtime",
+		Name:      "received_bytes_total",
+		Help:      "Number of bytes received from the clients.",
+	}, []string{"server"})
+
+	bytesOutCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "realtime",
+		Name:      "sent_bytes_total",
+		Help:      "Number of bytes sent to the clients.",
+	}, []string{"server"})
+
+	droppedCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "realtime",
+		Name:      "messages_dropped_total",
+		Help:      "Number of messages which were never delivered.",
+	}, []string{"server", "reason"})
+
+	queueDepthGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "realtime",
+		Name:      "queue_depth",
+		Help:      "Number of messages waiting to be written to a connection.",
+	}, []string{"server"})
+)
+
+// connType returns the connection type label.
+func connType(isGuest bool) string {
+	if isGuest {
+		return connTypeGuest
+	}
+	return connTypeUser
+}
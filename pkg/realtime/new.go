@@ -1,12 +1,20 @@
 package realtime
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
 	"sync"
+	"time"
 
+	"github.com/dwarvesf/go-api/pkg/config"
 	"github.com/dwarvesf/go-api/pkg/logger"
 	"github.com/dwarvesf/go-api/pkg/middleware"
+	"github.com/dwarvesf/go-api/pkg/model"
 	"github.com/dwarvesf/go-api/pkg/util"
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 )
 
 const (
@@ -18,6 +26,9 @@ const (
 
 	// randomIDLength is the length of the random ID for guest users
 	randomIDLength = 10
+
+	// ticketQueryKey is the query parameter carrying the connection ticket
+	ticketQueryKey = "ticket"
 )
 
 // User represents a realtime user
@@ -26,15 +37,29 @@ type User struct {
 	DeviceID string
 }
 
+// ConnectionInfo represents an open realtime connection
+type ConnectionInfo struct {
+	UserID      string
+	DeviceID    string
+	IsGuest     bool
+	IP          string
+	ConnectedAt time.Time
+}
+
 // Server represents a WebSocket server interface
 type Server interface {
 	HandleConnection(c *gin.Context) (*User, error)
 	HandleEvent(c *gin.Context, u User, callback func(c *gin.Context, data any) error)
 	SendMessage(userID string, message string) error
 	SendData(userID string, data any) error
+	SendConnected(userID string, data any) (int, error)
+	SendDeviceData(u User, data any) error
+	Request(ctx context.Context, u User, method string, params any) (json.RawMessage, error)
 	BroadcastMessage(message string) error
 	BroadcastData(data any) error
 	DisconnectUser(u User) error
+	Connections() []ConnectionInfo
+	Shutdown(ctx context.Context) error
 }
 
 // generateRandomID generates a random ID for guest users.
@@ -42,12 +67,53 @@ func generateRandomID() string {
 	return util.RandomString(randomIDLength)
 }
 
+// identify returns the ID of the connecting user, a ticket in the query
+// takes precedence over the Authorization header and anonymous users are guests.
+func identify(c *gin.Context, authMw middleware.AuthMiddleware, tickets TicketStore) (string, bool, error) {
+	if ticket := c.Query(ticketQueryKey); ticket != "" && tickets != nil {
+		uID, err := tickets.Redeem(c.Request.Context(), ticket)
+		if err != nil {
+			return "", false, err
+		}
+		return PrefixUser + strconv.Itoa(uID), false, nil
+	}
+
+	jwtClaims, err := authMw.Authenticate(c)
+	if err != nil {
+		if !errors.Is(err, model.ErrNoAuthHeader) {
+			return "", false, err
+		}
+		return PrefixGuest + generateRandomID(), true, nil
+	}
+
+	uID, err := middleware.UserIDFromJWTClaims(jwtClaims)
+	if err != nil {
+		return "", false, err
+	}
+
+	return PrefixUser + strconv.Itoa(uID), false, nil
+}
+
 // New creates a new WebSocket server.
-func New(authMw middleware.AuthMiddleware, l logger.Log) Server {
+// The offline queue is optional, undeliverable messages are dropped when it is nil.
+func New(cfg config.Config, authMw middleware.AuthMiddleware, tickets TicketStore, offline OfflineQueue, l logger.Log) Server {
+	limits := LimitsFromConfig(cfg)
 	return &ws{
 		clients: make(map[string]map[string]*Conn),
 		mutex:   sync.RWMutex{},
 		authMw:  authMw,
+		tickets: tickets,
+		offline: offline,
+		limits:  limits,
+		limiter: newConnLimiter(limits),
 		log:     l,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			CheckOrigin:     checkOrigin(cfg.AllowedOrigins),
+			// permessage-deflate is only used when the client offers it
+			EnableCompression: true,
+			Subprotocols:      []string{SubprotocolMsgpack},
+		},
 	}
 }
@@ -0,0 +1,135 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/offlinemessage"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// TypeOffline is the message type of the messages queued while the user was offline
+	TypeOffline = "offline"
+
+	// TypeOfflineAck is the message type the clients acknowledge the offline messages with,
+	// it isn't TypeAck so that the ID of a message never resolves a request of the server
+	TypeOfflineAck = "offline.ack"
+
+	// DefaultOfflineTTL is the default lifetime of a queued message
+	DefaultOfflineTTL = 24 * time.Hour
+)
+
+// OfflineQueue stores the messages which couldn't be delivered because the user
+// had no connected device, they are delivered again when the user connects.
+// Whether a user has a connected device is only known by the replica the
+// user is connected to, so the queue must only be enabled with a single replica.
+type OfflineQueue interface {
+	Push(ctx context.Context, userID string, data any) error
+	Pending(ctx context.Context, userID string) ([]Message, error)
+	Ack(ctx context.Context, userID string, msgID string) error
+}
+
+type offlineQueue struct {
+	repo offlinemessage.Repo
+	ttl  time.Duration
+	now  func() time.Time
+}
+
+// NewOfflineQueue creates an offline queue persisted by the repository.
+func NewOfflineQueue(repo offlinemessage.Repo, ttl time.Duration) OfflineQueue {
+	return &offlineQueue{
+		repo: repo,
+		ttl:  ttl,
+		now:  time.Now,
+	}
+}
+
+// Push stores the data for the user until it expires.
+func (q *offlineQueue) Push(ctx context.Context, userID string, data any) error {
+	uID, ok := parseUserID(userID)
+	if !ok {
+		return ErrUserNotFound
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	dbCtx := db.FromContext(ctx)
+	now := q.now()
+	if err := q.repo.DeleteExpired(dbCtx, uID, now); err != nil {
+		return err
+	}
+
+	_, err = q.repo.Create(dbCtx, uID, payload, now.Add(q.ttl))
+	return err
+}
+
+// Pending returns the queued messages of the user in the order they were pushed,
+// every message asks for an ack which removes it from the queue.
+func (q *offlineQueue) Pending(ctx context.Context, userID string) ([]Message, error) {
+	uID, ok := parseUserID(userID)
+	if !ok {
+		return nil, nil
+	}
+
+	dt, err := q.repo.GetPending(db.FromContext(ctx), uID, q.now())
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]Message, 0, len(dt))
+	for _, m := range dt {
+		rs = append(rs, Message{
+			Version: ProtocolVersion,
+			Type:    TypeOffline,
+			ID:      strconv.FormatInt(m.ID, 10),
+			Payload: m.Payload,
+			Ack:     true,
+		})
+	}
+
+	return rs, nil
+}
+
+// Ack removes the delivered message from the queue of the user.
+func (q *offlineQueue) Ack(ctx context.Context, userID string, msgID string) error {
+	uID, ok := parseUserID(userID)
+	if !ok {
+		return ErrUserNotFound
+	}
+
+	id, err := strconv.ParseInt(msgID, 10, 64)
+	if err != nil {
+		return ErrInvalidMessage
+	}
+
+	return q.repo.Delete(db.FromContext(ctx), uID, id)
+}
+
+// HandleAck returns the router handler of TypeOfflineAck removing the acknowledged messages from the queue.
+func HandleAck(q OfflineQueue) HandlerFunc {
+	return func(c *gin.Context, u User, msg Message) (any, error) {
+		return nil, q.Ack(c.Request.Context(), u.ID, msg.ID)
+	}
+}
+
+// parseUserID returns the ID of an authenticated user, guests are never queued.
+func parseUserID(userID string) (int, bool) {
+	if !strings.HasPrefix(userID, PrefixUser) {
+		return 0, false
+	}
+
+	uID, err := strconv.Atoi(strings.TrimPrefix(userID, PrefixUser))
+	if err != nil {
+		return 0, false
+	}
+
+	return uID, true
+}
@@ -0,0 +1,239 @@
+package realtime
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	mocks "github.com/dwarvesf/go-api/mocks/pkg/repository/offlinemessage"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_offlineQueue_Push(t *testing.T) {
+	_, err := db.Init(config.LoadTestConfig())
+	require.NoError(t, err)
+
+	now := time.Now()
+	type mocked struct {
+		expDeleteExpired bool
+		deleteExpiredErr error
+		expCreate        bool
+		createErr        error
+	}
+	tests := map[string]struct {
+		userID  string
+		data    any
+		mocked  mocked
+		wantErr error
+	}{
+		"success": {
+			userID: "user-1",
+			data:   map[string]string{"text": "hello"},
+			mocked: mocked{
+				expDeleteExpired: true,
+				expCreate:        true,
+			},
+		},
+		"guest is never queued": {
+			userID:  "guest-abc",
+			data:    map[string]string{"text": "hello"},
+			wantErr: ErrUserNotFound,
+		},
+		"failed to delete expired messages": {
+			userID: "user-1",
+			data:   map[string]string{"text": "hello"},
+			mocked: mocked{
+				expDeleteExpired: true,
+				deleteExpiredErr: errors.New("failed"),
+			},
+			wantErr: errors.New("failed"),
+		},
+		"failed to create": {
+			userID: "user-1",
+			data:   map[string]string{"text": "hello"},
+			mocked: mocked{
+				expDeleteExpired: true,
+				expCreate:        true,
+				createErr:        errors.New("failed"),
+			},
+			wantErr: errors.New("failed"),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			repoMock := mocks.NewRepo(t)
+			if tt.mocked.expDeleteExpired {
+				repoMock.EXPECT().DeleteExpired(mock.Anything, 1, now).Return(tt.mocked.deleteExpiredErr)
+			}
+			if tt.mocked.expCreate {
+				repoMock.EXPECT().
+					Create(mock.Anything, 1, json.RawMessage(`{"text":"hello"}`), now.Add(time.Hour)).
+					Return(&model.OfflineMessage{ID: 1}, tt.mocked.createErr)
+			}
+
+			q := &offlineQueue{
+				repo: repoMock,
+				ttl:  time.Hour,
+				now:  func() time.Time { return now },
+			}
+			err := q.Push(&gin.Context{}, tt.userID, tt.data)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func Test_offlineQueue_Pending(t *testing.T) {
+	_, err := db.Init(config.LoadTestConfig())
+	require.NoError(t, err)
+
+	now := time.Now()
+	repoMock := mocks.NewRepo(t)
+	repoMock.EXPECT().GetPending(mock.Anything, 1, now).Return([]model.OfflineMessage{
+		{ID: 3, UserID: 1, Payload: json.RawMessage(`"first"`)},
+		{ID: 7, UserID: 1, Payload: json.RawMessage(`"second"`)},
+	}, nil)
+
+	q := &offlineQueue{
+		repo: repoMock,
+		ttl:  time.Hour,
+		now:  func() time.Time { return now },
+	}
+	got, err := q.Pending(&gin.Context{}, "user-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, []Message{
+		{Version: ProtocolVersion, Type: TypeOffline, ID: "3", Payload: json.RawMessage(`"first"`), Ack: true},
+		{Version: ProtocolVersion, Type: TypeOffline, ID: "7", Payload: json.RawMessage(`"second"`), Ack: true},
+	}, got)
+}
+
+func Test_offlineQueue_Ack(t *testing.T) {
+	_, err := db.Init(config.LoadTestConfig())
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		userID    string
+		msgID     string
+		expDelete bool
+		wantErr   error
+	}{
+		"success": {
+			userID:    "user-1",
+			msgID:     "3",
+			expDelete: true,
+		},
+		"guest": {
+			userID:  "guest-abc",
+			msgID:   "3",
+			wantErr: ErrUserNotFound,
+		},
+		"invalid message id": {
+			userID:  "user-1",
+			msgID:   "abc",
+			wantErr: ErrInvalidMessage,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			repoMock := mocks.NewRepo(t)
+			if tt.expDelete {
+				repoMock.EXPECT().Delete(mock.Anything, 1, int64(3)).Return(nil)
+			}
+
+			q := &offlineQueue{repo: repoMock, now: time.Now}
+			err := q.Ack(&gin.Context{}, tt.userID, tt.msgID)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func Test_ws_offlineQueue(t *testing.T) {
+	_, err := db.Init(config.LoadTestConfig())
+	require.NoError(t, err)
+
+	now := time.Now()
+	repoMock := mocks.NewRepo(t)
+	s := &ws{
+		clients: make(map[string]map[string]*Conn),
+		mutex:   sync.RWMutex{},
+		offline: &offlineQueue{
+			repo: repoMock,
+			ttl:  time.Hour,
+			now:  func() time.Time { return now },
+		},
+		log: logger.NewLogger(),
+	}
+
+	// the user is offline, the data is queued
+	repoMock.EXPECT().DeleteExpired(mock.Anything, 1, now).Return(nil)
+	repoMock.EXPECT().Create(mock.Anything, 1, json.RawMessage(`"hello"`), now.Add(time.Hour)).
+		Return(&model.OfflineMessage{ID: 1}, nil)
+	err = s.SendData("user-1", "hello")
+	require.NoError(t, err)
+
+	// guests are never queued
+	err = s.SendData("guest-abc", "hello")
+	require.Equal(t, ErrUserNotFound, err)
+
+	// the queued data is flushed when the user connects
+	repoMock.EXPECT().GetPending(mock.Anything, 1, now).Return([]model.OfflineMessage{
+		{ID: 1, UserID: 1, Payload: json.RawMessage(`"hello"`)},
+	}, nil)
+	mockConnection := &mockSocket{}
+	s.clients["user-1"] = map[string]*Conn{
+		"user-1-device": {Socket: mockConnection, DeviceID: "user-1-device"},
+	}
+	c := &gin.Context{Request: &http.Request{}}
+	var got string
+	s.HandleEvent(c, User{ID: "user-1", DeviceID: "user-1-device"}, func(*gin.Context, any) error {
+		// the read message echoes the content written so far
+		got = string(mockConnection.content)
+		return errors.New("closed")
+	})
+
+	assert.Equal(t, `{"version":1,"type":"offline","id":"1","payload":"hello","ack":true}`, got)
+}
+
+func Test_ws_offlineAck(t *testing.T) {
+	_, err := db.Init(config.LoadTestConfig())
+	require.NoError(t, err)
+
+	u := User{ID: "user-1", DeviceID: "user-1-device"}
+	repoMock := mocks.NewRepo(t)
+	repoMock.EXPECT().Delete(mock.Anything, 1, int64(1)).Return(nil)
+
+	// the client acks the offline message 1 while the server waits for the answer of its request 1
+	conn := &Conn{
+		Socket:   &mockSocket{content: []byte(`{"version":1,"type":"offline.ack","id":"1"}`)},
+		DeviceID: u.DeviceID,
+	}
+	call, err := conn.calls.add("1")
+	require.NoError(t, err)
+	s := &ws{
+		clients: map[string]map[string]*Conn{u.ID: {u.DeviceID: conn}},
+		mutex:   sync.RWMutex{},
+		log:     logger.NewLogger(),
+	}
+
+	router := NewRouter()
+	router.Handle(TypeOfflineAck, HandleAck(&offlineQueue{repo: repoMock, now: time.Now}))
+	callback := router.Callback(s, u)
+	s.HandleEvent(&gin.Context{Request: &http.Request{}}, u, func(c *gin.Context, data any) error {
+		require.NoError(t, callback(c, data))
+		return errors.New("closed")
+	})
+
+	// the request is failed by the disconnection instead of being answered by the ack
+	_, answered := <-call
+	assert.False(t, answered)
+}
@@ -0,0 +1,122 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRequestTimeout is the timeout of a request when the context has no deadline
+	DefaultRequestTimeout = 30 * time.Second
+
+	// requestIDPrefix is the prefix of the IDs of the requests sent by the server
+	requestIDPrefix = "req-"
+)
+
+// pendingCalls tracks the requests waiting for the answer of a device
+type pendingCalls struct {
+	calls  map[string]chan Message
+	closed bool
+	mutex  sync.Mutex
+}
+
+// add registers a request, it fails once the device is disconnected.
+func (p *pendingCalls) add(id string) (chan Message, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.closed {
+		return nil, ErrDeviceDisconnected
+	}
+	if p.calls == nil {
+		p.calls = make(map[string]chan Message)
+	}
+
+	ch := make(chan Message, 1)
+	p.calls[id] = ch
+
+	return ch, nil
+}
+
+func (p *pendingCalls) remove(id string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	delete(p.calls, id)
+}
+
+// resolve delivers the answer to the request with the same ID,
+// it reports whether the message was the answer of a request.
+func (p *pendingCalls) resolve(msg Message) bool {
+	if msg.Type != TypeAck && msg.Type != TypeError {
+		return false
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	ch, ok := p.calls[msg.ID]
+	if !ok {
+		return false
+	}
+	delete(p.calls, msg.ID)
+	ch <- msg
+
+	return true
+}
+
+// close fails the pending requests and the ones made afterwards.
+func (p *pendingCalls) close() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.closed = true
+	for id, ch := range p.calls {
+		close(ch)
+		delete(p.calls, id)
+	}
+}
+
+// request sends the method to the device and waits for its answer.
+func (c *Conn) request(ctx context.Context, method string, params any) (json.RawMessage, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DefaultRequestTimeout)
+		defer cancel()
+	}
+
+	msg, err := NewMessage(method, params)
+	if err != nil {
+		return nil, err
+	}
+	msg.ID = requestIDPrefix + generateRandomID()
+	msg.Ack = true
+
+	ch, err := c.calls.add(msg.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer c.calls.remove(msg.ID)
+
+	if err := c.writeData(msg); err != nil {
+		return nil, err
+	}
+
+	select {
+	case reply, ok := <-ch:
+		if !ok {
+			return nil, ErrDeviceDisconnected
+		}
+		if reply.Type == TypeError {
+			if reply.Error == nil {
+				return nil, &MessageError{Code: "INTERNAL_ERROR"}
+			}
+			return nil, reply.Error
+		}
+		return reply.Payload, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,168 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rpcSocket reads the frames pushed to in, the reply func answers the written frames
+type rpcSocket struct {
+	in     chan []byte
+	reply  func(req Message) []byte
+	closed chan struct{}
+	once   sync.Once
+}
+
+func newRPCSocket(reply func(req Message) []byte) *rpcSocket {
+	return &rpcSocket{
+		in:     make(chan []byte, 10),
+		reply:  reply,
+		closed: make(chan struct{}),
+	}
+}
+
+func (m *rpcSocket) ReadMessage() (int, []byte, error) {
+	select {
+	case data := <-m.in:
+		return websocket.TextMessage, data, nil
+	case <-m.closed:
+		return 0, nil, errors.New("closed")
+	}
+}
+
+func (m *rpcSocket) WriteMessage(messageType int, data []byte) error {
+	var req Message
+	if err := json.Unmarshal(data, &req); err == nil && m.reply != nil {
+		if answer := m.reply(req); answer != nil {
+			m.in <- answer
+		}
+	}
+	return nil
+}
+
+func (m *rpcSocket) WriteJSON(v interface{}) error {
+	return nil
+}
+
+func (m *rpcSocket) Close() error {
+	m.once.Do(func() { close(m.closed) })
+	return nil
+}
+
+func Test_ws_Request(t *testing.T) {
+	u := User{ID: "user-1", DeviceID: "user-1-device"}
+	tests := map[string]struct {
+		user       User
+		reply      func(req Message) []byte
+		disconnect bool
+		timeout    time.Duration
+		want       json.RawMessage
+		wantErr    error
+	}{
+		"success": {
+			user: u,
+			reply: func(req Message) []byte {
+				return []byte(`{"version":1,"type":"ack","id":"` + req.ID + `","payload":{"confirmed":true}}`)
+			},
+			want: json.RawMessage(`{"confirmed":true}`),
+		},
+		"device answers with an error": {
+			user: u,
+			reply: func(req Message) []byte {
+				return []byte(`{"version":1,"type":"error","id":"` + req.ID + `","error":{"code":"DENIED","message":"denied"}}`)
+			},
+			wantErr: &MessageError{Code: "DENIED", Message: "denied"},
+		},
+		"timeout": {
+			user:    u,
+			timeout: 50 * time.Millisecond,
+			wantErr: context.DeadlineExceeded,
+		},
+		"device disconnects": {
+			user:       u,
+			disconnect: true,
+			wantErr:    ErrDeviceDisconnected,
+		},
+		"device not found": {
+			user:    User{ID: "user-1", DeviceID: "unknown"},
+			wantErr: ErrDeviceNotFound,
+		},
+		"user not found": {
+			user:    User{ID: "user-2", DeviceID: "user-2-device"},
+			wantErr: ErrUserNotFound,
+		},
+	}
+	l := logger.NewLogger()
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			socket := newRPCSocket(tt.reply)
+			conn := &Conn{Socket: socket, DeviceID: u.DeviceID}
+			s := &ws{
+				clients: map[string]map[string]*Conn{
+					u.ID: {u.DeviceID: conn},
+				},
+				mutex: sync.RWMutex{},
+				log:   l,
+			}
+			done := make(chan struct{})
+			go func() {
+				s.HandleEvent(&gin.Context{}, u, func(*gin.Context, any) error {
+					return errors.New("answers must not reach the callback")
+				})
+				close(done)
+			}()
+
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+			disconnected := make(chan struct{})
+			go func() {
+				defer close(disconnected)
+				if tt.disconnect {
+					time.Sleep(50 * time.Millisecond)
+					s.DisconnectUser(u)
+				}
+			}()
+
+			got, err := s.Request(ctx, tt.user, "confirm", map[string]string{"action": "delete"})
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+			assert.Empty(t, conn.calls.calls)
+
+			<-disconnected
+			socket.Close()
+			<-done
+		})
+	}
+}
+
+func Test_pendingCalls_resolve(t *testing.T) {
+	var p pendingCalls
+	ch, err := p.add("req-1")
+	require.NoError(t, err)
+
+	// only the answers of a pending request are resolved
+	assert.False(t, p.resolve(Message{Type: "ping", ID: "req-1"}))
+	assert.False(t, p.resolve(Message{Type: TypeAck, ID: "req-2"}))
+	assert.False(t, p.resolve(Message{Type: TypeOfflineAck, ID: "req-1"}))
+	assert.True(t, p.resolve(Message{Type: TypeAck, ID: "req-1"}))
+	assert.Equal(t, Message{Type: TypeAck, ID: "req-1"}, <-ch)
+
+	// no request is accepted once closed
+	p.close()
+	_, err = p.add("req-3")
+	assert.Equal(t, ErrDeviceDisconnected, err)
+}
@@ -0,0 +1,99 @@
+package realtime
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HandlerFunc handles a realtime message, the result is sent back as the ack payload
+type HandlerFunc func(c *gin.Context, u User, msg Message) (any, error)
+
+// Router dispatches realtime messages to the handlers registered by message type
+type Router struct {
+	handlers map[string]HandlerFunc
+	mutex    sync.RWMutex
+}
+
+// NewRouter creates a new router, it's shared by the WebSocket and SSE servers.
+func NewRouter() *Router {
+	return &Router{
+		handlers: make(map[string]HandlerFunc),
+	}
+}
+
+// Handle registers the handler for the message type.
+func (r *Router) Handle(msgType string, h HandlerFunc) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.handlers[msgType] = h
+}
+
+// On registers a handler which receives the payload decoded into T.
+func On[T any](r *Router, msgType string, h func(c *gin.Context, u User, payload T) (any, error)) {
+	r.Handle(msgType, func(c *gin.Context, u User, msg Message) (any, error) {
+		var payload T
+		if err := msg.Decode(&payload); err != nil {
+			return nil, err
+		}
+
+		return h(c, u, payload)
+	})
+}
+
+// Dispatch decodes the raw message and calls the registered handler.
+// It returns the frame to reply with, or nil when no reply is needed.
+func (r *Router) Dispatch(c *gin.Context, u User, data []byte) *Message {
+	msg, err := ParseMessage(data)
+	if err != nil {
+		return errorMessage(msg, err)
+	}
+
+	r.mutex.RLock()
+	h, ok := r.handlers[msg.Type]
+	r.mutex.RUnlock()
+	if !ok {
+		return errorMessage(msg, fmt.Errorf("%w: %s", ErrUnknownMessageType, msg.Type))
+	}
+
+	result, err := h(c, u, msg)
+	if err != nil {
+		return errorMessage(msg, err)
+	}
+
+	if !msg.Ack {
+		return nil
+	}
+
+	reply, err := ackMessage(msg, result)
+	if err != nil {
+		return errorMessage(msg, err)
+	}
+
+	return reply
+}
+
+// Callback returns the callback for Server.HandleEvent which dispatches every
+// incoming message and sends the reply to the device through the server it's connected to.
+func (r *Router) Callback(s Server, u User) func(*gin.Context, any) error {
+	return func(c *gin.Context, data any) error {
+		var raw []byte
+		switch v := data.(type) {
+		case []byte:
+			raw = v
+		case string:
+			raw = []byte(v)
+		default:
+			return nil
+		}
+
+		reply := r.Dispatch(c, u, raw)
+		if reply == nil {
+			return nil
+		}
+
+		return s.SendDeviceData(u, reply)
+	}
+}
@@ -0,0 +1,163 @@
+package realtime
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter_Dispatch(t *testing.T) {
+	type echoPayload struct {
+		Text string `json:"text"`
+	}
+
+	r := NewRouter()
+	On(r, "echo", func(c *gin.Context, u User, payload echoPayload) (any, error) {
+		return payload, nil
+	})
+	r.Handle("forbidden", func(c *gin.Context, u User, msg Message) (any, error) {
+		return nil, model.Error{Status: 403, Code: "FORBIDDEN", Message: "forbidden"}
+	})
+	r.Handle("failed", func(c *gin.Context, u User, msg Message) (any, error) {
+		return nil, errors.New("failed")
+	})
+
+	tests := map[string]struct {
+		data string
+		want *Message
+	}{
+		"ack requested": {
+			data: `{"version":1,"type":"echo","id":"1","channel":"room","ack":true,"payload":{"text":"hello"}}`,
+			want: &Message{
+				Version: ProtocolVersion,
+				Type:    TypeAck,
+				ID:      "1",
+				Channel: "room",
+				Payload: json.RawMessage(`{"text":"hello"}`),
+			},
+		},
+		"no ack requested": {
+			data: `{"version":1,"type":"echo","payload":{"text":"hello"}}`,
+			want: nil,
+		},
+		"malformed json": {
+			data: `{"version":1,"type":`,
+			want: &Message{
+				Version: ProtocolVersion,
+				Type:    TypeError,
+				Error:   &MessageError{Code: "INVALID_MESSAGE"},
+			},
+		},
+		"unsupported version": {
+			data: `{"version":2,"type":"echo","id":"2"}`,
+			want: &Message{
+				Version: ProtocolVersion,
+				Type:    TypeError,
+				ID:      "2",
+				Error:   &MessageError{Code: "INVALID_MESSAGE"},
+			},
+		},
+		"missing type": {
+			data: `{"version":1,"id":"3"}`,
+			want: &Message{
+				Version: ProtocolVersion,
+				Type:    TypeError,
+				ID:      "3",
+				Error:   &MessageError{Code: "INVALID_MESSAGE"},
+			},
+		},
+		"ack without id": {
+			data: `{"version":1,"type":"echo","ack":true}`,
+			want: &Message{
+				Version: ProtocolVersion,
+				Type:    TypeError,
+				Error:   &MessageError{Code: "INVALID_MESSAGE"},
+			},
+		},
+		"invalid payload": {
+			data: `{"version":1,"type":"echo","id":"4","payload":{"text":1}}`,
+			want: &Message{
+				Version: ProtocolVersion,
+				Type:    TypeError,
+				ID:      "4",
+				Error:   &MessageError{Code: "INVALID_MESSAGE"},
+			},
+		},
+		"unknown type": {
+			data: `{"version":1,"type":"unknown","id":"5"}`,
+			want: &Message{
+				Version: ProtocolVersion,
+				Type:    TypeError,
+				ID:      "5",
+				Error:   &MessageError{Code: "UNKNOWN_TYPE"},
+			},
+		},
+		"model error": {
+			data: `{"version":1,"type":"forbidden","id":"6"}`,
+			want: &Message{
+				Version: ProtocolVersion,
+				Type:    TypeError,
+				ID:      "6",
+				Error:   &MessageError{Code: "FORBIDDEN"},
+			},
+		},
+		"internal error": {
+			data: `{"version":1,"type":"failed","id":"7"}`,
+			want: &Message{
+				Version: ProtocolVersion,
+				Type:    TypeError,
+				ID:      "7",
+				Error:   &MessageError{Code: "INTERNAL_ERROR"},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := r.Dispatch(&gin.Context{}, User{ID: "user-1", DeviceID: "user-1-device"}, []byte(tc.data))
+			if tc.want == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			require.NotNil(t, got)
+			if got.Error != nil {
+				// only compare the code, the message contains the detail of the error
+				got.Error.Message = ""
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestRouter_Callback(t *testing.T) {
+	mockConnection := &mockSocket{}
+	s := &ws{
+		clients: map[string]map[string]*Conn{
+			"user-1": {
+				"user-1-device": {
+					Socket:   mockConnection,
+					DeviceID: "user-1-device",
+				},
+			},
+		},
+		mutex: sync.RWMutex{},
+	}
+
+	r := NewRouter()
+	r.Handle("ping", func(c *gin.Context, u User, msg Message) (any, error) {
+		return "pong", nil
+	})
+
+	callback := r.Callback(s, User{ID: "user-1", DeviceID: "user-1-device"})
+	err := callback(&gin.Context{}, []byte(`{"version":1,"type":"ping","id":"1","ack":true}`))
+
+	require.NoError(t, err)
+	assert.Equal(t, `{"version":1,"type":"ack","id":"1","payload":"pong"}`, string(mockConnection.content))
+}
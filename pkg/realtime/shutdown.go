@@ -0,0 +1,35 @@
+package realtime
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// TypeReconnect is the message type asking the client to reconnect
+	TypeReconnect = "reconnect"
+
+	// reconnectReason is the reason of the close frame sent on shutdown
+	reconnectReason = "server is restarting, please reconnect"
+
+	// shutdownPollInterval is how often the connections are checked while shutting down
+	shutdownPollInterval = 50 * time.Millisecond
+)
+
+// waitUntil polls done until it returns true or the context is done.
+func waitUntil(ctx context.Context, done func() bool) error {
+	ticker := time.NewTicker(shutdownPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if done() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
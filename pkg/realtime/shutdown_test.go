@@ -0,0 +1,147 @@
+package realtime
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/handler/testutil"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// peerSocket blocks reading until it's closed, it replies to the close frame when replyClose is set
+type peerSocket struct {
+	replyClose bool
+	frames     []frame
+	closed     chan struct{}
+	once       sync.Once
+	mutex      sync.Mutex
+}
+
+func newPeerSocket(replyClose bool) *peerSocket {
+	return &peerSocket{replyClose: replyClose, closed: make(chan struct{})}
+}
+
+func (m *peerSocket) ReadMessage() (int, []byte, error) {
+	<-m.closed
+	return 0, nil, errors.New("closed")
+}
+
+func (m *peerSocket) WriteMessage(messageType int, data []byte) error {
+	m.mutex.Lock()
+	m.frames = append(m.frames, frame{messageType, data})
+	m.mutex.Unlock()
+
+	if messageType == websocket.CloseMessage && m.replyClose {
+		m.Close()
+	}
+	return nil
+}
+
+func (m *peerSocket) WriteJSON(v interface{}) error {
+	return nil
+}
+
+func (m *peerSocket) Close() error {
+	m.once.Do(func() { close(m.closed) })
+	return nil
+}
+
+func Test_ws_Shutdown(t *testing.T) {
+	tests := map[string]struct {
+		replyClose bool
+		wantErr    error
+	}{
+		"devices reply to the close frame": {
+			replyClose: true,
+		},
+		"devices are closed after the deadline": {
+			replyClose: false,
+			wantErr:    context.DeadlineExceeded,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			socket := newPeerSocket(tt.replyClose)
+			s := &ws{
+				clients: map[string]map[string]*Conn{
+					"user-1": {
+						"user-1-device": {Socket: socket, DeviceID: "user-1-device"},
+					},
+				},
+				mutex: sync.RWMutex{},
+				log:   logger.NewLogger(),
+			}
+			done := make(chan struct{})
+			go func() {
+				s.HandleEvent(&gin.Context{}, User{ID: "user-1", DeviceID: "user-1-device"}, func(*gin.Context, any) error {
+					return nil
+				})
+				close(done)
+			}()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+			err := s.Shutdown(ctx)
+			<-done
+
+			assert.Equal(t, tt.wantErr, err)
+			require.NotEmpty(t, socket.frames)
+			assert.Equal(t, frame{
+				messageType: websocket.CloseMessage,
+				data:        websocket.FormatCloseMessage(websocket.CloseGoingAway, reconnectReason),
+			}, socket.frames[0])
+			assert.True(t, s.isEmpty())
+
+			// new connections are refused
+			_, err = s.HandleConnection(&gin.Context{})
+			assert.Equal(t, model.ErrServerShuttingDown, err)
+		})
+	}
+}
+
+func Test_sse_Shutdown(t *testing.T) {
+	closeChannel := make(chan bool)
+	w := &TestResponseRecorder{
+		httptest.NewRecorder(),
+		closeChannel,
+	}
+	ginCtx := testutil.NewRequest(w, testutil.MethodGet, nil, nil, nil, nil)
+
+	s := &sse{
+		clients:  make(map[string]map[string]*SSEConn),
+		shutdown: make(chan struct{}),
+	}
+	u, err := s.HandleConnection(ginCtx)
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		s.HandleEvent(ginCtx, *u, func(*gin.Context, any) error {
+			return nil
+		})
+		close(done)
+	}()
+	// SendMessage blocks until the stream received the message
+	require.NoError(t, s.SendMessage(u.ID, "test message"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	err = s.Shutdown(ctx)
+	<-done
+
+	require.NoError(t, err)
+	assert.Equal(t, "event:message\ndata:test message\n\nevent:reconnect\ndata:{\"version\":1,\"type\":\"reconnect\"}\n\n", w.Body.String())
+
+	// new connections are refused
+	_, err = s.HandleConnection(ginCtx)
+	assert.Equal(t, model.ErrServerShuttingDown, err)
+}
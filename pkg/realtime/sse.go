@@ -1,12 +1,16 @@
 package realtime
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
 	"io"
-	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/logger"
 	"github.com/dwarvesf/go-api/pkg/middleware"
 	"github.com/dwarvesf/go-api/pkg/model"
 	"github.com/gin-gonic/gin"
@@ -23,65 +27,92 @@ func SSEHeadersMiddleware() gin.HandlerFunc {
 	}
 }
 
+// sseBufferSize is the number of messages waiting for a stream, the messages
+// sent while it's full are dropped so a stalled stream never blocks the senders
+const sseBufferSize = 64
+
 // SSEConn represents a SSE connection.
 type SSEConn struct {
-	Channel chan string
-	ID      string
+	Channel     chan string
+	ID          string
+	IsGuest     bool
+	IP          string
+	ConnectedAt time.Time
+
+	// done is closed when the device disconnects, Channel is never closed
+	// as the senders may still hold the connection
+	done chan struct{}
 }
 
 type sse struct {
-	clients sync.Map
+	clients map[string]map[string]*SSEConn
+	mutex   sync.RWMutex
 	authMw  middleware.AuthMiddleware
+	tickets TicketStore
+	offline OfflineQueue
+	limiter *connLimiter
+	log     logger.Log
+
+	// shutdown is closed when the server shuts down to end every stream
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
+	closing      atomic.Bool
 }
 
 // NewSSE creates a new SSE server.
-func NewSSE(authMw middleware.AuthMiddleware) Server {
+// The offline queue is optional, undeliverable messages are dropped when it is nil.
+func NewSSE(cfg config.Config, authMw middleware.AuthMiddleware, tickets TicketStore, offline OfflineQueue, l logger.Log) Server {
 	return &sse{
-		clients: sync.Map{},
-		authMw:  authMw,
+		clients:  make(map[string]map[string]*SSEConn),
+		authMw:   authMw,
+		tickets:  tickets,
+		offline:  offline,
+		limiter:  newConnLimiter(LimitsFromConfig(cfg)),
+		log:      l,
+		shutdown: make(chan struct{}),
 	}
 }
 
 func (s *sse) HandleConnection(c *gin.Context) (*User, error) {
-	// Create a channel for sending SSE data
-	messageChannel := make(chan string)
-	var device *SSEConn
-	var userID string
-	jwtClaims, err := s.authMw.Authenticate(c)
+	if s.closing.Load() {
+		return nil, model.ErrServerShuttingDown
+	}
+
+	userID, isGuest, err := identify(c, s.authMw, s.tickets)
 	if err != nil {
-		if !errors.Is(err, model.ErrNoAuthHeader) {
-			return nil, err
-		}
-		if errors.Is(err, model.ErrNoAuthHeader) {
-			userID = PrefixGuest + generateRandomID()
-			device = &SSEConn{
-				Channel: messageChannel,
-				ID:      userID,
-			}
-		}
-	} else {
-		uID, err := middleware.UserIDFromJWTClaims(jwtClaims)
-		if err != nil {
-			return nil, err
-		}
-		userID = PrefixUser + strconv.Itoa(uID)
-		device = &SSEConn{
-			Channel: messageChannel,
-			ID:      userID + "-" + generateRandomID(),
-		}
+		return nil, err
 	}
 
-	// Register the client's channel for SSE updates
-	clientArr, ok := s.clients.Load(userID)
-	if !ok {
-		clientArr = make(map[string]*SSEConn, 0)
+	ip := c.ClientIP()
+	if err := s.limiter.acquire(userID, ip, isGuest); err != nil {
+		// end the stream with an error event, the client can't read the status of an EventSource
+		body, _ := json.Marshal(errorMessage(Message{}, err))
+		c.SSEvent("error", string(body))
+		c.Writer.Flush()
+		return nil, err
 	}
-	clientArrData, ok := clientArr.(map[string]*SSEConn)
-	if !ok {
-		clientArrData = make(map[string]*SSEConn, 0)
+
+	// Create a channel for sending SSE data
+	device := &SSEConn{
+		Channel:     make(chan string, sseBufferSize),
+		ID:          userID,
+		IsGuest:     isGuest,
+		IP:          ip,
+		ConnectedAt: time.Now(),
+		done:        make(chan struct{}),
 	}
-	clientArrData[device.ID] = device
-	s.clients.Store(userID, clientArrData)
+	if !isGuest {
+		device.ID = userID + "-" + generateRandomID()
+	}
+
+	// Register the client's channel for SSE updates
+	s.mutex.Lock()
+	if _, found := s.clients[userID]; !found {
+		s.clients[userID] = make(map[string]*SSEConn, 0)
+	}
+	s.clients[userID][device.ID] = device
+	s.mutex.Unlock()
+	connectionsGauge.WithLabelValues(serverSSE, connType(isGuest)).Inc()
 
 	user := &User{
 		ID:       userID,
@@ -92,94 +123,207 @@ func (s *sse) HandleConnection(c *gin.Context) (*User, error) {
 }
 
 func (s *sse) HandleEvent(c *gin.Context, u User, callback func(*gin.Context, any) error) {
-	val, ok := s.clients.Load(u.ID)
-	if !ok {
-		return
-	}
-	clientChArr, ok := val.(map[string]*SSEConn)
+	s.mutex.RLock()
+	clientCh, ok := s.clients[u.ID][u.DeviceID]
+	s.mutex.RUnlock()
 	if !ok {
 		return
 	}
 
-	var clientCh *SSEConn
-	for _, ch := range clientChArr {
-		if ch.ID == u.DeviceID {
-			clientCh = ch
-			break
+	s.flushOffline(c, u)
+
+	// Stream message to client from message channel until either side closes
+	ctx := c.Request.Context()
+	clientGone := c.Writer.CloseNotify()
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case msg := <-clientCh.Channel:
+			c.SSEvent("message", msg)
+			countSent(msg)
+			return true
+		case <-clientCh.done:
+			return false
+		case <-s.shutdown:
+			s.drain(c, clientCh)
+			return false
+		case <-clientGone:
+			return false
+		case <-ctx.Done():
+			return false
 		}
+	})
+
+	s.DisconnectUser(u)
+
+	// the device can't be found by the senders anymore, the messages left are never sent
+	if n := len(clientCh.Channel); n > 0 {
+		queueDepthGauge.WithLabelValues(serverSSE).Sub(float64(n))
+		droppedCounter.WithLabelValues(serverSSE, reasonDisconnected).Add(float64(n))
 	}
+}
 
-	finished := make(chan bool)
+// send queues the message of the device without blocking, the message is
+// dropped when the device disconnected or its buffer is full.
+func (s *sse) send(clientCh *SSEConn, msg string) {
+	select {
+	case <-clientCh.done:
+		droppedCounter.WithLabelValues(serverSSE, reasonDisconnected).Inc()
+		return
+	default:
+	}
 
-	go func() {
-		close(finished)
-		disconnected := c.Stream(func(w io.Writer) bool {
-			// Stream message to client from message channel
-			if msg, ok := <-clientCh.Channel; ok {
-				c.SSEvent("message", msg)
-				return true
-			}
-			return false
-		})
+	select {
+	case clientCh.Channel <- msg:
+		queueDepthGauge.WithLabelValues(serverSSE).Inc()
+	default:
+		droppedCounter.WithLabelValues(serverSSE, reasonBufferFull).Inc()
+	}
+}
 
-		if disconnected {
-			s.DisconnectUser(u)
+// countSent records a message taken from the buffer and written to a stream.
+func countSent(msg string) {
+	queueDepthGauge.WithLabelValues(serverSSE).Dec()
+	messagesOutCounter.WithLabelValues(serverSSE).Inc()
+	bytesOutCounter.WithLabelValues(serverSSE).Add(float64(len(msg)))
+}
+
+// drain sends the messages already waiting for the device then asks it to reconnect.
+func (s *sse) drain(c *gin.Context, clientCh *SSEConn) {
+	for {
+		select {
+		case msg := <-clientCh.Channel:
+			c.SSEvent("message", msg)
+			countSent(msg)
+		default:
+			body, _ := json.Marshal(Message{Version: ProtocolVersion, Type: TypeReconnect})
+			c.SSEvent(TypeReconnect, string(body))
+			return
 		}
-	}()
-	<-finished
+	}
 }
+
 func (s *sse) SendMessage(userID string, message string) error {
-	val, ok := s.clients.Load(userID)
-	if !ok {
-		return ErrClientNotFound
-	}
-	clientChArr, ok := val.(map[string]*SSEConn)
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	clientChArr, ok := s.clients[userID]
 	if !ok {
 		return ErrClientNotFound
 	}
 
 	for _, clientCh := range clientChArr {
-		clientCh.Channel <- message
+		s.send(clientCh, message)
 	}
 
 	return nil
 }
 
+// flushOffline sends the queued messages of the user to the new device in order.
+// SSE clients acknowledge them through the HTTP API as the stream is one-way.
+func (s *sse) flushOffline(c *gin.Context, u User) {
+	if s.offline == nil {
+		return
+	}
+
+	msgs, err := s.offline.Pending(c.Request.Context(), u.ID)
+	if err != nil {
+		s.log.Error(err, "failed to get offline messages")
+		return
+	}
+
+	for _, msg := range msgs {
+		body, err := json.Marshal(msg)
+		if err != nil {
+			s.log.Error(err, "failed to encode offline message")
+			return
+		}
+		c.SSEvent("message", string(body))
+	}
+	c.Writer.Flush()
+}
+
+// SendData sends data to all devices of a SSE user, the data is queued
+// when the offline queue is enabled and the user has no connected device.
 func (s *sse) SendData(userID string, data any) error {
 	body, err := json.Marshal(data)
 	if err != nil {
 		return err
 	}
 
-	val, ok := s.clients.Load(userID)
-	if !ok {
+	s.mutex.RLock()
+	clientChArr := s.clients[userID]
+	if len(clientChArr) == 0 {
+		s.mutex.RUnlock()
+		if s.offline != nil && strings.HasPrefix(userID, PrefixUser) {
+			return s.offline.Push(context.Background(), userID, data)
+		}
+		droppedCounter.WithLabelValues(serverSSE, reasonNoDevice).Inc()
 		return ErrClientNotFound
 	}
-	clientChArr, ok := val.(map[string]*SSEConn)
+	defer s.mutex.RUnlock()
+
+	for _, clientCh := range clientChArr {
+		s.send(clientCh, string(body))
+	}
+
+	return nil
+}
+
+// SendConnected sends data to the connected devices of a SSE user, it's
+// never queued. It returns the number of devices the data was sent to.
+func (s *sse) SendConnected(userID string, data any) (int, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, clientCh := range s.clients[userID] {
+		s.send(clientCh, string(body))
+	}
+
+	return len(s.clients[userID]), nil
+}
+
+func (s *sse) SendDeviceData(u User, data any) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	clientChArr, ok := s.clients[u.ID]
 	if !ok {
 		return ErrClientNotFound
 	}
 
-	for _, clientCh := range clientChArr {
-		clientCh.Channel <- string(body)
+	clientCh, ok := clientChArr[u.DeviceID]
+	if !ok {
+		return ErrDeviceNotFound
 	}
+	s.send(clientCh, string(body))
 
 	return nil
 }
 
+// Request isn't supported as SSE clients can't answer through the stream.
+func (s *sse) Request(ctx context.Context, u User, method string, params any) (json.RawMessage, error) {
+	return nil, ErrRequestNotSupported
+}
+
 func (s *sse) BroadcastMessage(message string) error {
-	s.clients.Range(func(key, value any) bool {
-		clientChArr, ok := value.(map[string]*SSEConn)
-		if !ok {
-			return true
-		}
-		for i := range clientChArr {
-			go func(key string) {
-				clientChArr[key].Channel <- message
-			}(i)
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, clientChArr := range s.clients {
+		for _, clientCh := range clientChArr {
+			s.send(clientCh, message)
 		}
-		return true
-	})
+	}
 
 	return nil
 }
@@ -190,26 +334,25 @@ func (s *sse) BroadcastData(data any) error {
 		return err
 	}
 
-	s.clients.Range(func(key, value any) bool {
-		clientChArr, ok := value.(map[string]*SSEConn)
-		if !ok {
-			return true
-		}
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, clientChArr := range s.clients {
 		for _, clientCh := range clientChArr {
-			clientCh.Channel <- string(body)
+			s.send(clientCh, string(body))
 		}
-		return true
-	})
+	}
 
 	return nil
 }
 
+// DisconnectUser ends the stream of the device, the senders holding the
+// connection see it's done instead of sending on a closed channel.
 func (s *sse) DisconnectUser(u User) error {
-	val, ok := s.clients.Load(u.ID)
-	if !ok {
-		return nil
-	}
-	clientChArr, ok := val.(map[string]*SSEConn)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	clientChArr, ok := s.clients[u.ID]
 	if !ok {
 		return nil
 	}
@@ -219,9 +362,61 @@ func (s *sse) DisconnectUser(u User) error {
 		return nil
 	}
 
-	close(clientCh.Channel)
+	close(clientCh.done)
 	delete(clientChArr, u.DeviceID)
+	if len(clientChArr) == 0 {
+		delete(s.clients, u.ID)
+	}
+	s.limiter.release(u.ID, clientCh.IP, clientCh.IsGuest)
+	connectionsGauge.WithLabelValues(serverSSE, connType(clientCh.IsGuest)).Dec()
 
-	s.clients.Store(u.ID, clientChArr)
 	return nil
 }
+
+// Shutdown stops accepting connections, ends every stream with a reconnect event
+// and waits for the streams to end until the context is done.
+func (s *sse) Shutdown(ctx context.Context) error {
+	s.closing.Store(true)
+	s.shutdownOnce.Do(func() {
+		if s.shutdown != nil {
+			close(s.shutdown)
+		}
+	})
+
+	return waitUntil(ctx, s.isEmpty)
+}
+
+// isEmpty reports whether no device is connected.
+func (s *sse) isEmpty() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, clientChArr := range s.clients {
+		if len(clientChArr) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Connections lists the connected devices.
+func (s *sse) Connections() []ConnectionInfo {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	rs := []ConnectionInfo{}
+	for userID, clientChArr := range s.clients {
+		for _, clientCh := range clientChArr {
+			rs = append(rs, ConnectionInfo{
+				UserID:      userID,
+				DeviceID:    clientCh.ID,
+				IsGuest:     clientCh.IsGuest,
+				IP:          clientCh.IP,
+				ConnectedAt: clientCh.ConnectedAt,
+			})
+		}
+	}
+
+	return rs
+}
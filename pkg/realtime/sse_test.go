@@ -77,7 +77,7 @@ func TestHandleConnection(t *testing.T) {
 			}, nil, nil, nil)
 
 			s := &sse{
-				clients: sync.Map{},
+				clients: make(map[string]map[string]*SSEConn),
 				authMw:  authMw,
 			}
 
@@ -89,12 +89,23 @@ func TestHandleConnection(t *testing.T) {
 			}
 
 			if !tc.wantErr {
-				s.HandleEvent(ginCtx, *u, func(*gin.Context, any) error {
-					return nil
-				})
-				s.BroadcastMessage("test message")
+				done := make(chan struct{})
+				go func() {
+					s.HandleEvent(ginCtx, *u, func(*gin.Context, any) error {
+						return nil
+					})
+					close(done)
+				}()
+				s.SendMessage(u.ID, "test message")
+				// the message is written by the stream once it's taken from the buffer
+				require.Eventually(t, func() bool {
+					s.mutex.RLock()
+					defer s.mutex.RUnlock()
+					return len(s.clients[u.ID][u.DeviceID].Channel) == 0
+				}, time.Second, time.Millisecond)
 				closeChannel <- true
 				close(w.closeChannel)
+				<-done
 				require.Equal(t, tc.message, w.Body.String())
 			}
 		})
@@ -103,30 +114,27 @@ func TestHandleConnection(t *testing.T) {
 
 func Test_sse_BroadcastMessage(t *testing.T) {
 	// Create a new SSE server
-	s := &sse{
-		clients: sync.Map{},
-	}
-
 	// Register a client
 	clientID := "client1"
-	messageChannel := make(chan string)
-
-	s.clients.Store(clientID, map[string]*SSEConn{
-		clientID: {
-			Channel: messageChannel,
-			ID:      clientID,
+	messageChannel := make(chan string, 1)
+	s := &sse{
+		clients: map[string]map[string]*SSEConn{
+			clientID: {
+				clientID: {
+					Channel: messageChannel,
+					ID:      clientID,
+				},
+			},
 		},
-	})
+	}
 
 	message := "test message"
 
-	go func() {
-		// Broadcast a message
-		err := s.BroadcastMessage(message)
-		if err != nil {
-			t.Errorf("Unexpected error: %v", err)
-		}
-	}()
+	// Broadcast a message
+	err := s.BroadcastMessage(message)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 
 	// Check that the message was received
 	receivedMessage := <-messageChannel
@@ -137,18 +145,18 @@ func Test_sse_BroadcastMessage(t *testing.T) {
 }
 
 func Test_sse_SendMessage(t *testing.T) {
-	s := &sse{
-		clients: sync.Map{},
-	}
-
 	// Create a dummy client
 	dummyClient := make(chan string, 1)
-	s.clients.Store("user1", map[string]*SSEConn{
-		"user1-client1": {
-			Channel: dummyClient,
-			ID:      "user1-client1",
+	s := &sse{
+		clients: map[string]map[string]*SSEConn{
+			"user1": {
+				"user1-client1": {
+					Channel: dummyClient,
+					ID:      "user1-client1",
+				},
+			},
 		},
-	})
+	}
 
 	tests := map[string]struct {
 		userID  string
@@ -199,17 +207,18 @@ func Test_sse_SendData(t *testing.T) {
 		Name string `json:"name,omitempty"`
 		Age  int    `json:"age,omitempty"`
 	}
-	s := &sse{
-		clients: sync.Map{},
-	}
 	// Create a dummy client
 	dummyClient := make(chan string, 1)
-	s.clients.Store("user1", map[string]*SSEConn{
-		"user1-client1": {
-			Channel: dummyClient,
-			ID:      "user1-client1",
+	s := &sse{
+		clients: map[string]map[string]*SSEConn{
+			"user1": {
+				"user1-client1": {
+					Channel: dummyClient,
+					ID:      "user1-client1",
+				},
+			},
 		},
-	})
+	}
 
 	tests := map[string]struct {
 		userID  string
@@ -268,17 +277,18 @@ func Test_sse_BroadcastData(t *testing.T) {
 		Name string `json:"name,omitempty"`
 		Age  int    `json:"age,omitempty"`
 	}
-	s := &sse{
-		clients: sync.Map{},
-	}
 	// Create a dummy client
 	dummyClient := make(chan string, 1)
-	s.clients.Store("user1", map[string]*SSEConn{
-		"user1-client1": {
-			Channel: dummyClient,
-			ID:      "user1-client1",
+	s := &sse{
+		clients: map[string]map[string]*SSEConn{
+			"user1": {
+				"user1-client1": {
+					Channel: dummyClient,
+					ID:      "user1-client1",
+				},
+			},
 		},
-	})
+	}
 
 	tests := map[string]struct {
 		data    any
@@ -323,3 +333,49 @@ func Test_sse_BroadcastData(t *testing.T) {
 		})
 	}
 }
+
+func Test_sse_send(t *testing.T) {
+	s := &sse{}
+	device := &SSEConn{Channel: make(chan string, 1), done: make(chan struct{})}
+
+	// the message sent while the buffer is full is dropped instead of blocking
+	s.send(device, "first")
+	s.send(device, "dropped")
+	require.Len(t, device.Channel, 1)
+	require.Equal(t, "first", <-device.Channel)
+
+	// nothing is queued once the device disconnected
+	close(device.done)
+	s.send(device, "after disconnect")
+	require.Empty(t, device.Channel)
+}
+
+func Test_sse_DisconnectUser_whileSending(t *testing.T) {
+	u := User{ID: "user-1", DeviceID: "user-1-a"}
+	s := &sse{
+		clients: map[string]map[string]*SSEConn{
+			u.ID: {
+				u.DeviceID: {Channel: make(chan string, sseBufferSize), ID: u.DeviceID, done: make(chan struct{})},
+			},
+		},
+		limiter: newConnLimiter(Limits{}),
+	}
+
+	// the senders never panic on a disconnected device, whatever the interleaving
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_ = s.SendData(u.ID, j)
+				_ = s.BroadcastData(j)
+			}
+		}()
+	}
+	require.NoError(t, s.DisconnectUser(u))
+	wg.Wait()
+
+	require.Empty(t, s.Connections())
+	require.Equal(t, ErrClientNotFound, s.SendData(u.ID, "hello"))
+}
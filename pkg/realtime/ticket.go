@@ -0,0 +1,102 @@
+package realtime
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/realtimeticket"
+	"github.com/dwarvesf/go-api/pkg/util"
+)
+
+const (
+	// DefaultTicketTTL is the default lifetime of a connection ticket
+	DefaultTicketTTL = 30 * time.Second
+
+	// ticketLength is the length of the ticket value
+	ticketLength = 32
+)
+
+// Ticket represents a short-lived one-time ticket used to authenticate
+// connections from browsers, which can't set the Authorization header
+type Ticket struct {
+	Value     string
+	UserID    int
+	ExpiresAt time.Time
+}
+
+// TicketStore issues and redeems connection tickets
+type TicketStore interface {
+	Issue(ctx context.Context, userID int) (*Ticket, error)
+	Redeem(ctx context.Context, value string) (int, error)
+}
+
+type ticketStore struct {
+	repo realtimeticket.Repo
+	ttl  time.Duration
+	now  func() time.Time
+}
+
+// NewTicketStore creates a ticket store persisted by the repository, so a ticket
+// issued by a replica can be redeemed by any other one.
+func NewTicketStore(repo realtimeticket.Repo, ttl time.Duration) TicketStore {
+	return &ticketStore{
+		repo: repo,
+		ttl:  ttl,
+		now:  time.Now,
+	}
+}
+
+// Issue issues a new ticket for the user.
+func (s *ticketStore) Issue(ctx context.Context, userID int) (*Ticket, error) {
+	dbCtx := db.FromContext(ctx)
+
+	// remove the expired tickets which were never redeemed
+	now := s.now()
+	if err := s.repo.DeleteExpired(dbCtx, now); err != nil {
+		return nil, err
+	}
+
+	t := Ticket{
+		Value:     util.RandomString(ticketLength),
+		UserID:    userID,
+		ExpiresAt: now.Add(s.ttl),
+	}
+	err := s.repo.Create(dbCtx, model.RealtimeTicket{
+		TokenHash: hashTicket(t.Value),
+		UserID:    t.UserID,
+		ExpiresAt: t.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// Redeem consumes the ticket and returns the user ID it was issued for.
+func (s *ticketStore) Redeem(ctx context.Context, value string) (int, error) {
+	t, err := s.repo.Take(db.FromContext(ctx), hashTicket(value))
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return 0, model.ErrInvalidToken
+		}
+		return 0, err
+	}
+
+	if !s.now().Before(t.ExpiresAt) {
+		return 0, model.ErrInvalidToken
+	}
+
+	return t.UserID, nil
+}
+
+// hashTicket returns the hash stored instead of the ticket, a leaked row can't open a connection.
+func hashTicket(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,89 @@
+package realtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mocks "github.com/dwarvesf/go-api/mocks/pkg/repository/realtimeticket"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ticketStore_Issue(t *testing.T) {
+	_, err := db.Init(config.LoadTestConfig())
+	require.NoError(t, err)
+
+	now := time.Now()
+	repoMock := mocks.NewRepo(t)
+	repoMock.EXPECT().DeleteExpired(mock.Anything, now).Return(nil)
+
+	var stored model.RealtimeTicket
+	repoMock.EXPECT().Create(mock.Anything, mock.Anything).
+		Run(func(_ db.Context, t model.RealtimeTicket) { stored = t }).
+		Return(nil)
+
+	s := &ticketStore{repo: repoMock, ttl: DefaultTicketTTL, now: func() time.Time { return now }}
+	got, err := s.Issue(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, got.UserID)
+	require.Equal(t, now.Add(DefaultTicketTTL), got.ExpiresAt)
+	require.Len(t, got.Value, ticketLength)
+
+	// the ticket itself is never stored
+	require.Equal(t, model.RealtimeTicket{
+		TokenHash: hashTicket(got.Value),
+		UserID:    1,
+		ExpiresAt: got.ExpiresAt,
+	}, stored)
+	require.NotEqual(t, got.Value, stored.TokenHash)
+}
+
+func Test_ticketStore_Redeem(t *testing.T) {
+	_, err := db.Init(config.LoadTestConfig())
+	require.NoError(t, err)
+
+	now := time.Now()
+	tests := map[string]struct {
+		ticket  *model.RealtimeTicket
+		takeErr error
+		want    int
+		wantErr error
+	}{
+		"success": {
+			ticket: &model.RealtimeTicket{UserID: 1, ExpiresAt: now.Add(time.Second)},
+			want:   1,
+		},
+		"expired": {
+			ticket:  &model.RealtimeTicket{UserID: 1, ExpiresAt: now},
+			wantErr: model.ErrInvalidToken,
+		},
+		"unknown or already redeemed": {
+			takeErr: model.ErrNotFound,
+			wantErr: model.ErrInvalidToken,
+		},
+		"failed to take the ticket": {
+			takeErr: errors.New("failed"),
+			wantErr: errors.New("failed"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			repoMock := mocks.NewRepo(t)
+			repoMock.EXPECT().Take(mock.Anything, hashTicket("ticket")).Return(tc.ticket, tc.takeErr)
+
+			s := &ticketStore{repo: repoMock, ttl: DefaultTicketTTL, now: func() time.Time { return now }}
+			got, err := s.Redeem(context.Background(), "ticket")
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
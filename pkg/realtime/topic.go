@@ -0,0 +1,131 @@
+package realtime
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// TypeSubscribe is the message type subscribing the device to the channel of the message
+	TypeSubscribe = "subscribe"
+
+	// TypeUnsubscribe is the message type unsubscribing the device from the channel of the message
+	TypeUnsubscribe = "unsubscribe"
+)
+
+// Topics keeps the channels the devices are subscribed to
+type Topics struct {
+	// channels maps the channel to the devices subscribed to it
+	channels map[string]map[string]User
+	// devices maps the device to the channels it's subscribed to
+	devices map[string]map[string]struct{}
+	mutex   sync.RWMutex
+}
+
+// NewTopics creates an empty topic registry.
+func NewTopics() *Topics {
+	return &Topics{
+		channels: make(map[string]map[string]User),
+		devices:  make(map[string]map[string]struct{}),
+	}
+}
+
+// Subscribe subscribes the device to the channel.
+func (t *Topics) Subscribe(u User, channel string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if _, ok := t.channels[channel]; !ok {
+		t.channels[channel] = make(map[string]User)
+	}
+	t.channels[channel][u.DeviceID] = u
+
+	if _, ok := t.devices[u.DeviceID]; !ok {
+		t.devices[u.DeviceID] = make(map[string]struct{})
+	}
+	t.devices[u.DeviceID][channel] = struct{}{}
+}
+
+// Unsubscribe unsubscribes the device from the channel.
+func (t *Topics) Unsubscribe(u User, channel string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.unsubscribe(u.DeviceID, channel)
+}
+
+// RemoveDevice unsubscribes the disconnected device from every channel.
+func (t *Topics) RemoveDevice(u User) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for channel := range t.devices[u.DeviceID] {
+		t.unsubscribe(u.DeviceID, channel)
+	}
+}
+
+func (t *Topics) unsubscribe(deviceID string, channel string) {
+	delete(t.channels[channel], deviceID)
+	if len(t.channels[channel]) == 0 {
+		delete(t.channels, channel)
+	}
+
+	delete(t.devices[deviceID], channel)
+	if len(t.devices[deviceID]) == 0 {
+		delete(t.devices, deviceID)
+	}
+}
+
+// Subscriptions returns the channels the device is subscribed to in alphabetical order.
+func (t *Topics) Subscriptions(deviceID string) []string {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	rs := make([]string, 0, len(t.devices[deviceID]))
+	for channel := range t.devices[deviceID] {
+		rs = append(rs, channel)
+	}
+	sort.Strings(rs)
+
+	return rs
+}
+
+// Subscribers returns the devices subscribed to the channel.
+func (t *Topics) Subscribers(channel string) []User {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	rs := make([]User, 0, len(t.channels[channel]))
+	for _, u := range t.channels[channel] {
+		rs = append(rs, u)
+	}
+
+	return rs
+}
+
+// HandleSubscribe returns the router handler of TypeSubscribe.
+func HandleSubscribe(t *Topics) HandlerFunc {
+	return func(c *gin.Context, u User, msg Message) (any, error) {
+		if msg.Channel == "" {
+			return nil, fmt.Errorf("%w: channel is required", ErrInvalidMessage)
+		}
+
+		t.Subscribe(u, msg.Channel)
+		return nil, nil
+	}
+}
+
+// HandleUnsubscribe returns the router handler of TypeUnsubscribe.
+func HandleUnsubscribe(t *Topics) HandlerFunc {
+	return func(c *gin.Context, u User, msg Message) (any, error) {
+		if msg.Channel == "" {
+			return nil, fmt.Errorf("%w: channel is required", ErrInvalidMessage)
+		}
+
+		t.Unsubscribe(u, msg.Channel)
+		return nil, nil
+	}
+}
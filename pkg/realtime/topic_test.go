@@ -0,0 +1,58 @@
+package realtime
+
+import (
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopics(t *testing.T) {
+	a := User{ID: "user-1", DeviceID: "user-1-a"}
+	b := User{ID: "user-1", DeviceID: "user-1-b"}
+
+	topics := NewTopics()
+	topics.Subscribe(a, "users")
+	topics.Subscribe(a, "campaigns")
+	topics.Subscribe(b, "campaigns")
+
+	assert.Equal(t, []string{"campaigns", "users"}, topics.Subscriptions(a.DeviceID))
+	assert.ElementsMatch(t, []User{a, b}, topics.Subscribers("campaigns"))
+
+	topics.Unsubscribe(a, "users")
+	assert.Equal(t, []string{"campaigns"}, topics.Subscriptions(a.DeviceID))
+	assert.Empty(t, topics.Subscribers("users"))
+
+	// the disconnected device leaves every channel
+	topics.RemoveDevice(a)
+	assert.Empty(t, topics.Subscriptions(a.DeviceID))
+	assert.Equal(t, []User{b}, topics.Subscribers("campaigns"))
+
+	topics.RemoveDevice(b)
+	assert.Empty(t, topics.channels)
+	assert.Empty(t, topics.devices)
+}
+
+func TestHandleSubscribe(t *testing.T) {
+	u := User{ID: "user-1", DeviceID: "user-1-a"}
+	topics := NewTopics()
+
+	r := NewRouter()
+	r.Handle(TypeSubscribe, HandleSubscribe(topics))
+	r.Handle(TypeUnsubscribe, HandleUnsubscribe(topics))
+
+	reply := r.Dispatch(&gin.Context{}, u, []byte(`{"version":1,"type":"subscribe","id":"1","channel":"campaigns","ack":true}`))
+	require.NotNil(t, reply)
+	assert.Equal(t, TypeAck, reply.Type)
+	assert.Equal(t, []string{"campaigns"}, topics.Subscriptions(u.DeviceID))
+
+	reply = r.Dispatch(&gin.Context{}, u, []byte(`{"version":1,"type":"subscribe","id":"2","ack":true}`))
+	require.NotNil(t, reply)
+	assert.Equal(t, TypeError, reply.Type)
+	assert.Equal(t, "INVALID_MESSAGE", reply.Error.Code)
+
+	reply = r.Dispatch(&gin.Context{}, u, []byte(`{"version":1,"type":"unsubscribe","channel":"campaigns"}`))
+	assert.Nil(t, reply)
+	assert.Empty(t, topics.Subscriptions(u.DeviceID))
+}
@@ -1,15 +1,30 @@
 package realtime
 
 import (
-	"strconv"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/dwarvesf/go-api/pkg/logger"
 	"github.com/dwarvesf/go-api/pkg/middleware"
 	"github.com/dwarvesf/go-api/pkg/model"
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
-	"github.com/pkg/errors"
+)
+
+const (
+	// writeTimeout is the deadline of a write to a WebSocket connection
+	writeTimeout = 10 * time.Second
+
+	// wsQueueSize is the number of frames waiting for the writer of a connection, the
+	// frames sent while it's full are dropped so a stalled device never blocks the senders
+	wsQueueSize = 64
 )
 
 // Socket represents a WebSocket connection
@@ -20,63 +35,249 @@ type Socket interface {
 	Close() error
 }
 
-var upgrader = websocket.Upgrader{
-	ReadBufferSize:  1024,
-	WriteBufferSize: 1024,
-}
-
 // Conn represents a WebSocket connection
 type Conn struct {
 	Socket
 	DeviceID    string
 	IsGuest     bool
+	IP          string
+	ConnectedAt time.Time
 	Permissions []string
+
+	codec      codec
+	limiter    *tokenBucket
+	writeMutex sync.Mutex
+	calls      pendingCalls
+
+	// queue holds the frames written by the writer goroutine, done is closed
+	// when the device disconnects. A connection without queue writes in place.
+	queue chan wsFrame
+	done  chan struct{}
+}
+
+// wsFrame is a frame waiting for the writer of a connection
+type wsFrame struct {
+	messageType int
+	data        []byte
 }
 
 type ws struct {
-	clients map[string]map[string]*Conn
-	mutex   sync.RWMutex
-	authMw  middleware.AuthMiddleware
-	log     logger.Log
+	clients  map[string]map[string]*Conn
+	mutex    sync.RWMutex
+	authMw   middleware.AuthMiddleware
+	tickets  TicketStore
+	offline  OfflineQueue
+	limits   Limits
+	limiter  *connLimiter
+	upgrader websocket.Upgrader
+	closing  atomic.Bool
+	log      logger.Log
 }
 
-// HandleConnection handles WebSocket connections and user authentication.
-func (s *ws) HandleConnection(c *gin.Context) (*User, error) {
-	r := c.Request
-	w := c.Writer
-	conn, err := upgrader.Upgrade(w, r, nil)
-	if err != nil {
-		return nil, err
+// checkOrigin returns the origin check of the upgrader. Requests without the
+// Origin header don't come from a browser, so they are always allowed.
+func checkOrigin(allowedOrigins string) func(r *http.Request) bool {
+	var origins []string
+	for _, o := range strings.Split(allowedOrigins, ",") {
+		if o = strings.TrimSpace(o); o != "" {
+			origins = append(origins, o)
+		}
 	}
 
-	var device *Conn
-	var userID string
-	jwtClaims, err := s.authMw.Authenticate(c)
-	if err != nil {
-		if !errors.Is(err, model.ErrNoAuthHeader) {
-			return nil, err
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
 		}
 
-		if errors.Is(err, model.ErrNoAuthHeader) {
-			userID = PrefixGuest + generateRandomID()
-			device = &Conn{
-				DeviceID: userID,
-				IsGuest:  true,
-				Socket:   conn,
+		for _, o := range origins {
+			if o == "*" || strings.EqualFold(o, origin) {
+				return true
 			}
 		}
-	} else {
-		uID, err := middleware.UserIDFromJWTClaims(jwtClaims)
-		if err != nil {
-			return nil, err
+
+		return false
+	}
+}
+
+// closeFrame returns the close frame matching the violation.
+func closeFrame(err error) []byte {
+	return websocket.FormatCloseMessage(closeCode(err), err.Error())
+}
+
+// closeWithError sends the close frame matching the violation then closes the socket.
+func closeWithError(socket Socket, err error) {
+	_ = socket.WriteMessage(websocket.CloseMessage, closeFrame(err))
+	socket.Close()
+}
+
+// writeMessage writes a frame to the socket, the writes of a connection are serialized.
+// A write taking longer than writeTimeout fails and breaks the connection.
+func (c *Conn) writeMessage(messageType int, data []byte) error {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+
+	if d, ok := c.Socket.(interface{ SetWriteDeadline(time.Time) error }); ok {
+		_ = d.SetWriteDeadline(time.Now().Add(writeTimeout))
+	}
+	if err := c.Socket.WriteMessage(messageType, data); err != nil {
+		droppedCounter.WithLabelValues(serverWS, reasonWriteFailed).Inc()
+		return err
+	}
+
+	if messageType == websocket.TextMessage || messageType == websocket.BinaryMessage {
+		messagesOutCounter.WithLabelValues(serverWS).Inc()
+		bytesOutCounter.WithLabelValues(serverWS).Add(float64(len(data)))
+	}
+
+	return nil
+}
+
+// writeData writes the data encoded by the codec of the connection.
+func (c *Conn) writeData(data any) error {
+	messageType, body, err := c.getCodec().encode(data)
+	if err != nil {
+		return err
+	}
+
+	return c.writeMessage(messageType, body)
+}
+
+// startWriter starts the goroutine writing the queued frames until the device disconnects.
+func (c *Conn) startWriter() {
+	c.queue = make(chan wsFrame, wsQueueSize)
+	c.done = make(chan struct{})
+	go c.writeLoop()
+}
+
+func (c *Conn) writeLoop() {
+	depth := queueDepthGauge.WithLabelValues(serverWS)
+	for {
+		select {
+		case f := <-c.queue:
+			depth.Dec()
+			if err := c.writeMessage(f.messageType, f.data); err != nil {
+				// the read of HandleEvent fails and disconnects the device
+				c.Close()
+			}
+		case <-c.done:
+			// the device can't be found by the senders anymore, the frames queued
+			// before the disconnection are flushed then the socket is closed
+			c.flush()
+			c.Close()
+			return
+		}
+	}
+}
+
+// flush writes the frames left in the queue, they are dropped once a write fails.
+func (c *Conn) flush() {
+	depth := queueDepthGauge.WithLabelValues(serverWS)
+	for {
+		select {
+		case f := <-c.queue:
+			depth.Dec()
+			if err := c.writeMessage(f.messageType, f.data); err != nil {
+				if n := len(c.queue); n > 0 {
+					depth.Sub(float64(n))
+					droppedCounter.WithLabelValues(serverWS, reasonDisconnected).Add(float64(n))
+				}
+				return
+			}
+		default:
+			return
 		}
-		userID = PrefixUser + strconv.Itoa(uID)
-		device = &Conn{
-			DeviceID: userID + "-" + generateRandomID(),
-			Socket:   conn,
+	}
+}
+
+// send queues the frame for the writer without blocking, the frame is dropped
+// when the device disconnected or its queue is full.
+func (c *Conn) send(messageType int, data []byte) error {
+	if c.queue == nil {
+		return c.writeMessage(messageType, data)
+	}
+
+	select {
+	case <-c.done:
+		droppedCounter.WithLabelValues(serverWS, reasonDisconnected).Inc()
+		return nil
+	default:
+	}
+
+	select {
+	case c.queue <- wsFrame{messageType: messageType, data: data}:
+		queueDepthGauge.WithLabelValues(serverWS).Inc()
+	default:
+		droppedCounter.WithLabelValues(serverWS, reasonBufferFull).Inc()
+	}
+
+	return nil
+}
+
+// sendData queues the data encoded by the codec of the connection.
+func (c *Conn) sendData(data any) error {
+	messageType, body, err := c.getCodec().encode(data)
+	if err != nil {
+		return err
+	}
+
+	return c.send(messageType, body)
+}
+
+// getCodec returns the codec of the connection, JSON when none was negotiated.
+func (c *Conn) getCodec() codec {
+	if c.codec == nil {
+		return jsonCodec{}
+	}
+	return c.codec
+}
+
+// HandleConnection authenticates the user then upgrades the connection to WebSocket.
+func (s *ws) HandleConnection(c *gin.Context) (*User, error) {
+	if s.closing.Load() {
+		return nil, model.ErrServerShuttingDown
+	}
+
+	userID, isGuest, err := identify(c, s.authMw, s.tickets)
+	if err != nil {
+		return nil, err
+	}
+
+	// the connection is upgraded even when the limit is reached,
+	// so the client receives the close code of the violation
+	ip := c.ClientIP()
+	limitErr := s.limiter.acquire(userID, ip, isGuest)
+
+	conn, err := s.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		if limitErr == nil {
+			s.limiter.release(userID, ip, isGuest)
 		}
+		return nil, err
+	}
+	if limitErr != nil {
+		closeWithError(conn, limitErr)
+		return nil, limitErr
+	}
+	if s.limits.MaxMessageSize > 0 {
+		// the close frame with code 1009 is sent by the connection itself
+		conn.SetReadLimit(s.limits.MaxMessageSize)
 	}
 
+	device := &Conn{
+		DeviceID:    userID,
+		IsGuest:     isGuest,
+		IP:          ip,
+		ConnectedAt: time.Now(),
+		Socket:      conn,
+		codec:       codecFor(conn.Subprotocol()),
+		limiter:     newTokenBucket(s.limits.MessageRate, s.limits.MessageBurst),
+	}
+	if !isGuest {
+		device.DeviceID = userID + "-" + generateRandomID()
+	}
+	device.startWriter()
+
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
@@ -85,6 +286,7 @@ func (s *ws) HandleConnection(c *gin.Context) (*User, error) {
 		s.clients[userID] = make(map[string]*Conn, 0)
 	}
 	s.clients[userID][device.DeviceID] = device
+	connectionsGauge.WithLabelValues(serverWS, connType(isGuest)).Inc()
 
 	return &User{
 		ID:       userID,
@@ -109,12 +311,39 @@ func (s *ws) HandleEvent(c *gin.Context, u User, callback func(*gin.Context, any
 
 	defer s.DisconnectUser(u)
 
+	s.flushOffline(c, u, conn)
+
 	for {
-		_, message, err := conn.ReadMessage()
+		messageType, message, err := conn.ReadMessage()
 		if err != nil {
+			if errors.Is(err, websocket.ErrReadLimit) {
+				droppedCounter.WithLabelValues(serverWS, reasonTooLarge).Inc()
+				err = ErrMessageTooLarge
+			}
 			s.log.Error(err)
 			return
 		}
+		messagesInCounter.WithLabelValues(serverWS).Inc()
+		bytesInCounter.WithLabelValues(serverWS).Add(float64(len(message)))
+
+		if !conn.limiter.allow() {
+			droppedCounter.WithLabelValues(serverWS, reasonRateLimited).Inc()
+			s.log.Error(ErrRateLimited, "closing connection of user "+u.ID)
+			_ = conn.writeMessage(websocket.CloseMessage, closeFrame(ErrRateLimited))
+			conn.Close()
+			return
+		}
+
+		message, err = conn.getCodec().decode(messageType, message)
+		if err != nil {
+			_ = conn.writeData(errorMessage(Message{}, fmt.Errorf("%w: %v", ErrInvalidMessage, err)))
+			continue
+		}
+
+		// the answers of the requests sent by the server don't go through the callback
+		if msg, err := ParseMessage(message); err == nil && conn.calls.resolve(msg) {
+			continue
+		}
 
 		err = callback(c, message)
 		if err != nil {
@@ -135,7 +364,7 @@ func (s *ws) SendMessage(userID string, message string) error {
 	}
 
 	for deviceKey := range devices {
-		err := devices[deviceKey].Socket.WriteMessage(websocket.TextMessage, []byte(message))
+		err := devices[deviceKey].send(websocket.TextMessage, []byte(message))
 		if err != nil {
 			return err
 		}
@@ -144,18 +373,23 @@ func (s *ws) SendMessage(userID string, message string) error {
 	return nil
 }
 
-// SendData sends data to all devices of a WebSocket user.
+// SendData sends data to all devices of a WebSocket user, the data is queued
+// when the offline queue is enabled and the user has no connected device.
 func (s *ws) SendData(userID string, data any) error {
 	s.mutex.RLock()
-	defer s.mutex.RUnlock()
-
-	devices, found := s.clients[userID]
-	if !found {
+	devices := s.clients[userID]
+	if len(devices) == 0 {
+		s.mutex.RUnlock()
+		if s.offline != nil && strings.HasPrefix(userID, PrefixUser) {
+			return s.offline.Push(context.Background(), userID, data)
+		}
+		droppedCounter.WithLabelValues(serverWS, reasonNoDevice).Inc()
 		return ErrUserNotFound
 	}
+	defer s.mutex.RUnlock()
 
 	for deviceKey := range devices {
-		err := devices[deviceKey].Socket.WriteJSON(data)
+		err := devices[deviceKey].sendData(data)
 		if err != nil {
 			return err
 		}
@@ -164,6 +398,83 @@ func (s *ws) SendData(userID string, data any) error {
 	return nil
 }
 
+// SendConnected sends data to the connected devices of a WebSocket user, it's never
+// queued offline. It returns the number of devices the data was sent to.
+func (s *ws) SendConnected(userID string, data any) (int, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	sent := 0
+	var err error
+	for _, device := range s.clients[userID] {
+		if werr := device.sendData(data); werr != nil {
+			err = werr
+			continue
+		}
+		sent++
+	}
+
+	return sent, err
+}
+
+// flushOffline sends the queued messages of the user to the new device in order.
+func (s *ws) flushOffline(c *gin.Context, u User, conn *Conn) {
+	if s.offline == nil {
+		return
+	}
+
+	msgs, err := s.offline.Pending(c.Request.Context(), u.ID)
+	if err != nil {
+		s.log.Error(err, "failed to get offline messages")
+		return
+	}
+
+	for _, msg := range msgs {
+		if err := conn.writeData(msg); err != nil {
+			s.log.Error(err, "failed to send offline message")
+			return
+		}
+	}
+}
+
+// SendDeviceData sends data to a single device of a WebSocket user.
+func (s *ws) SendDeviceData(u User, data any) error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	devices, found := s.clients[u.ID]
+	if !found {
+		return ErrUserNotFound
+	}
+
+	device, found := devices[u.DeviceID]
+	if !found {
+		return ErrDeviceNotFound
+	}
+
+	return device.sendData(data)
+}
+
+// Request sends the method to a device and waits for the answer, it fails
+// when the context is done or the device disconnects before answering.
+// The answer is read by HandleEvent, so it must not be called from the
+// callback handling the messages of the same device.
+func (s *ws) Request(ctx context.Context, u User, method string, params any) (json.RawMessage, error) {
+	s.mutex.RLock()
+	devices, found := s.clients[u.ID]
+	if !found {
+		s.mutex.RUnlock()
+		return nil, ErrUserNotFound
+	}
+	device, found := devices[u.DeviceID]
+	s.mutex.RUnlock()
+	if !found {
+		return nil, ErrDeviceNotFound
+	}
+
+	return device.request(ctx, method, params)
+}
+
 // BroadcastMessage sends a message to all devices of all WebSocket users.
 func (s *ws) BroadcastMessage(message string) error {
 	s.mutex.RLock()
@@ -172,7 +483,7 @@ func (s *ws) BroadcastMessage(message string) error {
 	for userKey := range s.clients {
 		devices := s.clients[userKey]
 		for deviceKey := range devices {
-			err := devices[deviceKey].Socket.WriteMessage(websocket.TextMessage, []byte(message))
+			err := devices[deviceKey].send(websocket.TextMessage, []byte(message))
 			if err != nil {
 				return err
 			}
@@ -190,7 +501,7 @@ func (s *ws) BroadcastData(data any) error {
 	for userKey := range s.clients {
 		devices := s.clients[userKey]
 		for deviceKey := range devices {
-			err := devices[deviceKey].Socket.WriteJSON(data)
+			err := devices[deviceKey].sendData(data)
 			if err != nil {
 				return err
 			}
@@ -214,8 +525,94 @@ func (s *ws) DisconnectUser(u User) error {
 		return ErrDeviceNotFound
 	}
 
-	device.Close()
+	device.calls.close()
+	if device.done != nil {
+		// the writer closes the socket once the queued frames are written
+		close(device.done)
+	} else {
+		device.Close()
+	}
 	delete(devices, u.DeviceID)
+	s.limiter.release(u.ID, device.IP, device.IsGuest)
+	connectionsGauge.WithLabelValues(serverWS, connType(device.IsGuest)).Dec()
 	s.clients[u.ID] = devices
 	return nil
 }
+
+// Connections lists the connected devices.
+func (s *ws) Connections() []ConnectionInfo {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	rs := []ConnectionInfo{}
+	for userID, devices := range s.clients {
+		for _, device := range devices {
+			rs = append(rs, ConnectionInfo{
+				UserID:      userID,
+				DeviceID:    device.DeviceID,
+				IsGuest:     device.IsGuest,
+				IP:          device.IP,
+				ConnectedAt: device.ConnectedAt,
+			})
+		}
+	}
+
+	return rs
+}
+
+// Shutdown stops accepting connections and asks every device to reconnect with
+// the close code 1001, the devices still connected when the context is done are closed.
+func (s *ws) Shutdown(ctx context.Context) error {
+	s.closing.Store(true)
+
+	// the frames are written outside the lock, a stalled device doesn't hold the disconnections
+	for _, device := range s.devices() {
+		err := device.writeMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, reconnectReason))
+		if err != nil {
+			s.log.Error(err, "failed to send close frame to device "+device.DeviceID)
+		}
+	}
+
+	// the devices are removed by HandleEvent once they reply to the close frame
+	err := waitUntil(ctx, s.isEmpty)
+	if err != nil {
+		s.mutex.RLock()
+		for _, devices := range s.clients {
+			for _, device := range devices {
+				device.Close()
+			}
+		}
+		s.mutex.RUnlock()
+	}
+
+	return err
+}
+
+// devices returns the connected devices.
+func (s *ws) devices() []*Conn {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	var rs []*Conn
+	for _, devices := range s.clients {
+		for _, device := range devices {
+			rs = append(rs, device)
+		}
+	}
+
+	return rs
+}
+
+// isEmpty reports whether no device is connected.
+func (s *ws) isEmpty() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, devices := range s.clients {
+		if len(devices) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -2,13 +2,17 @@ package realtime
 
 import (
 	"encoding/json"
+	"net/http"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/dwarvesf/go-api/pkg/logger"
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type mockSocket struct {
@@ -177,6 +181,68 @@ func Test_ws_SendData(t *testing.T) {
 	}
 }
 
+func Test_ws_SendDeviceData(t *testing.T) {
+	s := &ws{
+		clients: make(map[string]map[string]*Conn),
+		mutex:   sync.RWMutex{},
+	}
+
+	// Create two devices of the same user
+	mockConnection := &mockSocket{}
+	otherConnection := &mockSocket{}
+	s.clients["user1"] = map[string]*Conn{
+		"user1-client1": {
+			Socket:   mockConnection,
+			DeviceID: "user1-client1",
+		},
+		"user1-client2": {
+			Socket:   otherConnection,
+			DeviceID: "user1-client2",
+		},
+	}
+
+	tests := map[string]struct {
+		user    User
+		data    any
+		wantErr bool
+		message string
+	}{
+		"success": {
+			user:    User{ID: "user1", DeviceID: "user1-client1"},
+			data:    map[string]string{"name": "user1"},
+			message: `{"name":"user1"}`,
+		},
+		"user not found": {
+			user:    User{ID: "user2", DeviceID: "user2-client1"},
+			data:    map[string]string{"name": "user2"},
+			wantErr: true,
+		},
+		"device not found": {
+			user:    User{ID: "user1", DeviceID: "user1-client3"},
+			data:    map[string]string{"name": "user1"},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockConnection.Clear()
+			otherConnection.Clear()
+			err := s.SendDeviceData(tc.user, tc.data)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("%v case: SendDeviceData() error = %v, wantErr %v", name, err, tc.wantErr)
+			}
+
+			if string(mockConnection.content) != tc.message {
+				t.Errorf("%v case: expected message %q but got %q", name, tc.message, string(mockConnection.content))
+			}
+			if len(otherConnection.content) != 0 {
+				t.Errorf("%v case: expected no message on other device but got %q", name, string(otherConnection.content))
+			}
+		})
+	}
+}
+
 func Test_ws_BroadcastData(t *testing.T) {
 	type testStruct struct {
 		Name string `json:"name,omitempty"`
@@ -287,3 +353,141 @@ func Test_ws_HandleEvent(t *testing.T) {
 		})
 	}
 }
+
+func Test_checkOrigin(t *testing.T) {
+	tests := map[string]struct {
+		allowedOrigins string
+		origin         string
+		want           bool
+	}{
+		"no origin header": {
+			allowedOrigins: "https://app.d.foundation",
+			want:           true,
+		},
+		"allow all": {
+			allowedOrigins: "*",
+			origin:         "https://evil.com",
+			want:           true,
+		},
+		"allowed origin": {
+			allowedOrigins: "https://app.d.foundation, https://admin.d.foundation",
+			origin:         "https://admin.d.foundation",
+			want:           true,
+		},
+		"not allowed origin": {
+			allowedOrigins: "https://app.d.foundation",
+			origin:         "https://evil.com",
+			want:           false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &http.Request{Header: make(http.Header)}
+			if tc.origin != "" {
+				r.Header.Set("Origin", tc.origin)
+			}
+
+			assert.Equal(t, tc.want, checkOrigin(tc.allowedOrigins)(r))
+		})
+	}
+}
+
+// stalledSocket blocks every write until it's released
+type stalledSocket struct {
+	mockSocket
+	release chan struct{}
+}
+
+func (m *stalledSocket) WriteMessage(messageType int, data []byte) error {
+	<-m.release
+	return nil
+}
+
+func Test_ws_SendConnected_stalledDevice(t *testing.T) {
+	u := User{ID: "user-1", DeviceID: "user-1-a"}
+	socket := &stalledSocket{release: make(chan struct{})}
+	device := &Conn{Socket: socket, DeviceID: u.DeviceID}
+	device.startWriter()
+	s := &ws{
+		clients: map[string]map[string]*Conn{u.ID: {u.DeviceID: device}},
+		limiter: newConnLimiter(Limits{}),
+	}
+
+	// the writer holds the first frame, the others fill the queue then are dropped
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < wsQueueSize*2; i++ {
+			n, err := s.SendConnected(u.ID, i)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, n)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("SendConnected blocked on a stalled device")
+	}
+	require.LessOrEqual(t, len(device.queue), wsQueueSize)
+
+	// nothing is queued once the device disconnected
+	require.NoError(t, s.DisconnectUser(u))
+	close(socket.release)
+	queued := len(device.queue)
+	require.NoError(t, device.send(websocket.TextMessage, []byte("after disconnect")))
+	require.LessOrEqual(t, len(device.queue), queued)
+}
+
+// recordingSocket records the frames written before it's closed
+type recordingSocket struct {
+	mockSocket
+	release chan struct{}
+	mutex   sync.Mutex
+	frames  []string
+	closed  bool
+}
+
+func (m *recordingSocket) WriteMessage(messageType int, data []byte) error {
+	<-m.release
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.closed {
+		return websocket.ErrCloseSent
+	}
+	m.frames = append(m.frames, string(data))
+	return nil
+}
+
+func (m *recordingSocket) Close() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.closed = true
+	return nil
+}
+
+func Test_ws_DisconnectUser_flushesQueue(t *testing.T) {
+	u := User{ID: "user-1", DeviceID: "user-1-a"}
+	socket := &recordingSocket{release: make(chan struct{})}
+	device := &Conn{Socket: socket, DeviceID: u.DeviceID}
+	device.startWriter()
+	s := &ws{
+		clients: map[string]map[string]*Conn{u.ID: {u.DeviceID: device}},
+		limiter: newConnLimiter(Limits{}),
+	}
+
+	// the frames queued before the disconnection are written then the socket is closed
+	require.NoError(t, device.send(websocket.TextMessage, []byte("first")))
+	require.NoError(t, device.send(websocket.TextMessage, []byte("revoked")))
+	require.NoError(t, s.DisconnectUser(u))
+	close(socket.release)
+
+	require.Eventually(t, func() bool {
+		socket.mutex.Lock()
+		defer socket.mutex.Unlock()
+		return socket.closed
+	}, time.Second, 10*time.Millisecond)
+	socket.mutex.Lock()
+	defer socket.mutex.Unlock()
+	require.Equal(t, []string{"first", "revoked"}, socket.frames)
+}
@@ -2,6 +2,10 @@ package base
 
 import (
 	"context"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/dwarvesf/go-api/pkg/model"
 	"github.com/dwarvesf/go-api/pkg/repository/db"
@@ -10,6 +14,9 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
+// defaultIDColumn is the column breaking the ties of the sort in cursor mode
+const defaultIDColumn = "id"
+
 // Counable represent the countable interface
 type Counable interface {
 	Count(ctx context.Context, exec boil.ContextExecutor) (int64, error)
@@ -21,6 +28,17 @@ type GetListFuncSet[OrmModel any, Model any] struct {
 	CounableFn     func([]qm.QueryMod) Counable
 	QueryListFn    func([]qm.QueryMod) ([]*OrmModel, error)
 	MappingFn      func(o *OrmModel) *Model
+
+	// Fields are the fields the list can be sorted and filtered by, the others are rejected
+	Fields util.Fields
+	// IDColumn is the unique column breaking the ties of the sort in cursor mode, id by default
+	IDColumn string
+
+	// RankFn returns the expression ranking the rows by relevance to the query in search mode,
+	// PrepareQueryFn filters the matching rows. The lists without it don't support search mode.
+	RankFn func(q model.ListQuery) (string, []interface{})
+	// HighlightFn returns the fields of the row with the terms of the query highlighted
+	HighlightFn func(o *OrmModel, q model.ListQuery) map[string]string
 }
 
 // GetList return the list of model
@@ -34,14 +52,41 @@ func GetList[OrmModel any, Model any](
 	// 3. query list
 	// 4. mapping the result to the response model
 
-	// prepare query and calculate pagination
-	ormParams := fns.PrepareQueryFn(ctx, q)
-	var count int64
-	c := fns.CounableFn(ormParams)
-	count, err := c.Count(ctx.Context, ctx.DB)
+	// the sort and the filters only reach the query through the whitelisted fields
+	sortFields, err := fns.Fields.Sort(q.Sort)
+	if err != nil {
+		return nil, err
+	}
+	filters, err := fns.Fields.Filter(q.Filters)
 	if err != nil {
 		return nil, err
 	}
+	orderBy := qm.OrderBy(util.OrderBy(sortFields))
+
+	// the search results are ordered by relevance first, which isn't a column the cursors could hold
+	searching := q.Search != nil && q.Query != ""
+	if searching {
+		if fns.RankFn == nil {
+			return nil, model.ErrInvalidSearch
+		}
+		if q.CursorMode {
+			return nil, model.NewError(model.ErrInvalidSearch.Status, model.ErrInvalidSearch.Code,
+				"search results can't be paginated by cursor")
+		}
+		rank, args := fns.RankFn(q)
+		orderBy = qm.OrderBy(rank+" desc, "+util.OrderBy(sortFields), args...)
+	}
+
+	// prepare query and calculate pagination
+	ormParams := append(fns.PrepareQueryFn(ctx, q), filters...)
+	var count int64
+	if !q.SkipCount {
+		c := fns.CounableFn(ormParams)
+		count, err = c.Count(ctx.Context, ctx.DB)
+		if err != nil {
+			return nil, err
+		}
+	}
 	pagination, err := util.CalculatePagination(int(count), q.Page, q.PageSize)
 	if err != nil {
 		return nil, err
@@ -50,30 +95,218 @@ func GetList[OrmModel any, Model any](
 	pagination.Sort = nomalizedSort
 
 	// query list
-	queryParams := make([]qm.QueryMod, 0, len(ormParams))
-	copy(queryParams, ormParams)
-	queryParams = append(queryParams,
-		qm.OrderBy(nomalizedSort),
-		qm.Limit(pagination.PageSize),
-		qm.Offset(pagination.Offset),
-	)
-
-	dt, err := fns.QueryListFn(queryParams)
+	var dt []*OrmModel
+	if q.CursorMode {
+		dt, err = queryListByCursor(q, fns, ormParams, sortFields, pagination)
+	} else {
+		dt, err = queryListByOffset(q, fns, ormParams, orderBy, pagination)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	// mapping to model
-	var result []Model
+	highlight := searching && q.Search.Highlight && fns.HighlightFn != nil
+	var (
+		result     []Model
+		highlights []map[string]string
+	)
 	for _, d := range dt {
 		itm := fns.MappingFn(d)
 		if itm != nil {
 			result = append(result, *itm)
+			if highlight {
+				highlights = append(highlights, fns.HighlightFn(d, q))
+			}
 		}
 	}
 
 	return &model.ListResult[Model]{
 		Data:       result,
 		Pagination: *pagination,
+		Highlights: highlights,
 	}, nil
 }
+
+// queryListByOffset queries the page of the pagination, without the count it looks one row ahead to know if there is a next page.
+func queryListByOffset[OrmModel any, Model any](
+	q model.ListQuery,
+	fns GetListFuncSet[OrmModel, Model],
+	ormParams []qm.QueryMod,
+	orderBy qm.QueryMod,
+	pagination *model.Pagination) ([]*OrmModel, error) {
+	limit := pagination.PageSize
+	if q.SkipCount {
+		limit++
+	}
+	queryParams := append(append([]qm.QueryMod{}, ormParams...),
+		orderBy,
+		qm.Limit(limit),
+		qm.Offset(pagination.Offset),
+	)
+
+	dt, err := fns.QueryListFn(queryParams)
+	if err != nil {
+		return nil, err
+	}
+	if q.SkipCount && len(dt) > pagination.PageSize {
+		pagination.HasNext = true
+		dt = dt[:pagination.PageSize]
+	}
+
+	return dt, nil
+}
+
+// queryListByCursor queries the page after the cursor, or before it for the cursor of a previous page.
+// The rows are compared by the sort columns then the ID column, so the sort columns must not be null.
+func queryListByCursor[OrmModel any, Model any](
+	q model.ListQuery,
+	fns GetListFuncSet[OrmModel, Model],
+	ormParams []qm.QueryMod,
+	sortFields []util.SortField,
+	pagination *model.Pagination) ([]*OrmModel, error) {
+	pagination.Page = 0
+	pagination.Offset = 0
+
+	idColumn := fns.IDColumn
+	if idColumn == "" {
+		idColumn = defaultIDColumn
+	}
+	keyFields := sortFields
+	tieBreak := true
+	for _, f := range sortFields {
+		if columnName(f.Column) == columnName(idColumn) {
+			tieBreak = false
+		}
+	}
+	if tieBreak {
+		tie := util.SortField{Column: idColumn}
+		if len(sortFields) > 0 {
+			tie.Desc = sortFields[len(sortFields)-1].Desc
+		}
+		keyFields = append(keyFields, tie)
+	}
+
+	var cursor *util.Cursor
+	if q.Cursor != "" {
+		var err error
+		cursor, err = util.DecodeCursor(q.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if cursor.Sort != pagination.Sort || len(cursor.Values) != len(sortFields) {
+			return nil, model.ErrInvalidCursor
+		}
+	}
+
+	// a previous page is queried in the reverse order then reversed back
+	backward := cursor != nil && cursor.Prev
+	queryFields := keyFields
+	if backward {
+		queryFields = make([]util.SortField, 0, len(keyFields))
+		for _, f := range keyFields {
+			queryFields = append(queryFields, util.SortField{Column: f.Column, Desc: !f.Desc})
+		}
+	}
+
+	queryParams := append([]qm.QueryMod{}, ormParams...)
+	if cursor != nil {
+		values := cursor.Values
+		if tieBreak {
+			values = append(values, cursor.ID)
+		}
+		where, args := util.KeysetWhere(queryFields, values)
+		queryParams = append(queryParams, qm.Where(where, args...))
+	}
+	queryParams = append(queryParams,
+		qm.OrderBy(util.OrderBy(queryFields)),
+		qm.Limit(pagination.PageSize+1),
+	)
+
+	dt, err := fns.QueryListFn(queryParams)
+	if err != nil {
+		return nil, err
+	}
+	more := len(dt) > pagination.PageSize
+	if more {
+		dt = dt[:pagination.PageSize]
+	}
+	if backward {
+		for i, j := 0, len(dt)-1; i < j; i, j = i+1, j-1 {
+			dt[i], dt[j] = dt[j], dt[i]
+		}
+	}
+	if len(dt) == 0 {
+		return dt, nil
+	}
+
+	// a page reached backward always has a next page, the one of the cursor
+	hasNext, hasPrev := more, cursor != nil
+	if backward {
+		hasNext, hasPrev = true, more
+	}
+	pagination.HasNext = hasNext
+	if hasNext {
+		if pagination.NextCursor, err = encodeCursor(dt[len(dt)-1], pagination.Sort, sortFields, idColumn, false); err != nil {
+			return nil, err
+		}
+	}
+	if hasPrev {
+		if pagination.PrevCursor, err = encodeCursor(dt[0], pagination.Sort, sortFields, idColumn, true); err != nil {
+			return nil, err
+		}
+	}
+
+	return dt, nil
+}
+
+// encodeCursor returns the cursor of the row
+func encodeCursor(o any, sort string, fields []util.SortField, idColumn string, prev bool) (string, error) {
+	c := util.Cursor{
+		Sort:   sort,
+		Values: make([]any, 0, len(fields)),
+		Prev:   prev,
+	}
+	for _, f := range fields {
+		v, err := columnValue(o, f.Column)
+		if err != nil {
+			return "", err
+		}
+		c.Values = append(c.Values, v)
+	}
+	id, err := columnValue(o, idColumn)
+	if err != nil {
+		return "", err
+	}
+	c.ID = id
+
+	return util.EncodeCursor(c)
+}
+
+// columnValue returns the value of the column of a row generated by sqlboiler, whose fields are tagged with their column.
+func columnValue(o any, column string) (any, error) {
+	column = columnName(column)
+	v := reflect.Indirect(reflect.ValueOf(o))
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("boil"), ",")
+		if name != column {
+			continue
+		}
+		val := v.Field(i).Interface()
+		if valuer, ok := val.(driver.Valuer); ok {
+			return valuer.Value()
+		}
+		return val, nil
+	}
+
+	return nil, fmt.Errorf("base: %T has no column %s", o, column)
+}
+
+// columnName returns the column without its table
+func columnName(column string) string {
+	if i := strings.LastIndex(column, "."); i >= 0 {
+		return column[i+1:]
+	}
+	return column
+}
@@ -8,13 +8,17 @@ import (
 
 	"github.com/dwarvesf/go-api/pkg/model"
 	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/util"
+	"github.com/stretchr/testify/require"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type ormUser struct {
-	ID   int
-	Name string
+	ID   int    `boil:"id"`
+	Name string `boil:"name"`
 }
 
 type modelUser struct {
@@ -34,6 +38,11 @@ func (c counable) Count(ctx context.Context, exec boil.ContextExecutor) (int64,
 	return c.Value, nil
 }
 
+var userFields = util.Fields{
+	"id":   {Column: "id", Type: util.FieldTypeInt, Sortable: true, Filterable: true},
+	"name": {Column: "name", Sortable: true, Filterable: true},
+}
+
 func mapping(o *ormUser) *modelUser {
 	return &modelUser{
 		ID:   o.ID,
@@ -77,6 +86,7 @@ func TestGetList(t *testing.T) {
 						}, nil
 					},
 					MappingFn: mapping,
+					Fields:    userFields,
 				},
 			},
 			want: &model.ListResult[modelUser]{
@@ -118,6 +128,7 @@ func TestGetList(t *testing.T) {
 						return []*ormUser{}, nil
 					},
 					MappingFn: mapping,
+					Fields:    userFields,
 				},
 			},
 			want: &model.ListResult[modelUser]{
@@ -129,6 +140,39 @@ func TestGetList(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		"skip count": {
+			args: args{
+				ctx: db.Context{},
+				q: model.ListQuery{
+					Page:      1,
+					PageSize:  1,
+					Sort:      "name",
+					SkipCount: true,
+				},
+				fns: GetListFuncSet[ormUser, modelUser]{
+					PrepareQueryFn: func(ctx db.Context, q model.ListQuery) []qm.QueryMod {
+						return []qm.QueryMod{}
+					},
+					CounableFn: func(qm []qm.QueryMod) Counable {
+						return counable{Error: errors.New("count isn't skipped")}
+					},
+					QueryListFn: func(qm []qm.QueryMod) ([]*ormUser, error) {
+						return []*ormUser{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}}, nil
+					},
+					MappingFn: mapping,
+					Fields:    userFields,
+				},
+			},
+			want: &model.ListResult[modelUser]{
+				Data: []modelUser{{ID: 1, Name: "a"}},
+				Pagination: model.Pagination{
+					PageSize: 1,
+					Page:     1,
+					Sort:     "name",
+					HasNext:  true,
+				},
+			},
+		},
 		"query list error": {
 			args: args{
 				ctx: db.Context{},
@@ -149,6 +193,7 @@ func TestGetList(t *testing.T) {
 						return nil, errors.New("error")
 					},
 					MappingFn: mapping,
+					Fields:    userFields,
 				},
 			},
 			want:    nil,
@@ -174,6 +219,7 @@ func TestGetList(t *testing.T) {
 						return []*ormUser{}, nil
 					},
 					MappingFn: mapping,
+					Fields:    userFields,
 				},
 			},
 			want:    nil,
@@ -193,3 +239,194 @@ func TestGetList(t *testing.T) {
 		})
 	}
 }
+
+// buildQuery returns the SQL of the mods, the way orm.Users(mods...) would run it
+func buildQuery(mods []qm.QueryMod) (string, []interface{}) {
+	q := &queries.Query{}
+	queries.SetDialect(q, &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true})
+	queries.SetFrom(q, `"users"`)
+	qm.Apply(q, mods...)
+
+	return queries.BuildQuery(q)
+}
+
+func TestGetList_Cursor(t *testing.T) {
+	var (
+		gotSQL  string
+		gotArgs []interface{}
+		rows    []*ormUser
+	)
+	fns := GetListFuncSet[ormUser, modelUser]{
+		PrepareQueryFn: func(ctx db.Context, q model.ListQuery) []qm.QueryMod {
+			return []qm.QueryMod{qm.Where("name <> ?", "")}
+		},
+		CounableFn: func(qm []qm.QueryMod) Counable {
+			return counable{Value: 3}
+		},
+		QueryListFn: func(mods []qm.QueryMod) ([]*ormUser, error) {
+			gotSQL, gotArgs = buildQuery(mods)
+			return rows, nil
+		},
+		MappingFn: mapping,
+		Fields:    userFields,
+	}
+	list := func(cursor string) (*model.ListResult[modelUser], error) {
+		return GetList(db.Context{}, model.ListQuery{
+			PageSize:   2,
+			Sort:       "-name",
+			CursorMode: true,
+			Cursor:     cursor,
+		}, fns)
+	}
+
+	// the table holds c(3), b(2) and a(1) sorted by -name, the first page looks one row ahead
+	rows = []*ormUser{{ID: 3, Name: "c"}, {ID: 2, Name: "b"}, {ID: 1, Name: "a"}}
+	first, err := list("")
+	require.NoError(t, err)
+	require.Equal(t, `SELECT * FROM "users" WHERE (name <> $1) ORDER BY name desc, id desc LIMIT 3;`, gotSQL)
+	require.Equal(t, []modelUser{{ID: 3, Name: "c"}, {ID: 2, Name: "b"}}, first.Data)
+	require.True(t, first.Pagination.HasNext)
+	require.Equal(t, 3, first.Pagination.TotalRecords)
+	require.NotEmpty(t, first.Pagination.NextCursor)
+	require.Empty(t, first.Pagination.PrevCursor)
+
+	// the next page starts after b(2)
+	rows = []*ormUser{{ID: 1, Name: "a"}}
+	second, err := list(first.Pagination.NextCursor)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT * FROM "users" WHERE (name <> $1) AND (((name < $2) OR (name = $3 AND id < $4))) ORDER BY name desc, id desc LIMIT 3;`, gotSQL)
+	require.Equal(t, []interface{}{"", "b", "b", int64(2)}, gotArgs)
+	require.Equal(t, []modelUser{{ID: 1, Name: "a"}}, second.Data)
+	require.False(t, second.Pagination.HasNext)
+	require.Empty(t, second.Pagination.NextCursor)
+	require.NotEmpty(t, second.Pagination.PrevCursor)
+
+	// the previous page ends before a(1), it's queried in the reverse order
+	rows = []*ormUser{{ID: 2, Name: "b"}, {ID: 3, Name: "c"}}
+	prev, err := list(second.Pagination.PrevCursor)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT * FROM "users" WHERE (name <> $1) AND (((name > $2) OR (name = $3 AND id > $4))) ORDER BY name asc, id asc LIMIT 3;`, gotSQL)
+	require.Equal(t, first.Data, prev.Data)
+	require.True(t, prev.Pagination.HasNext)
+	require.Equal(t, first.Pagination.NextCursor, prev.Pagination.NextCursor)
+	require.Empty(t, prev.Pagination.PrevCursor)
+
+	// a tampered cursor or the cursor of another sort is rejected
+	_, err = list(first.Pagination.NextCursor + "x")
+	require.ErrorIs(t, err, model.ErrInvalidCursor)
+	_, err = GetList(db.Context{}, model.ListQuery{
+		PageSize:   2,
+		Sort:       "name",
+		CursorMode: true,
+		Cursor:     first.Pagination.NextCursor,
+	}, fns)
+	require.ErrorIs(t, err, model.ErrInvalidCursor)
+}
+
+func TestGetList_Fields(t *testing.T) {
+	var gotSQL string
+	fns := GetListFuncSet[ormUser, modelUser]{
+		PrepareQueryFn: func(ctx db.Context, q model.ListQuery) []qm.QueryMod {
+			return []qm.QueryMod{}
+		},
+		CounableFn: func(qm []qm.QueryMod) Counable {
+			return counable{Value: 0}
+		},
+		QueryListFn: func(mods []qm.QueryMod) ([]*ormUser, error) {
+			gotSQL, _ = buildQuery(mods)
+			return nil, nil
+		},
+		MappingFn: mapping,
+		Fields:    userFields,
+	}
+
+	tests := map[string]struct {
+		q        model.ListQuery
+		wantSQL  string
+		wantCode string
+	}{
+		"sort and filters": {
+			q: model.ListQuery{
+				Sort: "-name,+id",
+				Filters: []model.Filter{
+					{Field: "name", Operator: model.FilterOperatorLike, Value: "andy"},
+					{Field: "id", Operator: model.FilterOperatorIn, Value: "1,2"},
+				},
+			},
+			wantSQL: `SELECT * FROM "users" WHERE (name ILIKE $1) AND ("id" IN ($2,$3)) ORDER BY name desc, id asc LIMIT 10;`,
+		},
+		"unknown sort field": {
+			q:        model.ListQuery{Sort: "-password"},
+			wantCode: model.ErrInvalidSort.Code,
+		},
+		"injected sort": {
+			q:        model.ListQuery{Sort: "name; drop table users"},
+			wantCode: model.ErrInvalidSort.Code,
+		},
+		"unknown filter field": {
+			q:        model.ListQuery{Sort: "name", Filters: []model.Filter{{Field: "password", Operator: model.FilterOperatorEq, Value: "x"}}},
+			wantCode: model.ErrInvalidFilter.Code,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotSQL = ""
+			_, err := GetList(db.Context{}, tt.q, fns)
+			if tt.wantCode != "" {
+				var e model.Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, tt.wantCode, e.Code)
+				require.Empty(t, gotSQL)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSQL, gotSQL)
+		})
+	}
+}
+
+func TestGetList_Search(t *testing.T) {
+	var gotSQL string
+	fns := GetListFuncSet[ormUser, modelUser]{
+		PrepareQueryFn: func(ctx db.Context, q model.ListQuery) []qm.QueryMod {
+			return []qm.QueryMod{qm.Where("name ILIKE ?", q.Query+"%")}
+		},
+		CounableFn: func(qm []qm.QueryMod) Counable {
+			return counable{Value: 1}
+		},
+		QueryListFn: func(mods []qm.QueryMod) ([]*ormUser, error) {
+			gotSQL, _ = buildQuery(mods)
+			return []*ormUser{{ID: 1, Name: "andy"}}, nil
+		},
+		MappingFn: mapping,
+		Fields:    userFields,
+		RankFn: func(q model.ListQuery) (string, []interface{}) {
+			return "similarity(name, ?)", []interface{}{q.Query}
+		},
+		HighlightFn: func(o *ormUser, q model.ListQuery) map[string]string {
+			return map[string]string{"name": "<b>" + o.Name + "</b>"}
+		},
+	}
+
+	got, err := GetList(db.Context{}, model.ListQuery{
+		Sort:   "-id",
+		Query:  "and",
+		Search: &model.SearchOptions{Highlight: true},
+	}, fns)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT * FROM "users" WHERE (name ILIKE $1) ORDER BY similarity(name, $2) desc, id desc LIMIT 10;`, gotSQL)
+	require.Equal(t, []modelUser{{ID: 1, Name: "andy"}}, got.Data)
+	require.Equal(t, []map[string]string{{"name": "<b>andy</b>"}}, got.Highlights)
+
+	// the results are only highlighted on demand
+	got, err = GetList(db.Context{}, model.ListQuery{Query: "and", Sort: "id", Search: &model.SearchOptions{}}, fns)
+	require.NoError(t, err)
+	require.Nil(t, got.Highlights)
+
+	_, err = GetList(db.Context{}, model.ListQuery{Query: "and", Sort: "id", Search: &model.SearchOptions{}, CursorMode: true}, fns)
+	require.EqualError(t, err, "search results can't be paginated by cursor")
+
+	fns.RankFn = nil
+	_, err = GetList(db.Context{}, model.ListQuery{Query: "and", Sort: "id", Search: &model.SearchOptions{}}, fns)
+	require.ErrorIs(t, err, model.ErrInvalidSearch)
+}
@@ -0,0 +1,225 @@
+package base
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Query represent the query of a table, the queries generated by sqlboiler such as orm.Users() implement it
+type Query interface {
+	Counable
+	Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error)
+	DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error)
+	Bind(ctx context.Context, exec boil.Executor, obj interface{}) error
+}
+
+// Entity represent a row of a table, the models generated by sqlboiler such as *orm.User implement it
+type Entity interface {
+	Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error
+	Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error)
+}
+
+// Repo represent the CRUD operations of a table, a resource only declares its table and mapping functions:
+//
+//	users := base.Repo[orm.User, model.User]{
+//		Name:       "User",
+//		Tracer:     monitor,
+//		PrimaryKey: orm.UserColumns.ID,
+//		QueryFn:    func(mods ...qm.QueryMod) base.Query { return orm.Users(mods...) },
+//		MappingFn:  toUserModel,
+//		ToOrmFn:    toUserOrm,
+//	}
+type Repo[OrmModel any, Model any] struct {
+	// Name is the name of the resource in the spans
+	Name string
+	// Tracer starts the spans of the operations
+	Tracer monitor.Tracer
+	// PrimaryKey is the column of the primary key
+	PrimaryKey string
+
+	QueryFn   func(mods ...qm.QueryMod) Query
+	MappingFn func(o *OrmModel) *Model
+	ToOrmFn   func(m Model) *OrmModel
+}
+
+// GetByID returns the row of the primary key, model.ErrNotFound when it doesn't exist.
+func (r Repo[OrmModel, Model]) GetByID(ctx db.Context, id any) (*Model, error) {
+	return r.FindOne(ctx, qm.Where(r.PrimaryKey+" = ?", id))
+}
+
+// FindOne returns the first row matching the mods, model.ErrNotFound when none does.
+func (r Repo[OrmModel, Model]) FindOne(ctx db.Context, mods ...qm.QueryMod) (rs *Model, err error) {
+	ctx, span := r.start(ctx, "FindOne")
+	defer func() { endSpan(span, err) }()
+
+	var o OrmModel
+	mods = append(mods, qm.Limit(1))
+	if err := r.QueryFn(mods...).Bind(ctx, ctx.DB, &o); err != nil {
+		return nil, GetOneErrorHandler(err)
+	}
+
+	return r.MappingFn(&o), nil
+}
+
+// Create inserts the row and returns it with the values set by the database.
+func (r Repo[OrmModel, Model]) Create(ctx db.Context, m Model) (rs *Model, err error) {
+	ctx, span := r.start(ctx, "Create")
+	defer func() { endSpan(span, err) }()
+
+	return r.insert(ctx, m)
+}
+
+// Update updates the columns of the row, every column but the primary key when none is given.
+// It returns model.ErrNotFound when the row doesn't exist.
+func (r Repo[OrmModel, Model]) Update(ctx db.Context, m Model, columns ...string) (rs *Model, err error) {
+	ctx, span := r.start(ctx, "Update")
+	defer func() { endSpan(span, err) }()
+
+	o := r.ToOrmFn(m)
+	e, err := entity(o)
+	if err != nil {
+		return nil, err
+	}
+
+	cols := boil.Infer()
+	if len(columns) > 0 {
+		cols = boil.Whitelist(columns...)
+	}
+	n, err := e.Update(ctx, ctx.DB, cols)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, model.ErrNotFound
+	}
+
+	return r.MappingFn(o), nil
+}
+
+// Delete deletes the row of the primary key, model.ErrNotFound when it doesn't exist.
+func (r Repo[OrmModel, Model]) Delete(ctx db.Context, id any) (err error) {
+	ctx, span := r.start(ctx, "Delete")
+	defer func() { endSpan(span, err) }()
+
+	n, err := r.QueryFn(qm.Where(r.PrimaryKey+" = ?", id)).DeleteAll(ctx, ctx.DB)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return model.ErrNotFound
+	}
+
+	return nil
+}
+
+// Exists reports whether a row matches the mods.
+func (r Repo[OrmModel, Model]) Exists(ctx db.Context, mods ...qm.QueryMod) (rs bool, err error) {
+	ctx, span := r.start(ctx, "Exists")
+	defer func() { endSpan(span, err) }()
+
+	return r.QueryFn(mods...).Exists(ctx, ctx.DB)
+}
+
+// Count returns the number of rows matching the mods.
+func (r Repo[OrmModel, Model]) Count(ctx db.Context, mods ...qm.QueryMod) (rs int64, err error) {
+	ctx, span := r.start(ctx, "Count")
+	defer func() { endSpan(span, err) }()
+
+	return r.QueryFn(mods...).Count(ctx, ctx.DB)
+}
+
+// CreateEach inserts the rows one by one, all or nothing, in a savepoint of the transaction
+// of the context or in a new transaction when the context has none. It runs an INSERT per
+// row, it's meant for the small batches and isn't a multi-row INSERT.
+func (r Repo[OrmModel, Model]) CreateEach(ctx db.Context, ms []Model) (rs []Model, err error) {
+	ctx, span := r.start(ctx, "CreateEach")
+	defer func() { endSpan(span, err) }()
+
+	insertAll := func(ctx db.Context) error {
+		rs = make([]Model, 0, len(ms))
+		for _, m := range ms {
+			created, err := r.insert(ctx, m)
+			if err != nil {
+				return err
+			}
+			rs = append(rs, *created)
+		}
+
+		return nil
+	}
+
+	if err := db.Transaction(ctx, insertAll); err != nil {
+		return nil, err
+	}
+
+	return rs, nil
+}
+
+// DeleteAll deletes the rows matching the mods and returns the number of rows deleted.
+func (r Repo[OrmModel, Model]) DeleteAll(ctx db.Context, mods ...qm.QueryMod) (rs int64, err error) {
+	ctx, span := r.start(ctx, "DeleteAll")
+	defer func() { endSpan(span, err) }()
+
+	return r.QueryFn(mods...).DeleteAll(ctx, ctx.DB)
+}
+
+// Raw binds the result of the query into dst, it's meant for the statements the
+// operations above don't cover such as an upsert, op names the span.
+func (r Repo[OrmModel, Model]) Raw(ctx db.Context, op string, dst any, query string, args ...any) (err error) {
+	ctx, span := r.start(ctx, op)
+	defer func() { endSpan(span, err) }()
+
+	return queries.Raw(query, args...).Bind(ctx, ctx.DB, dst)
+}
+
+func (r Repo[OrmModel, Model]) insert(ctx db.Context, m Model) (*Model, error) {
+	o := r.ToOrmFn(m)
+	e, err := entity(o)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.Insert(ctx, ctx.DB, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	return r.MappingFn(o), nil
+}
+
+// start starts the span of the operation, the span is a child of the span of the controller.
+func (r Repo[OrmModel, Model]) start(ctx db.Context, op string) (db.Context, trace.Span) {
+	spanName := r.Name + "Repo." + op
+	spanCtx, span := r.Tracer.Start(ctx.Context, spanName)
+	ctx.Context = spanCtx
+
+	return ctx, span
+}
+
+// endSpan records the error of the operation, a missing row isn't a failure.
+func endSpan(span trace.Span, err error) {
+	if err != nil && !errors.Is(err, model.ErrNotFound) && !errors.Is(err, sql.ErrNoRows) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// entity returns the row as an Entity, OrmModel must be a model generated by sqlboiler.
+func entity[OrmModel any](o *OrmModel) (Entity, error) {
+	e, ok := any(o).(Entity)
+	if !ok {
+		return nil, fmt.Errorf("base: %T isn't an entity", o)
+	}
+
+	return e, nil
+}
@@ -0,0 +1,212 @@
+package base
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ormItem is a row of a fake table holding the items 1 to 10, it implements Entity like the sqlboiler models
+type ormItem struct {
+	ID   int
+	Name string
+}
+
+func (o *ormItem) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o.Name == "" {
+		return errors.New("name is required")
+	}
+	o.ID = 10 + len(o.Name)
+	return nil
+}
+
+func (o *ormItem) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if o.ID > 10 {
+		return 0, nil
+	}
+	return 1, nil
+}
+
+type modelItem struct {
+	ID   int
+	Name string
+}
+
+// fakeQuery returns the same result whatever the mods
+type fakeQuery struct {
+	row     *ormItem
+	err     error
+	count   int64
+	deleted int64
+}
+
+func (q fakeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	return q.count, q.err
+}
+
+func (q fakeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return q.count > 0, q.err
+}
+
+func (q fakeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	return q.deleted, q.err
+}
+
+func (q fakeQuery) Bind(ctx context.Context, exec boil.Executor, obj interface{}) error {
+	if q.err != nil {
+		return q.err
+	}
+	*obj.(*ormItem) = *q.row
+	return nil
+}
+
+// fakeTx is an executor in a transaction, the fake queries never use it, it only records the savepoints
+type fakeTx struct {
+	boil.ContextTransactor
+	stmts []string
+}
+
+func (tx *fakeTx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	tx.stmts = append(tx.stmts, query)
+	return nil, nil
+}
+
+// spanRecorder is a tracer recording the names of the spans it starts
+type spanRecorder struct {
+	monitor.Tracer
+	names []string
+}
+
+func (s *spanRecorder) Start(ctx context.Context, spanName string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	s.names = append(s.names, spanName)
+	return s.Tracer.Start(ctx, spanName, opts...)
+}
+
+func newItemRepo(q fakeQuery) Repo[ormItem, modelItem] {
+	return Repo[ormItem, modelItem]{
+		Name:       "Item",
+		Tracer:     monitor.TestMonitor(),
+		PrimaryKey: "id",
+		QueryFn:    func(mods ...qm.QueryMod) Query { return q },
+		MappingFn: func(o *ormItem) *modelItem {
+			return &modelItem{ID: o.ID, Name: o.Name}
+		},
+		ToOrmFn: func(m modelItem) *ormItem {
+			return &ormItem{ID: m.ID, Name: m.Name}
+		},
+	}
+}
+
+func TestRepo_FindOne(t *testing.T) {
+	tests := map[string]struct {
+		query   fakeQuery
+		want    *modelItem
+		wantErr error
+	}{
+		"found": {
+			query: fakeQuery{row: &ormItem{ID: 1, Name: "item"}},
+			want:  &modelItem{ID: 1, Name: "item"},
+		},
+		"not found": {
+			query:   fakeQuery{err: sql.ErrNoRows},
+			wantErr: model.ErrNotFound,
+		},
+		"failed": {
+			query:   fakeQuery{err: errors.New("connection refused")},
+			wantErr: errors.New("connection refused"),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := newItemRepo(tt.query).GetByID(db.Context{Context: context.Background()}, 1)
+			if tt.wantErr != nil {
+				require.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRepo_Update(t *testing.T) {
+	ctx := db.Context{Context: context.Background()}
+
+	got, err := newItemRepo(fakeQuery{}).Update(ctx, modelItem{ID: 1, Name: "renamed"}, "name")
+	require.NoError(t, err)
+	require.Equal(t, &modelItem{ID: 1, Name: "renamed"}, got)
+
+	// no row updated means the row doesn't exist
+	_, err = newItemRepo(fakeQuery{}).Update(ctx, modelItem{ID: 11, Name: "renamed"})
+	require.ErrorIs(t, err, model.ErrNotFound)
+}
+
+func TestRepo_Delete(t *testing.T) {
+	ctx := db.Context{Context: context.Background()}
+
+	require.NoError(t, newItemRepo(fakeQuery{deleted: 1}).Delete(ctx, 1))
+	require.ErrorIs(t, newItemRepo(fakeQuery{}).Delete(ctx, 1), model.ErrNotFound)
+}
+
+func TestRepo_DeleteAll(t *testing.T) {
+	ctx := db.Context{Context: context.Background()}
+
+	n, err := newItemRepo(fakeQuery{deleted: 3}).DeleteAll(ctx, qm.Where("name = ?", "item"))
+	require.NoError(t, err)
+	require.Equal(t, int64(3), n)
+}
+
+func TestRepo_spans(t *testing.T) {
+	ctx := db.Context{Context: context.Background()}
+	tracer := &spanRecorder{Tracer: monitor.TestMonitor()}
+	r := newItemRepo(fakeQuery{row: &ormItem{ID: 1, Name: "item"}})
+	r.Tracer = tracer
+
+	_, err := r.GetByID(ctx, 1)
+	require.NoError(t, err)
+	_, err = r.Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ItemRepo.FindOne", "ItemRepo.Count"}, tracer.names)
+}
+
+func TestRepo_Count(t *testing.T) {
+	ctx := db.Context{Context: context.Background()}
+	r := newItemRepo(fakeQuery{count: 2})
+
+	n, err := r.Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), n)
+
+	ok, err := r.Exists(ctx, qm.Where("name = ?", "item"))
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestRepo_CreateEach(t *testing.T) {
+	tx := &fakeTx{}
+	ctx := db.Context{Context: context.Background(), DB: tx}
+	r := newItemRepo(fakeQuery{})
+
+	got, err := r.CreateEach(ctx, []modelItem{{Name: "a"}, {Name: "bb"}})
+	require.NoError(t, err)
+	require.Equal(t, []modelItem{{ID: 11, Name: "a"}, {ID: 12, Name: "bb"}}, got)
+
+	// the rows inserted before the failure are rolled back with the savepoint
+	_, err = r.CreateEach(ctx, []modelItem{{Name: "a"}, {}})
+	require.EqualError(t, err, "name is required")
+	require.Equal(t, []string{
+		"SAVEPOINT sp_1",
+		"RELEASE SAVEPOINT sp_1",
+		"SAVEPOINT sp_1",
+		"ROLLBACK TO SAVEPOINT sp_1",
+	}, tx.stmts)
+}
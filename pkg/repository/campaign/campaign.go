@@ -0,0 +1,176 @@
+package campaign
+
+import (
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/base"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// claimDueQuery starts the scheduled campaigns whose time has come,
+// a campaign is claimed by a single dispatcher
+const claimDueQuery = `
+UPDATE campaigns SET
+	status = $1,
+	started_at = $2,
+	updated_at = $2
+WHERE status = $3 AND scheduled_at <= $2
+RETURNING *`
+
+type repo struct {
+}
+
+func (r *repo) GetList(ctx db.Context) ([]model.Campaign, error) {
+	dt, err := orm.Campaigns(qm.OrderBy(orm.CampaignColumns.ID+" DESC")).All(ctx, ctx.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCampaignModels(dt)
+}
+
+func (r *repo) GetListByStatus(ctx db.Context, status model.CampaignStatus) ([]model.Campaign, error) {
+	dt, err := orm.Campaigns(
+		orm.CampaignWhere.Status.EQ(string(status)),
+		qm.OrderBy(orm.CampaignColumns.ID+" ASC"),
+	).All(ctx, ctx.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCampaignModels(dt)
+}
+
+func (r *repo) GetByID(ctx db.Context, id int) (*model.Campaign, error) {
+	m, err := orm.FindCampaign(ctx, ctx.DB, id)
+	if err != nil {
+		return nil, base.GetOneErrorHandler(err)
+	}
+
+	return toCampaignModel(m)
+}
+
+func (r *repo) Create(ctx db.Context, req model.CampaignRequest) (*model.Campaign, error) {
+	a, err := toAudienceJSON(req.Audience)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &orm.Campaign{
+		Name:     req.Name,
+		Template: req.Template,
+		Audience: a,
+		Status:   string(model.CampaignStatusDraft),
+	}
+	if err := m.Insert(ctx, ctx.DB, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	return toCampaignModel(m)
+}
+
+// Update changes the campaign, only a draft can be changed.
+func (r *repo) Update(ctx db.Context, id int, req model.CampaignRequest) (*model.Campaign, error) {
+	a, err := toAudienceJSON(req.Audience)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.updateInStatus(ctx, id, model.CampaignStatusDraft, model.ErrCampaignNotDraft, orm.M{
+		orm.CampaignColumns.Name:      req.Name,
+		orm.CampaignColumns.Template:  req.Template,
+		orm.CampaignColumns.Audience:  a,
+		orm.CampaignColumns.UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// Schedule sets the send time of a draft.
+func (r *repo) Schedule(ctx db.Context, id int, at time.Time) error {
+	return r.updateInStatus(ctx, id, model.CampaignStatusDraft, model.ErrCampaignNotDraft, orm.M{
+		orm.CampaignColumns.Status:      string(model.CampaignStatusScheduled),
+		orm.CampaignColumns.ScheduledAt: null.TimeFrom(at),
+		orm.CampaignColumns.UpdatedAt:   time.Now(),
+	})
+}
+
+// Unschedule turns a scheduled campaign back into a draft.
+func (r *repo) Unschedule(ctx db.Context, id int) error {
+	return r.updateInStatus(ctx, id, model.CampaignStatusScheduled, model.ErrCampaignNotScheduled, orm.M{
+		orm.CampaignColumns.Status:      string(model.CampaignStatusDraft),
+		orm.CampaignColumns.ScheduledAt: null.Time{},
+		orm.CampaignColumns.UpdatedAt:   time.Now(),
+	})
+}
+
+// updateInStatus updates the campaign when it's in the given status, errStatus is returned otherwise.
+func (r *repo) updateInStatus(ctx db.Context, id int, status model.CampaignStatus, errStatus error, cols orm.M) error {
+	n, err := orm.Campaigns(
+		orm.CampaignWhere.ID.EQ(id),
+		orm.CampaignWhere.Status.EQ(string(status)),
+	).UpdateAll(ctx, ctx.DB, cols)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return nil
+	}
+
+	exists, err := orm.CampaignExists(ctx, ctx.DB, id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return model.ErrNotFound
+	}
+
+	return errStatus
+}
+
+func (r *repo) ClaimDue(ctx db.Context, now time.Time) ([]model.Campaign, error) {
+	var dt []*orm.Campaign
+	err := queries.Raw(claimDueQuery,
+		string(model.CampaignStatusSending),
+		now,
+		string(model.CampaignStatusScheduled),
+	).Bind(ctx, ctx.DB, &dt)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCampaignModels(dt)
+}
+
+func (r *repo) Finish(ctx db.Context, id int, now time.Time) error {
+	_, err := orm.Campaigns(
+		orm.CampaignWhere.ID.EQ(id),
+		orm.CampaignWhere.Status.EQ(string(model.CampaignStatusSending)),
+	).UpdateAll(ctx, ctx.DB, orm.M{
+		orm.CampaignColumns.Status:     string(model.CampaignStatusDone),
+		orm.CampaignColumns.FinishedAt: now,
+		orm.CampaignColumns.UpdatedAt:  now,
+	})
+	return err
+}
+
+// Fail marks the campaign being sent as failed, the dispatch doesn't send it again.
+func (r *repo) Fail(ctx db.Context, id int, now time.Time) error {
+	_, err := orm.Campaigns(
+		orm.CampaignWhere.ID.EQ(id),
+		orm.CampaignWhere.Status.EQ(string(model.CampaignStatusSending)),
+	).UpdateAll(ctx, ctx.DB, orm.M{
+		orm.CampaignColumns.Status:    string(model.CampaignStatusFailed),
+		orm.CampaignColumns.UpdatedAt: now,
+	})
+	return err
+}
@@ -0,0 +1,86 @@
+package campaign
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_repo_Lifecycle(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		r := &repo{}
+		from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		c, err := r.Create(ctx, model.CampaignRequest{
+			Name:     "Welcome",
+			Template: "sent_mail",
+			Audience: model.AudienceFilter{Role: model.RoleUser, CreatedFrom: &from},
+		})
+		require.NoError(t, err)
+		require.Equal(t, model.CampaignStatusDraft, c.Status)
+		require.Equal(t, model.RoleUser, c.Audience.Role)
+		require.True(t, from.Equal(*c.Audience.CreatedFrom))
+
+		c, err = r.Update(ctx, c.ID, model.CampaignRequest{Name: "Hello", Template: "sent_mail"})
+		require.NoError(t, err)
+		require.Equal(t, "Hello", c.Name)
+		require.Equal(t, model.AudienceFilter{}, c.Audience)
+
+		now := time.Now().Truncate(time.Millisecond)
+		require.NoError(t, r.Schedule(ctx, c.ID, now.Add(-time.Minute)))
+
+		// only a draft can be changed
+		_, err = r.Update(ctx, c.ID, model.CampaignRequest{Name: "Hi", Template: "sent_mail"})
+		require.ErrorIs(t, err, model.ErrCampaignNotDraft)
+		require.ErrorIs(t, r.Schedule(ctx, c.ID+1, now), model.ErrNotFound)
+
+		got, err := r.ClaimDue(ctx, now)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		require.Equal(t, model.CampaignStatusSending, got[0].Status)
+		require.True(t, now.Equal(*got[0].StartedAt))
+
+		// a claimed campaign isn't claimed again
+		got, err = r.ClaimDue(ctx, now)
+		require.NoError(t, err)
+		require.Empty(t, got)
+
+		require.NoError(t, r.Finish(ctx, c.ID, now))
+		c, err = r.GetByID(ctx, c.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.CampaignStatusDone, c.Status)
+		require.ErrorIs(t, r.Unschedule(ctx, c.ID), model.ErrCampaignNotScheduled)
+
+		// a done campaign can't fail anymore
+		require.NoError(t, r.Fail(ctx, c.ID, now))
+		c, err = r.GetByID(ctx, c.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.CampaignStatusDone, c.Status)
+	})
+}
+
+func Test_repo_Fail(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		r := &repo{}
+		now := time.Now()
+
+		c, err := r.Create(ctx, model.CampaignRequest{Name: "Welcome", Template: "sent_mail"})
+		require.NoError(t, err)
+		require.NoError(t, r.Schedule(ctx, c.ID, now.Add(-time.Minute)))
+		_, err = r.ClaimDue(ctx, now)
+		require.NoError(t, err)
+
+		// the send job died, the campaign isn't listed as being sent anymore
+		require.NoError(t, r.Fail(ctx, c.ID, now))
+		c, err = r.GetByID(ctx, c.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.CampaignStatusFailed, c.Status)
+
+		sending, err := r.GetListByStatus(ctx, model.CampaignStatusSending)
+		require.NoError(t, err)
+		require.Empty(t, sending)
+	})
+}
@@ -0,0 +1,91 @@
+package campaign
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// Repo represent the campaign
+type Repo interface {
+	GetList(ctx db.Context) ([]model.Campaign, error)
+	GetListByStatus(ctx db.Context, status model.CampaignStatus) ([]model.Campaign, error)
+	GetByID(ctx db.Context, id int) (*model.Campaign, error)
+	Create(ctx db.Context, req model.CampaignRequest) (*model.Campaign, error)
+	Update(ctx db.Context, id int, req model.CampaignRequest) (*model.Campaign, error)
+	Schedule(ctx db.Context, id int, at time.Time) error
+	Unschedule(ctx db.Context, id int) error
+	ClaimDue(ctx db.Context, now time.Time) ([]model.Campaign, error)
+	Finish(ctx db.Context, id int, now time.Time) error
+	Fail(ctx db.Context, id int, now time.Time) error
+}
+
+// New return new campaign repo
+func New() Repo {
+	return &repo{}
+}
+
+// audience is the JSON document of the audience filter stored in the campaign
+type audience struct {
+	Role        string     `json:"role,omitempty"`
+	Status      string     `json:"status,omitempty"`
+	CreatedFrom *time.Time `json:"createdFrom,omitempty"`
+	CreatedTo   *time.Time `json:"createdTo,omitempty"`
+	Query       string     `json:"query,omitempty"`
+}
+
+func toAudienceJSON(f model.AudienceFilter) (types.JSON, error) {
+	return json.Marshal(audience{
+		Role:        string(f.Role),
+		Status:      string(f.Status),
+		CreatedFrom: f.CreatedFrom,
+		CreatedTo:   f.CreatedTo,
+		Query:       f.Query,
+	})
+}
+
+func toCampaignModel(m *orm.Campaign) (*model.Campaign, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	var a audience
+	if err := m.Audience.Unmarshal(&a); err != nil {
+		return nil, err
+	}
+
+	return &model.Campaign{
+		ID:       m.ID,
+		Name:     m.Name,
+		Template: m.Template,
+		Audience: model.AudienceFilter{
+			Role:        model.Role(a.Role),
+			Status:      model.Status(a.Status),
+			CreatedFrom: a.CreatedFrom,
+			CreatedTo:   a.CreatedTo,
+			Query:       a.Query,
+		},
+		Status:      model.CampaignStatus(m.Status),
+		ScheduledAt: m.ScheduledAt.Ptr(),
+		StartedAt:   m.StartedAt.Ptr(),
+		FinishedAt:  m.FinishedAt.Ptr(),
+		CreatedAt:   m.CreatedAt,
+	}, nil
+}
+
+func toCampaignModels(dt []*orm.Campaign) ([]model.Campaign, error) {
+	rs := make([]model.Campaign, 0, len(dt))
+	for _, m := range dt {
+		c, err := toCampaignModel(m)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, *c)
+	}
+
+	return rs, nil
+}
@@ -0,0 +1,132 @@
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dwarvesf/go-api/pkg/middleware"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// auditSetting is the setting the audit triggers read the user making the changes from,
+// the triggers fill updated_at, created_by and updated_by of the tables given to audit_table
+const auditSetting = "app.user_id"
+
+// auditedStatements are the statements which can fire the audit triggers
+var auditedStatements = []string{"INSERT", "UPDATE", "DELETE", "MERGE", "WITH"}
+
+// auditConnector opens the connections of the pools, they pass the user of the context of
+// every write to the audit triggers so no repository has to fill the audit columns.
+type auditConnector struct {
+	driver.Connector
+}
+
+func (c auditConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pgConn, ok := conn.(*stdlib.Conn)
+	if !ok {
+		conn.Close()
+		return nil, fmt.Errorf("unexpected driver connection %T", conn)
+	}
+
+	return &auditConn{Conn: pgConn}, nil
+}
+
+// auditConn sets the user of the context on its session before the writes, the setting is only
+// sent when the user differs from the one of the previous write on the connection.
+type auditConn struct {
+	*stdlib.Conn
+
+	// actor is the user set on the session, "" for none, it's only known when synced
+	actor  string
+	synced bool
+}
+
+func (c *auditConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if err := c.setActor(ctx, query); err != nil {
+		return nil, err
+	}
+
+	rs, err := c.Conn.ExecContext(ctx, query, args)
+	// rolling back a savepoint reverts the setting too
+	if hasPrefixFold(query, "ROLLBACK") {
+		c.synced = false
+	}
+
+	return rs, err
+}
+
+func (c *auditConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if err := c.setActor(ctx, query); err != nil {
+		return nil, err
+	}
+
+	return c.Conn.QueryContext(ctx, query, args)
+}
+
+func (c *auditConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	tx, err := c.Conn.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return auditTx{Tx: tx, conn: c}, nil
+}
+
+func (c *auditConn) setActor(ctx context.Context, query string) error {
+	if !isAudited(query) {
+		return nil
+	}
+
+	var actor string
+	if uID, err := middleware.UserIDFromContext(ctx); err == nil {
+		actor = strconv.Itoa(uID)
+	}
+	if c.synced && c.actor == actor {
+		return nil
+	}
+
+	_, err := c.Conn.ExecContext(ctx, "SELECT set_config('"+auditSetting+"', $1, false)", []driver.NamedValue{
+		{Ordinal: 1, Value: actor},
+	})
+	if err != nil {
+		c.synced = false
+		return err
+	}
+
+	c.actor, c.synced = actor, true
+	return nil
+}
+
+// auditTx forgets the user set on the connection on rollback, which reverts it
+type auditTx struct {
+	driver.Tx
+	conn *auditConn
+}
+
+func (t auditTx) Rollback() error {
+	t.conn.synced = false
+	return t.Tx.Rollback()
+}
+
+func isAudited(query string) bool {
+	for _, s := range auditedStatements {
+		if hasPrefixFold(query, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasPrefixFold(query, prefix string) bool {
+	q := strings.TrimSpace(query)
+	return len(q) >= len(prefix) && strings.EqualFold(q[:len(prefix)], prefix)
+}
@@ -0,0 +1,48 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsAudited(t *testing.T) {
+	tests := map[string]struct {
+		query string
+		want  bool
+	}{
+		"insert": {
+			query: `INSERT INTO "users" ("email") VALUES ($1)`,
+			want:  true,
+		},
+		"update with leading spaces": {
+			query: "\n\t  UPDATE \"users\" SET \"name\" = $1",
+			want:  true,
+		},
+		"lower case delete": {
+			query: `delete from "users" where "id" = $1`,
+			want:  true,
+		},
+		"cte": {
+			query: `WITH moved AS (DELETE FROM "mail_outbox" RETURNING *) SELECT * FROM moved`,
+			want:  true,
+		},
+		"select": {
+			query: `SELECT * FROM "users"`,
+			want:  false,
+		},
+		"savepoint": {
+			query: `SAVEPOINT sp_1`,
+			want:  false,
+		},
+		"shorter than the statements": {
+			query: "UP",
+			want:  false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.want, isAudited(tt.query))
+		})
+	}
+}
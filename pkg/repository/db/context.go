@@ -15,54 +15,25 @@ type Context struct {
 	DB boil.ContextExecutor
 }
 
-// FromContext create new store context
+// FromContext create new store context, it runs in the transaction of the context when there is one
 func FromContext(ctx context.Context) Context {
-	return Context{
-		Context: ctx,
-		DB:      GetDB(),
-	}
-}
-
-// NewTransaction create new transaction
-func NewTransaction(ctx context.Context) (Context, FinalFunc) {
-	tx, err := GetDB().BeginTx(ctx, nil)
-	if err != nil {
-		panic(err)
-	}
-
-	return Context{
+	if state := txFromContext(ctx); state != nil {
+		return Context{
 			Context: ctx,
-			DB:      tx,
-		}, func(err error) error {
-			if err != nil {
-				txErr := tx.Rollback()
-				if txErr != nil {
-					return txErr
-				}
-				return err
-			}
-			return tx.Commit()
+			DB:      state.tx,
 		}
-}
-
-// Transaction create new transaction with callback function txFunc
-func Transaction(ctx context.Context, txFunc func(ctx Context) error) error {
-	tx, err := GetDB().BeginTx(ctx, nil)
-	if err != nil {
-		return err
 	}
-	err = txFunc(Context{
-		Context: ctx,
-		DB:      tx,
-	})
 
-	if err != nil {
-		txErr := tx.Rollback()
-		if txErr != nil {
-			return txErr
+	// with replicas, the writes of the users are recorded so they read them from the primary
+	if replicas != nil {
+		return Context{
+			Context: ctx,
+			DB:      primaryDB{GetDB()},
 		}
-		return err
 	}
 
-	return tx.Commit()
+	return Context{
+		Context: ctx,
+		DB:      GetDB(),
+	}
 }
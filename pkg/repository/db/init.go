@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"sync"
@@ -12,6 +13,9 @@ import (
 	"github.com/pkg/errors"
 )
 
+// defaultReplicaHealthInterval is the interval of the health checks of the replicas
+const defaultReplicaHealthInterval = 5 * time.Second
+
 // singleDB is global db
 var singleDB *sql.DB
 
@@ -41,16 +45,52 @@ func Init(cfg config.Config) (*sql.DB, error) {
 		return nil, errors.WithStack(fmt.Errorf("parsing pgx config failed. err: %w", err))
 	}
 
-	pool, err := sql.Open("pgx", stdlib.RegisterConnConfig(connCfg))
-	if err != nil {
-		return nil, errors.WithStack(fmt.Errorf("opening DB failed. err: %w", err))
+	pool := openPool(cfg, connCfg)
+	if err := setupReplicas(cfg); err != nil {
+		return nil, err
+	}
+
+	setupGlobalDB(pool)
+
+	return pool, nil
+}
+
+// setupReplicas opens the pools of the replicas and checks their health in the background,
+// they serve the reads once their first health check passed.
+func setupReplicas(cfg config.Config) error {
+	if len(cfg.DatabaseReplicaURLs) == 0 {
+		return nil
 	}
 
+	pools := make([]*sql.DB, 0, len(cfg.DatabaseReplicaURLs))
+	for _, url := range cfg.DatabaseReplicaURLs {
+		connCfg, err := pgx.ParseConfig(url)
+		if err != nil {
+			return errors.WithStack(fmt.Errorf("parsing pgx config of replica failed. err: %w", err))
+		}
+		pools = append(pools, openPool(cfg, connCfg))
+	}
+
+	interval := time.Duration(cfg.DBReplicaHealthInterval) * time.Second
+	if interval <= 0 {
+		interval = defaultReplicaHealthInterval
+	}
+	replicas = newReplicaSet(pools, time.Duration(cfg.DBReadYourWritesWindow)*time.Second)
+	go replicas.run(context.Background(), interval)
+
+	return nil
+}
+
+func openPool(cfg config.Config, connCfg *pgx.ConnConfig) *sql.DB {
+	pool := openDB(connCfg)
 	pool.SetConnMaxLifetime(29 * time.Minute)
 	pool.SetMaxOpenConns(cfg.DBMaxOpenConns)
 	pool.SetMaxIdleConns(cfg.DBMaxIdleConns)
 
-	setupGlobalDB(pool)
+	return pool
+}
 
-	return pool, nil
+// openDB opens the pool, its connections pass the user of the writes to the audit triggers
+func openDB(connCfg *pgx.ConnConfig) *sql.DB {
+	return sql.OpenDB(auditConnector{stdlib.GetConnector(*connCfg)})
 }
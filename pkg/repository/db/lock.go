@@ -0,0 +1,106 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+)
+
+// AdvisoryLock is a session level Postgres advisory lock, it's held on a
+// dedicated connection until Unlock or until the connection is lost
+type AdvisoryLock struct {
+	key   int64
+	conn  *sql.Conn
+	mutex sync.Mutex
+}
+
+// NewAdvisoryLock creates the advisory lock of the key.
+func NewAdvisoryLock(key int64) *AdvisoryLock {
+	return &AdvisoryLock{key: key}
+}
+
+// TryLock acquires the lock without waiting, it reports whether the lock is held.
+func (l *AdvisoryLock) TryLock(ctx context.Context) (bool, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.conn != nil {
+		return true, nil
+	}
+
+	conn, err := GetDB().Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&locked); err != nil {
+		conn.Close()
+		return false, err
+	}
+	if !locked {
+		conn.Close()
+		return false, nil
+	}
+
+	l.conn = conn
+	return true, nil
+}
+
+// Lock acquires the lock, waiting until it's released by its holder or the context is done.
+func (l *AdvisoryLock) Lock(ctx context.Context) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.conn != nil {
+		return nil
+	}
+
+	conn, err := GetDB().Conn(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", l.key); err != nil {
+		conn.Close()
+		return err
+	}
+
+	l.conn = conn
+	return nil
+}
+
+// Check returns an error when the connection holding the lock is lost,
+// the lock is released by Postgres with the session.
+func (l *AdvisoryLock) Check(ctx context.Context) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.conn == nil {
+		return sql.ErrConnDone
+	}
+
+	if err := l.conn.PingContext(ctx); err != nil {
+		l.conn.Close()
+		l.conn = nil
+		return err
+	}
+
+	return nil
+}
+
+// Unlock releases the lock and the connection.
+func (l *AdvisoryLock) Unlock(ctx context.Context) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.conn == nil {
+		return nil
+	}
+
+	_, err := l.conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", l.key)
+	l.conn.Close()
+	l.conn = nil
+
+	return err
+}
@@ -0,0 +1,106 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/dwarvesf/go-api/migrations"
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+// MigrateLockKey is the key of the advisory lock held while migrating on boot,
+// the replicas of a rollout wait for the first one instead of racing it
+const MigrateLockKey int64 = 7_300_002
+
+// migrateDialect is the dialect of the sql-migrate tracking table, gorp_migrations
+const migrateDialect = "postgres"
+
+// Direction is the direction of a migration
+type Direction = migrate.MigrationDirection
+
+const (
+	// Up applies the pending migrations
+	Up = migrate.Up
+	// Down rolls back the applied migrations
+	Down = migrate.Down
+)
+
+// ErrNothingToRedo is returned by Redo when no migration is applied
+var ErrNothingToRedo = errors.New("no migration to redo")
+
+// MigrationStatus is the state of an embedded migration
+type MigrationStatus struct {
+	ID        string
+	AppliedAt *time.Time
+}
+
+// migrationSource reads the migrations embedded in the binary
+var migrationSource = migrate.HttpFileSystemMigrationSource{FileSystem: http.FS(migrations.FS)}
+
+// Migrate applies the pending migrations, or rolls back the applied ones when down,
+// limit bounds the number of migrations, 0 means all of them.
+func Migrate(ctx context.Context, dir Direction, limit int) (int, error) {
+	return migrate.ExecMaxContext(ctx, GetDB(), migrateDialect, migrationSource, dir, limit)
+}
+
+// Redo rolls back the last applied migration and applies it again.
+func Redo(ctx context.Context) (string, error) {
+	planned, _, err := migrate.PlanMigration(GetDB(), migrateDialect, migrationSource, Down, 1)
+	if err != nil {
+		return "", err
+	}
+	if len(planned) == 0 {
+		return "", ErrNothingToRedo
+	}
+
+	if _, err := Migrate(ctx, Down, 1); err != nil {
+		return "", err
+	}
+	if _, err := Migrate(ctx, Up, 1); err != nil {
+		return "", err
+	}
+
+	return planned[0].Id, nil
+}
+
+// MigrationStatuses lists the embedded migrations with the time they were applied at.
+func MigrationStatuses() ([]MigrationStatus, error) {
+	ms, err := migrationSource.FindMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := migrate.GetMigrationRecords(GetDB(), migrateDialect)
+	if err != nil {
+		return nil, err
+	}
+	appliedAt := make(map[string]time.Time, len(records))
+	for _, r := range records {
+		appliedAt[r.Id] = r.AppliedAt
+	}
+
+	rs := make([]MigrationStatus, 0, len(ms))
+	for _, m := range ms {
+		s := MigrationStatus{ID: m.Id}
+		if t, ok := appliedAt[m.Id]; ok {
+			s.AppliedAt = &t
+		}
+		rs = append(rs, s)
+	}
+
+	return rs, nil
+}
+
+// MigrateOnBoot applies the pending migrations while holding the migration lock,
+// the other instances booting at the same time wait and find nothing to apply.
+func MigrateOnBoot(ctx context.Context) (int, error) {
+	lock := NewAdvisoryLock(MigrateLockKey)
+	if err := lock.Lock(ctx); err != nil {
+		return 0, err
+	}
+	defer lock.Unlock(context.Background())
+
+	return Migrate(ctx, Up, 0)
+}
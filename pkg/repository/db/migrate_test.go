@@ -0,0 +1,26 @@
+package db
+
+import (
+	"io/fs"
+	"testing"
+
+	"github.com/dwarvesf/go-api/migrations"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationSource(t *testing.T) {
+	files, err := fs.Glob(migrations.FS, "*.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	ms, err := migrationSource.FindMigrations()
+	require.NoError(t, err)
+	require.Len(t, ms, len(files))
+
+	for i, m := range ms {
+		require.NotEmpty(t, m.Up, m.Id)
+		if i > 0 {
+			require.True(t, ms[i-1].Less(m), "%s must be applied before %s", ms[i-1].Id, m.Id)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/middleware"
+)
+
+// replicaPingTimeout is the time a replica has to answer the health check
+const replicaPingTimeout = 2 * time.Second
+
+// replicas are the read replicas of the primary, nil without replicas
+var replicas *replicaSet
+
+// replica is a read replica, it only serves the reads once its health check passed
+type replica struct {
+	db      *sql.DB
+	healthy atomic.Bool
+}
+
+// replicaSet hands out the healthy replicas in turn
+type replicaSet struct {
+	replicas []*replica
+	next     atomic.Uint32
+	writes   *writeTracker
+}
+
+func newReplicaSet(dbs []*sql.DB, readYourWrites time.Duration) *replicaSet {
+	s := &replicaSet{
+		replicas: make([]*replica, 0, len(dbs)),
+		writes:   newWriteTracker(readYourWrites),
+	}
+	for _, db := range dbs {
+		s.replicas = append(s.replicas, &replica{db: db})
+	}
+
+	return s
+}
+
+// pick returns the next healthy replica, nil when none is healthy
+func (s *replicaSet) pick() *sql.DB {
+	n := len(s.replicas)
+	start := int(s.next.Add(1) - 1)
+	for i := 0; i < n; i++ {
+		r := s.replicas[(start+i)%n]
+		if r.healthy.Load() {
+			return r.db
+		}
+	}
+
+	return nil
+}
+
+// checkHealth pings every replica, the ones which don't answer stop serving the reads until they do
+func (s *replicaSet) checkHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, r := range s.replicas {
+		wg.Add(1)
+		go func(r *replica) {
+			defer wg.Done()
+			pingCtx, cancel := context.WithTimeout(ctx, replicaPingTimeout)
+			defer cancel()
+			r.healthy.Store(r.db.PingContext(pingCtx) == nil)
+		}(r)
+	}
+	wg.Wait()
+}
+
+// run checks the health of the replicas every interval until the context is done
+func (s *replicaSet) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.checkHealth(ctx)
+		s.writes.sweep(time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// writeTracker remembers the last write of the users, so their reads stick to the primary for a while
+// and they read their own writes despite the replication lag. It's kept in memory, so the stickiness
+// only holds for the requests of the user served by the same instance.
+type writeTracker struct {
+	window time.Duration
+	mutex  sync.Mutex
+	last   map[int]time.Time
+}
+
+func newWriteTracker(window time.Duration) *writeTracker {
+	return &writeTracker{
+		window: window,
+		last:   map[int]time.Time{},
+	}
+}
+
+// mark records a write of the user of the context, the anonymous writes aren't tracked
+func (w *writeTracker) mark(ctx context.Context, now time.Time) {
+	uID, err := middleware.UserIDFromContext(ctx)
+	if err != nil || w.window <= 0 {
+		return
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.last[uID] = now
+}
+
+// recent reports whether the user of the context wrote within the window
+func (w *writeTracker) recent(ctx context.Context, now time.Time) bool {
+	uID, err := middleware.UserIDFromContext(ctx)
+	if err != nil {
+		return false
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	last, ok := w.last[uID]
+	return ok && now.Sub(last) < w.window
+}
+
+// sweep forgets the writes out of the window
+func (w *writeTracker) sweep(now time.Time) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	for uID, last := range w.last {
+		if now.Sub(last) >= w.window {
+			delete(w.last, uID)
+		}
+	}
+}
+
+// markWrite records a write of the user of the context when there are replicas
+func markWrite(ctx context.Context) {
+	if replicas != nil {
+		replicas.writes.mark(ctx, time.Now())
+	}
+}
+
+// ReadOnlyFromContext creates a store context for reads, routed to a healthy replica.
+// The reads stay on the primary inside a transaction, for a while after a write of the user
+// so it reads its own writes, and when there is no healthy replica. Writing through it fails.
+func ReadOnlyFromContext(ctx context.Context) Context {
+	if replicas == nil || txFromContext(ctx) != nil || replicas.writes.recent(ctx, time.Now()) {
+		return FromContext(ctx)
+	}
+	replica := replicas.pick()
+	if replica == nil {
+		return FromContext(ctx)
+	}
+
+	return Context{
+		Context: ctx,
+		DB:      replica,
+	}
+}
+
+// primaryDB is the primary pool recording the writes of the users when there are replicas,
+// a query is a write unless it's a SELECT
+type primaryDB struct {
+	*sql.DB
+}
+
+func (p primaryDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	rs, err := p.DB.ExecContext(ctx, query, args...)
+	if err == nil {
+		markWrite(ctx)
+	}
+	return rs, err
+}
+
+func (p primaryDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	rows, err := p.DB.QueryContext(ctx, query, args...)
+	if err == nil && isWrite(query) {
+		markWrite(ctx)
+	}
+	return rows, err
+}
+
+func (p primaryDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if isWrite(query) {
+		markWrite(ctx)
+	}
+	return p.DB.QueryRowContext(ctx, query, args...)
+}
+
+func isWrite(query string) bool {
+	q := strings.TrimSpace(query)
+	return len(q) < len("SELECT") || !strings.EqualFold(q[:len("SELECT")], "SELECT")
+}
@@ -2,17 +2,15 @@ package db
 
 import (
 	"context"
-	"database/sql"
 	"testing"
 	"time"
 
 	"github.com/dwarvesf/go-api/pkg/config"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/stretchr/testify/require"
 )
 
-// WithTestingDB run callback with transaction
+// WithTestingDB run callback with transaction, rolled back at the end of the test
 func WithTestingDB(t *testing.T, callback func(ctx Context)) {
 	if singleDB == nil {
 		initTestingDB(t)
@@ -23,18 +21,15 @@ func WithTestingDB(t *testing.T, callback func(ctx Context)) {
 
 	defer tx.Rollback()
 
-	callback(Context{
-		Context: context.Background(),
-		DB:      tx,
-	})
+	// the transactions of the tested code are savepoints of the testing one
+	callback(txContext(context.Background(), &txState{tx: tx}))
 }
 
 func initTestingDB(t *testing.T) {
 	cfg := config.LoadTestConfig()
 	dbConnCfg, err := pgx.ParseConfig(cfg.DatabaseURL)
 	require.NoError(t, err)
-	connStr := stdlib.RegisterConnConfig(dbConnCfg)
-	appDB, err := sql.Open("pgx", connStr)
+	appDB := openDB(dbConnCfg)
 	appDB.SetMaxOpenConns(50)
 	appDB.SetConnMaxLifetime(30 * time.Minute)
 
@@ -0,0 +1,229 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a transaction is retried after a serialization failure or a deadlock
+	DefaultMaxRetries = 3
+
+	retryBackoff = 20 * time.Millisecond
+)
+
+// the SQLSTATE of the errors the transaction can be retried after
+const (
+	codeSerializationFailure = "40001"
+	codeDeadlockDetected     = "40P01"
+)
+
+// TxOption configures a transaction, the options only apply to the outermost transaction
+type TxOption func(o *txOptions)
+
+type txOptions struct {
+	sql.TxOptions
+	maxRetries int
+}
+
+// Isolation sets the isolation level of the transaction, the level of the database by default.
+func Isolation(level sql.IsolationLevel) TxOption {
+	return func(o *txOptions) {
+		o.Isolation = level
+	}
+}
+
+// ReadOnly makes the transaction read only.
+func ReadOnly() TxOption {
+	return func(o *txOptions) {
+		o.ReadOnly = true
+	}
+}
+
+// MaxRetries overrides the number of times the transaction is retried, 0 disables the retries.
+func MaxRetries(n int) TxOption {
+	return func(o *txOptions) {
+		o.maxRetries = n
+	}
+}
+
+// txKey is the key of the transaction in the context
+type txKey struct{}
+
+// txState is the transaction of a context, the nested transactions are savepoints of it
+type txState struct {
+	tx         boil.ContextExecutor
+	savepoints int32
+}
+
+// txFromContext returns the transaction of the context, a Context whose DB is a transaction
+// such as the one of WithTestingDB counts as one.
+func txFromContext(ctx context.Context) *txState {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return state
+	}
+	if c, ok := ctx.(Context); ok {
+		if _, ok := c.DB.(boil.ContextTransactor); ok {
+			return &txState{tx: c.DB}
+		}
+	}
+
+	return nil
+}
+
+// Transaction runs txFunc in a transaction, committed when it returns no error and rolled back otherwise.
+// Inside another transaction it runs in a savepoint of it, so only its own changes are rolled back.
+// The outermost transaction is retried after a serialization failure or a deadlock, so txFunc
+// may run more than once and must not have side effects outside the database.
+func Transaction(ctx context.Context, txFunc func(ctx Context) error, opts ...TxOption) error {
+	if state := txFromContext(ctx); state != nil {
+		return savepoint(ctx, state, txFunc)
+	}
+
+	o := txOptions{maxRetries: DefaultMaxRetries}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := transaction(ctx, o.TxOptions, txFunc)
+		if err == nil || attempt >= o.maxRetries || !IsRetryable(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(time.Duration(attempt+1) * retryBackoff):
+		}
+	}
+}
+
+// NewTransaction begins a transaction, or a savepoint inside another transaction, finished by the final function:
+//
+//	dbCtx, finalFn, err := db.NewTransaction(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	defer func() { err = finalFn(err) }()
+//
+// Unlike Transaction it isn't retried, the code of the caller can't be run again.
+func NewTransaction(ctx context.Context, opts ...TxOption) (Context, FinalFunc, error) {
+	if state := txFromContext(ctx); state != nil {
+		name := state.nextSavepoint()
+		if _, err := state.tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+			return Context{}, nil, err
+		}
+		return txContext(ctx, state), func(err error) error {
+			return releaseSavepoint(ctx, state, name, err)
+		}, nil
+	}
+
+	var o txOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	tx, err := GetDB().BeginTx(ctx, &o.TxOptions)
+	if err != nil {
+		return Context{}, nil, err
+	}
+
+	return txContext(ctx, &txState{tx: tx}), func(err error) error {
+		return finish(ctx, tx, err)
+	}, nil
+}
+
+// IsRetryable reports whether the error is a serialization failure or a deadlock,
+// after which the transaction can succeed when run again.
+func IsRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	return pgErr.Code == codeSerializationFailure || pgErr.Code == codeDeadlockDetected
+}
+
+func transaction(ctx context.Context, opts sql.TxOptions, txFunc func(ctx Context) error) (err error) {
+	tx, err := GetDB().BeginTx(ctx, &opts)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	return finish(ctx, tx, txFunc(txContext(ctx, &txState{tx: tx})))
+}
+
+func savepoint(ctx context.Context, state *txState, txFunc func(ctx Context) error) (err error) {
+	name := state.nextSavepoint()
+	if _, err := state.tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_, _ = state.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+			panic(p)
+		}
+	}()
+
+	return releaseSavepoint(ctx, state, name, txFunc(txContext(ctx, state)))
+}
+
+// finish commits the transaction, or rolls it back on error. A committed transaction
+// counts as a write of the user, whose reads then stick to the primary.
+func finish(ctx context.Context, tx *sql.Tx, err error) error {
+	if err != nil {
+		if txErr := tx.Rollback(); txErr != nil {
+			return txErr
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	markWrite(ctx)
+
+	return nil
+}
+
+// releaseSavepoint releases the savepoint, or rolls back to it on error
+func releaseSavepoint(ctx context.Context, state *txState, name string, err error) error {
+	if err != nil {
+		if _, txErr := state.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); txErr != nil {
+			return txErr
+		}
+		return err
+	}
+
+	_, err = state.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	return err
+}
+
+// txContext returns the context running in the transaction, FromContext finds it in the context
+func txContext(ctx context.Context, state *txState) Context {
+	if c, ok := ctx.(Context); ok {
+		ctx = c.Context
+	}
+
+	return Context{
+		Context: context.WithValue(ctx, txKey{}, state),
+		DB:      state.tx,
+	}
+}
+
+func (s *txState) nextSavepoint() string {
+	return fmt.Sprintf("sp_%d", atomic.AddInt32(&s.savepoints, 1))
+}
@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// fakeTx records the statements of the savepoints
+type fakeTx struct {
+	boil.ContextTransactor
+	stmts []string
+}
+
+func (tx *fakeTx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	tx.stmts = append(tx.stmts, query)
+	return nil, nil
+}
+
+func TestTransaction_Savepoint(t *testing.T) {
+	tx := &fakeTx{}
+	ctx := txContext(context.Background(), &txState{tx: tx})
+
+	err := Transaction(ctx, func(ctx Context) error {
+		require.Equal(t, tx, ctx.DB)
+		// the context of the transaction carries it to the code using FromContext
+		require.Equal(t, tx, FromContext(ctx).DB)
+
+		_ = Transaction(ctx, func(ctx Context) error {
+			return errors.New("failed")
+		})
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"SAVEPOINT sp_1",
+		"SAVEPOINT sp_2",
+		"ROLLBACK TO SAVEPOINT sp_2",
+		"RELEASE SAVEPOINT sp_1",
+	}, tx.stmts)
+
+	// a Context whose DB is a transaction counts as one
+	tx = &fakeTx{}
+	dbCtx, finalFn, err := NewTransaction(Context{Context: context.Background(), DB: tx})
+	require.NoError(t, err)
+	require.Equal(t, tx, dbCtx.DB)
+	require.EqualError(t, finalFn(errors.New("failed")), "failed")
+	require.Equal(t, []string{"SAVEPOINT sp_1", "ROLLBACK TO SAVEPOINT sp_1"}, tx.stmts)
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want bool
+	}{
+		"serialization failure": {
+			err:  &pgconn.PgError{Code: "40001"},
+			want: true,
+		},
+		"wrapped deadlock": {
+			err:  fmt.Errorf("commit: %w", &pgconn.PgError{Code: "40P01"}),
+			want: true,
+		},
+		"unique violation": {
+			err: &pgconn.PgError{Code: "23505"},
+		},
+		"not a database error": {
+			err: errors.New("failed"),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.want, IsRetryable(tt.err))
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package job
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/base"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// claimQuery locks the next due job, the jobs locked by other workers are skipped
+const claimQuery = `
+UPDATE jobs SET
+	status = $1,
+	attempts = attempts + 1,
+	locked_at = $2,
+	locked_by = $3,
+	updated_at = $2
+WHERE id = (
+	SELECT id FROM jobs
+	WHERE status = $4 AND run_at <= $2 AND name = ANY($5)
+	ORDER BY run_at, id
+	LIMIT 1
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING *`
+
+// createUniqueQuery inserts the job unless a live job was enqueued with the same key
+const createUniqueQuery = `
+INSERT INTO jobs (name, payload, status, unique_key, max_attempts, run_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (unique_key) WHERE status IN ('pending', 'running') DO NOTHING
+RETURNING *`
+
+type repo struct {
+}
+
+func (r *repo) Create(ctx db.Context, job model.Job) (*model.Job, error) {
+	m := &orm.Job{
+		Name:        job.Name,
+		Payload:     types.JSON(job.Payload),
+		Status:      string(model.JobStatusPending),
+		UniqueKey:   null.NewString(job.UniqueKey, job.UniqueKey != ""),
+		MaxAttempts: job.MaxAttempts,
+		RunAt:       job.RunAt,
+	}
+	if len(m.Payload) == 0 {
+		m.Payload = types.JSON("{}")
+	}
+	if m.RunAt.IsZero() {
+		m.RunAt = time.Now()
+	}
+
+	if job.UniqueKey == "" {
+		err := m.Insert(ctx, ctx.DB, boil.Infer())
+		return toJobModel(m), err
+	}
+
+	var dt orm.Job
+	err := queries.Raw(createUniqueQuery,
+		m.Name,
+		m.Payload,
+		m.Status,
+		m.UniqueKey,
+		m.MaxAttempts,
+		m.RunAt,
+	).Bind(ctx, ctx.DB, &dt)
+	if err == nil {
+		return toJobModel(&dt), nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	// the live job enqueued with the same key is returned instead of a duplicate
+	live, err := orm.Jobs(
+		orm.JobWhere.UniqueKey.EQ(m.UniqueKey),
+		orm.JobWhere.Status.IN([]string{string(model.JobStatusPending), string(model.JobStatusRunning)}),
+	).One(ctx, ctx.DB)
+	return toJobModel(live), base.GetOneErrorHandler(err)
+}
+
+func (r *repo) GetByID(ctx db.Context, id int64) (*model.Job, error) {
+	dt, err := orm.FindJob(ctx, ctx.DB, id)
+	return toJobModel(dt), base.GetOneErrorHandler(err)
+}
+
+func (r *repo) Claim(ctx db.Context, workerID string, names []string, now time.Time) (*model.Job, error) {
+	var m orm.Job
+	err := queries.Raw(claimQuery,
+		string(model.JobStatusRunning),
+		now,
+		workerID,
+		string(model.JobStatusPending),
+		types.StringArray(names),
+	).Bind(ctx, ctx.DB, &m)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toJobModel(&m), nil
+}
+
+// Heartbeat extends the lock of the running job so that ReleaseStale doesn't put it back.
+func (r *repo) Heartbeat(ctx db.Context, id int64, workerID string, now time.Time) error {
+	n, err := orm.Jobs(lockedBy(id, workerID)...).UpdateAll(ctx, ctx.DB, orm.M{
+		orm.JobColumns.LockedAt:  now,
+		orm.JobColumns.UpdatedAt: now,
+	})
+	return lockLost(n, err)
+}
+
+func (r *repo) Complete(ctx db.Context, id int64, workerID string, now time.Time) error {
+	n, err := orm.Jobs(lockedBy(id, workerID)...).UpdateAll(ctx, ctx.DB, orm.M{
+		orm.JobColumns.Status:    string(model.JobStatusSucceeded),
+		orm.JobColumns.LockedAt:  nil,
+		orm.JobColumns.LockedBy:  nil,
+		orm.JobColumns.LastError: nil,
+		orm.JobColumns.UpdatedAt: now,
+	})
+	return lockLost(n, err)
+}
+
+func (r *repo) Retry(ctx db.Context, id int64, workerID string, now time.Time, runAt time.Time, lastErr string) error {
+	n, err := orm.Jobs(lockedBy(id, workerID)...).UpdateAll(ctx, ctx.DB, orm.M{
+		orm.JobColumns.Status:    string(model.JobStatusPending),
+		orm.JobColumns.RunAt:     runAt,
+		orm.JobColumns.LockedAt:  nil,
+		orm.JobColumns.LockedBy:  nil,
+		orm.JobColumns.LastError: lastErr,
+		orm.JobColumns.UpdatedAt: now,
+	})
+	return lockLost(n, err)
+}
+
+func (r *repo) Fail(ctx db.Context, id int64, workerID string, now time.Time, lastErr string) error {
+	n, err := orm.Jobs(lockedBy(id, workerID)...).UpdateAll(ctx, ctx.DB, orm.M{
+		orm.JobColumns.Status:    string(model.JobStatusDead),
+		orm.JobColumns.LockedAt:  nil,
+		orm.JobColumns.LockedBy:  nil,
+		orm.JobColumns.LastError: lastErr,
+		orm.JobColumns.UpdatedAt: now,
+	})
+	return lockLost(n, err)
+}
+
+// locked selects the job while it's still locked by the worker, the job released
+// by ReleaseStale and claimed again by another worker isn't updated.
+func lockedBy(id int64, workerID string) []qm.QueryMod {
+	return []qm.QueryMod{
+		orm.JobWhere.ID.EQ(id),
+		orm.JobWhere.Status.EQ(string(model.JobStatusRunning)),
+		orm.JobWhere.LockedBy.EQ(null.StringFrom(workerID)),
+	}
+}
+
+func lockLost(n int64, err error) error {
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return model.ErrJobLockLost
+	}
+	return nil
+}
+
+// ReleaseStale puts back the jobs locked by workers which died while running them.
+func (r *repo) ReleaseStale(ctx db.Context, lockedBefore time.Time) (int64, error) {
+	return orm.Jobs(
+		orm.JobWhere.Status.EQ(string(model.JobStatusRunning)),
+		orm.JobWhere.LockedAt.LT(null.TimeFrom(lockedBefore)),
+	).UpdateAll(ctx, ctx.DB, orm.M{
+		orm.JobColumns.Status:   string(model.JobStatusPending),
+		orm.JobColumns.LockedAt: nil,
+		orm.JobColumns.LockedBy: nil,
+	})
+}
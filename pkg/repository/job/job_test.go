@@ -0,0 +1,177 @@
+package job
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_repo_Create(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		r := &repo{}
+
+		first, err := r.Create(ctx, model.Job{Name: "sent_mail", UniqueKey: "daily", MaxAttempts: 3})
+		require.NoError(t, err)
+		require.Equal(t, model.JobStatusPending, first.Status)
+		require.JSONEq(t, `{}`, string(first.Payload))
+
+		// the job with the same key is returned instead of a duplicate
+		second, err := r.Create(ctx, model.Job{Name: "sent_mail", UniqueKey: "daily", MaxAttempts: 3})
+		require.NoError(t, err)
+		require.Equal(t, first.ID, second.ID)
+
+		// the key can be enqueued again once its job isn't live anymore
+		_, err = r.Claim(ctx, "worker-1", []string{"sent_mail"}, time.Now())
+		require.NoError(t, err)
+		err = r.Complete(ctx, first.ID, "worker-1", time.Now())
+		require.NoError(t, err)
+		again, err := r.Create(ctx, model.Job{Name: "sent_mail", UniqueKey: "daily", MaxAttempts: 3})
+		require.NoError(t, err)
+		require.NotEqual(t, first.ID, again.ID)
+		require.Equal(t, model.JobStatusPending, again.Status)
+
+		// jobs without key are never deduplicated
+		third, err := r.Create(ctx, model.Job{Name: "sent_mail", MaxAttempts: 3})
+		require.NoError(t, err)
+		fourth, err := r.Create(ctx, model.Job{Name: "sent_mail", MaxAttempts: 3})
+		require.NoError(t, err)
+		require.NotEqual(t, third.ID, fourth.ID)
+	})
+}
+
+func Test_repo_Claim(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		now := time.Now()
+		r := &repo{}
+
+		due, err := r.Create(ctx, model.Job{Name: "a", Payload: json.RawMessage(`{"n":1}`), MaxAttempts: 3, RunAt: now.Add(-time.Minute)})
+		require.NoError(t, err)
+		_, err = r.Create(ctx, model.Job{Name: "a", MaxAttempts: 3, RunAt: now.Add(time.Hour)})
+		require.NoError(t, err)
+		_, err = r.Create(ctx, model.Job{Name: "b", MaxAttempts: 3, RunAt: now.Add(-time.Hour)})
+		require.NoError(t, err)
+
+		got, err := r.Claim(ctx, "worker-1", []string{"a"}, now)
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		require.Equal(t, due.ID, got.ID)
+		require.Equal(t, model.JobStatusRunning, got.Status)
+		require.Equal(t, 1, got.Attempts)
+		require.Equal(t, "worker-1", got.LockedBy)
+
+		// the job scheduled later and the job of another name aren't claimed
+		got, err = r.Claim(ctx, "worker-1", []string{"a"}, now)
+		require.NoError(t, err)
+		require.Nil(t, got)
+	})
+}
+
+func Test_repo_Retry(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		now := time.Now()
+		r := &repo{}
+
+		m, err := r.Create(ctx, model.Job{Name: "a", MaxAttempts: 3, RunAt: now.Add(-time.Minute)})
+		require.NoError(t, err)
+		_, err = r.Claim(ctx, "worker-1", []string{"a"}, now)
+		require.NoError(t, err)
+
+		err = r.Retry(ctx, m.ID, "worker-1", now, now.Add(time.Minute), "failed")
+		require.NoError(t, err)
+
+		got, err := r.GetByID(ctx, m.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.JobStatusPending, got.Status)
+		require.Equal(t, "failed", got.LastError)
+		require.Nil(t, got.LockedAt)
+
+		// the job isn't claimed before the backoff
+		claimed, err := r.Claim(ctx, "worker-1", []string{"a"}, now)
+		require.NoError(t, err)
+		require.Nil(t, claimed)
+
+		claimed, err = r.Claim(ctx, "worker-1", []string{"a"}, now.Add(time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, 2, claimed.Attempts)
+	})
+}
+
+func Test_repo_ReleaseStale(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		now := time.Now()
+		r := &repo{}
+
+		m, err := r.Create(ctx, model.Job{Name: "a", MaxAttempts: 3, RunAt: now.Add(-time.Hour)})
+		require.NoError(t, err)
+		_, err = r.Claim(ctx, "worker-1", []string{"a"}, now.Add(-time.Hour))
+		require.NoError(t, err)
+
+		n, err := r.ReleaseStale(ctx, now.Add(-time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, int64(1), n)
+
+		got, err := r.GetByID(ctx, m.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.JobStatusPending, got.Status)
+	})
+}
+
+func Test_repo_Complete_staleReclaimed(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		now := time.Now()
+		r := &repo{}
+
+		m, err := r.Create(ctx, model.Job{Name: "a", MaxAttempts: 3, RunAt: now.Add(-time.Hour)})
+		require.NoError(t, err)
+		_, err = r.Claim(ctx, "worker-1", []string{"a"}, now.Add(-time.Hour))
+		require.NoError(t, err)
+
+		// worker-1 hangs past the lock timeout, the job is released and claimed by worker-2
+		_, err = r.ReleaseStale(ctx, now.Add(-time.Minute))
+		require.NoError(t, err)
+		_, err = r.Claim(ctx, "worker-2", []string{"a"}, now)
+		require.NoError(t, err)
+
+		// the outcome of worker-1 doesn't override the run of worker-2
+		require.ErrorIs(t, r.Complete(ctx, m.ID, "worker-1", now), model.ErrJobLockLost)
+		require.ErrorIs(t, r.Retry(ctx, m.ID, "worker-1", now, now, "failed"), model.ErrJobLockLost)
+		require.ErrorIs(t, r.Fail(ctx, m.ID, "worker-1", now, "failed"), model.ErrJobLockLost)
+
+		got, err := r.GetByID(ctx, m.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.JobStatusRunning, got.Status)
+		require.Equal(t, "worker-2", got.LockedBy)
+
+		require.NoError(t, r.Complete(ctx, m.ID, "worker-2", now))
+		got, err = r.GetByID(ctx, m.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.JobStatusSucceeded, got.Status)
+	})
+}
+
+func Test_repo_Heartbeat(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		now := time.Now()
+		r := &repo{}
+
+		m, err := r.Create(ctx, model.Job{Name: "a", MaxAttempts: 3, RunAt: now.Add(-time.Hour)})
+		require.NoError(t, err)
+		_, err = r.Claim(ctx, "worker-1", []string{"a"}, now.Add(-time.Hour))
+		require.NoError(t, err)
+
+		// the job still running on worker-1 isn't released as stale
+		require.NoError(t, r.Heartbeat(ctx, m.ID, "worker-1", now))
+		n, err := r.ReleaseStale(ctx, now.Add(-time.Minute))
+		require.NoError(t, err)
+		require.Zero(t, n)
+
+		require.ErrorIs(t, r.Heartbeat(ctx, m.ID, "worker-2", now), model.ErrJobLockLost)
+		got, err := r.GetByID(ctx, m.ID)
+		require.NoError(t, err)
+		require.Equal(t, "worker-1", got.LockedBy)
+	})
+}
@@ -0,0 +1,48 @@
+package job
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+)
+
+// Repo represent the background job
+type Repo interface {
+	Create(ctx db.Context, job model.Job) (*model.Job, error)
+	GetByID(ctx db.Context, id int64) (*model.Job, error)
+	Claim(ctx db.Context, workerID string, names []string, now time.Time) (*model.Job, error)
+	Heartbeat(ctx db.Context, id int64, workerID string, now time.Time) error
+	Complete(ctx db.Context, id int64, workerID string, now time.Time) error
+	Retry(ctx db.Context, id int64, workerID string, now time.Time, runAt time.Time, lastErr string) error
+	Fail(ctx db.Context, id int64, workerID string, now time.Time, lastErr string) error
+	ReleaseStale(ctx db.Context, lockedBefore time.Time) (int64, error)
+}
+
+// New return new job repo
+func New() Repo {
+	return &repo{}
+}
+
+func toJobModel(m *orm.Job) *model.Job {
+	if m == nil {
+		return nil
+	}
+	return &model.Job{
+		ID:          m.ID,
+		Name:        m.Name,
+		Payload:     json.RawMessage(m.Payload),
+		Status:      model.JobStatus(m.Status),
+		UniqueKey:   m.UniqueKey.String,
+		Attempts:    m.Attempts,
+		MaxAttempts: m.MaxAttempts,
+		RunAt:       m.RunAt,
+		LockedAt:    m.LockedAt.Ptr(),
+		LockedBy:    m.LockedBy.String,
+		LastError:   m.LastError.String,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
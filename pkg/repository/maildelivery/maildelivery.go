@@ -0,0 +1,77 @@
+package maildelivery
+
+import (
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type repo struct {
+}
+
+// GetCheckpoint returns the checkpoint of the campaign, it's created when the campaign starts.
+func (r *repo) GetCheckpoint(ctx db.Context, campaign string) (*model.MailCheckpoint, error) {
+	m := &orm.MailCheckpoint{
+		Campaign:  campaign,
+		StartedAt: time.Now(),
+	}
+	err := m.Upsert(ctx, ctx.DB, false, []string{orm.MailCheckpointColumns.Campaign}, boil.None(), boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+
+	m, err = orm.FindMailCheckpoint(ctx, ctx.DB, campaign)
+	return toCheckpointModel(m), err
+}
+
+func (r *repo) SaveCheckpoint(ctx db.Context, cp model.MailCheckpoint) error {
+	_, err := orm.MailCheckpoints(orm.MailCheckpointWhere.Campaign.EQ(cp.Campaign)).UpdateAll(ctx, ctx.DB, orm.M{
+		orm.MailCheckpointColumns.LastUserID: cp.LastUserID,
+		orm.MailCheckpointColumns.Sent:       cp.Sent,
+		orm.MailCheckpointColumns.Skipped:    cp.Skipped,
+		orm.MailCheckpointColumns.Failed:     cp.Failed,
+		orm.MailCheckpointColumns.FinishedAt: null.TimeFromPtr(cp.FinishedAt),
+		orm.MailCheckpointColumns.UpdatedAt:  time.Now(),
+	})
+	return err
+}
+
+// GetSentUserIDs returns the users of the list the campaign was already sent to.
+func (r *repo) GetSentUserIDs(ctx db.Context, campaign string, userIDs []int) ([]int, error) {
+	dt, err := orm.MailDeliveries(
+		orm.MailDeliveryWhere.Campaign.EQ(campaign),
+		orm.MailDeliveryWhere.UserID.IN(userIDs),
+		orm.MailDeliveryWhere.Status.EQ(string(model.MailDeliveryStatusSent)),
+	).All(ctx, ctx.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]int, 0, len(dt))
+	for _, m := range dt {
+		rs = append(rs, m.UserID)
+	}
+
+	return rs, nil
+}
+
+// Record saves the outcome of sending the campaign to the user, a failed delivery is replaced by the next attempt.
+func (r *repo) Record(ctx db.Context, d model.MailDelivery) error {
+	m := &orm.MailDelivery{
+		Campaign: d.Campaign,
+		UserID:   d.UserID,
+		Email:    d.Email,
+		Status:   string(d.Status),
+		Error:    null.NewString(d.Error, d.Error != ""),
+	}
+
+	return m.Upsert(ctx, ctx.DB, true,
+		[]string{orm.MailDeliveryColumns.Campaign, orm.MailDeliveryColumns.UserID},
+		boil.Whitelist(orm.MailDeliveryColumns.Email, orm.MailDeliveryColumns.Status, orm.MailDeliveryColumns.Error, orm.MailDeliveryColumns.UpdatedAt),
+		boil.Infer(),
+	)
+}
@@ -0,0 +1,81 @@
+package maildelivery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func Test_repo_Checkpoint(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		r := &repo{}
+
+		cp, err := r.GetCheckpoint(ctx, "welcome")
+		require.NoError(t, err)
+		require.Equal(t, 0, cp.LastUserID)
+		require.Nil(t, cp.FinishedAt)
+
+		finishedAt := time.Now().Truncate(time.Millisecond)
+		cp.LastUserID = 10
+		cp.Sent = 8
+		cp.Skipped = 1
+		cp.Failed = 1
+		cp.FinishedAt = &finishedAt
+		err = r.SaveCheckpoint(ctx, *cp)
+		require.NoError(t, err)
+
+		// getting the checkpoint again doesn't reset the progress
+		got, err := r.GetCheckpoint(ctx, "welcome")
+		require.NoError(t, err)
+		require.Equal(t, 10, got.LastUserID)
+		require.Equal(t, 8, got.Sent)
+		require.Equal(t, 1, got.Skipped)
+		require.Equal(t, 1, got.Failed)
+		require.True(t, finishedAt.Equal(*got.FinishedAt))
+	})
+}
+
+func Test_repo_Record(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		ids := make([]int, 0, 2)
+		for _, email := range []string{"admin@d.foundation", "admin1@d.foundation"} {
+			u := &orm.User{
+				Email:          email,
+				Name:           "admin",
+				Status:         "active",
+				Role:           "admin",
+				HashedPassword: "123456",
+				Salt:           "abcdef",
+			}
+			require.NoError(t, u.Insert(ctx, ctx.DB, boil.Infer()))
+			ids = append(ids, u.ID)
+		}
+		r := &repo{}
+
+		err := r.Record(ctx, model.MailDelivery{Campaign: "welcome", UserID: ids[0], Email: "admin@d.foundation", Status: model.MailDeliveryStatusSent})
+		require.NoError(t, err)
+		err = r.Record(ctx, model.MailDelivery{Campaign: "welcome", UserID: ids[1], Email: "admin1@d.foundation", Status: model.MailDeliveryStatusFailed, Error: "timeout"})
+		require.NoError(t, err)
+
+		got, err := r.GetSentUserIDs(ctx, "welcome", ids)
+		require.NoError(t, err)
+		require.Equal(t, ids[:1], got)
+
+		// the next attempt replaces the failed delivery
+		err = r.Record(ctx, model.MailDelivery{Campaign: "welcome", UserID: ids[1], Email: "admin1@d.foundation", Status: model.MailDeliveryStatusSent})
+		require.NoError(t, err)
+
+		got, err = r.GetSentUserIDs(ctx, "welcome", ids)
+		require.NoError(t, err)
+		require.ElementsMatch(t, ids, got)
+
+		got, err = r.GetSentUserIDs(ctx, "other", ids)
+		require.NoError(t, err)
+		require.Empty(t, got)
+	})
+}
@@ -0,0 +1,35 @@
+package maildelivery
+
+import (
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+)
+
+// Repo represent the mail deliveries and the checkpoints of the campaigns
+type Repo interface {
+	GetCheckpoint(ctx db.Context, campaign string) (*model.MailCheckpoint, error)
+	SaveCheckpoint(ctx db.Context, cp model.MailCheckpoint) error
+	GetSentUserIDs(ctx db.Context, campaign string, userIDs []int) ([]int, error)
+	Record(ctx db.Context, d model.MailDelivery) error
+}
+
+// New return new mail delivery repo
+func New() Repo {
+	return &repo{}
+}
+
+func toCheckpointModel(m *orm.MailCheckpoint) *model.MailCheckpoint {
+	if m == nil {
+		return nil
+	}
+	return &model.MailCheckpoint{
+		Campaign:   m.Campaign,
+		LastUserID: m.LastUserID,
+		Sent:       m.Sent,
+		Skipped:    m.Skipped,
+		Failed:     m.Failed,
+		StartedAt:  m.StartedAt,
+		FinishedAt: m.FinishedAt.Ptr(),
+	}
+}
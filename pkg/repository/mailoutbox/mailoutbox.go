@@ -0,0 +1,145 @@
+package mailoutbox
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/base"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// claimQuery locks the next due mail, the mails locked by other dispatchers are
+// skipped unless their lock is older than the lock timeout
+const claimQuery = `
+UPDATE mail_outbox SET
+	attempts = attempts + 1,
+	locked_at = $1,
+	locked_by = $4,
+	updated_at = $1
+WHERE id = (
+	SELECT id FROM mail_outbox
+	WHERE status = $2 AND next_attempt_at <= $1 AND (locked_at IS NULL OR locked_at < $3)
+	ORDER BY next_attempt_at, id
+	LIMIT 1
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING *`
+
+type repo struct {
+}
+
+func (r *repo) Create(ctx db.Context, msg model.MailOutboxMessage) (*model.MailOutboxMessage, error) {
+	m := &orm.MailOutbox{
+		Template:      msg.Template,
+		Recipient:     msg.To,
+		Subject:       msg.Subject,
+		HTML:          msg.HTML,
+		Text:          msg.Text,
+		MessageID:     msg.MessageID,
+		Status:        string(model.MailOutboxStatusQueued),
+		MaxAttempts:   msg.MaxAttempts,
+		NextAttemptAt: msg.NextAttemptAt,
+	}
+	if m.NextAttemptAt.IsZero() {
+		m.NextAttemptAt = time.Now()
+	}
+
+	err := m.Insert(ctx, ctx.DB, boil.Infer())
+	return toMessageModel(m), err
+}
+
+func (r *repo) GetByID(ctx db.Context, id int64) (*model.MailOutboxMessage, error) {
+	dt, err := orm.FindMailOutbox(ctx, ctx.DB, id)
+	return toMessageModel(dt), base.GetOneErrorHandler(err)
+}
+
+func (r *repo) Claim(ctx db.Context, dispatcherID string, now time.Time, lockedBefore time.Time) (*model.MailOutboxMessage, error) {
+	var m orm.MailOutbox
+	err := queries.Raw(claimQuery,
+		now,
+		string(model.MailOutboxStatusQueued),
+		lockedBefore,
+		dispatcherID,
+	).Bind(ctx, ctx.DB, &m)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toMessageModel(&m), nil
+}
+
+func (r *repo) MarkSent(ctx db.Context, id int64, dispatcherID string, now time.Time) error {
+	n, err := orm.MailOutboxes(lockedBy(id, dispatcherID)...).UpdateAll(ctx, ctx.DB, orm.M{
+		orm.MailOutboxColumns.Status:    string(model.MailOutboxStatusSent),
+		orm.MailOutboxColumns.LockedAt:  nil,
+		orm.MailOutboxColumns.LockedBy:  nil,
+		orm.MailOutboxColumns.LastError: nil,
+		orm.MailOutboxColumns.SentAt:    now,
+		orm.MailOutboxColumns.UpdatedAt: now,
+	})
+	return lockLost(n, err)
+}
+
+func (r *repo) Retry(ctx db.Context, id int64, dispatcherID string, now time.Time, nextAttemptAt time.Time, lastErr string) error {
+	n, err := orm.MailOutboxes(lockedBy(id, dispatcherID)...).UpdateAll(ctx, ctx.DB, orm.M{
+		orm.MailOutboxColumns.NextAttemptAt: nextAttemptAt,
+		orm.MailOutboxColumns.LockedAt:      nil,
+		orm.MailOutboxColumns.LockedBy:      nil,
+		orm.MailOutboxColumns.LastError:     lastErr,
+		orm.MailOutboxColumns.UpdatedAt:     now,
+	})
+	return lockLost(n, err)
+}
+
+func (r *repo) Fail(ctx db.Context, id int64, dispatcherID string, now time.Time, lastErr string) error {
+	n, err := orm.MailOutboxes(lockedBy(id, dispatcherID)...).UpdateAll(ctx, ctx.DB, orm.M{
+		orm.MailOutboxColumns.Status:    string(model.MailOutboxStatusFailed),
+		orm.MailOutboxColumns.LockedAt:  nil,
+		orm.MailOutboxColumns.LockedBy:  nil,
+		orm.MailOutboxColumns.LastError: lastErr,
+		orm.MailOutboxColumns.UpdatedAt: now,
+	})
+	return lockLost(n, err)
+}
+
+// lockedBy selects the mail while it's still locked by the dispatcher, the mail
+// claimed again by another dispatcher after the lock timeout isn't updated.
+func lockedBy(id int64, dispatcherID string) []qm.QueryMod {
+	return []qm.QueryMod{
+		orm.MailOutboxWhere.ID.EQ(id),
+		orm.MailOutboxWhere.Status.EQ(string(model.MailOutboxStatusQueued)),
+		orm.MailOutboxWhere.LockedBy.EQ(null.StringFrom(dispatcherID)),
+	}
+}
+
+func lockLost(n int64, err error) error {
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return model.ErrMailLockLost
+	}
+	return nil
+}
+
+// MarkBounced marks the sent mail of the Message-ID as bounced, it returns the number of mails updated.
+func (r *repo) MarkBounced(ctx db.Context, messageID string, now time.Time, detail string) (int64, error) {
+	return orm.MailOutboxes(
+		orm.MailOutboxWhere.MessageID.EQ(messageID),
+		orm.MailOutboxWhere.Status.EQ(string(model.MailOutboxStatusSent)),
+	).UpdateAll(ctx, ctx.DB, orm.M{
+		orm.MailOutboxColumns.Status:    string(model.MailOutboxStatusBounced),
+		orm.MailOutboxColumns.LastError: detail,
+		orm.MailOutboxColumns.UpdatedAt: now,
+	})
+}
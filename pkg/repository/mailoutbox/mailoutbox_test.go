@@ -0,0 +1,125 @@
+package mailoutbox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_repo_Claim(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		now := time.Now()
+		r := &repo{}
+
+		due, err := r.Create(ctx, model.MailOutboxMessage{Template: "welcome", To: "a@d.foundation", MessageID: "<1@d.foundation>", MaxAttempts: 3, NextAttemptAt: now.Add(-time.Minute)})
+		require.NoError(t, err)
+		require.Equal(t, model.MailOutboxStatusQueued, due.Status)
+		_, err = r.Create(ctx, model.MailOutboxMessage{Template: "welcome", To: "b@d.foundation", MessageID: "<2@d.foundation>", MaxAttempts: 3, NextAttemptAt: now.Add(time.Hour)})
+		require.NoError(t, err)
+
+		got, err := r.Claim(ctx, "dispatcher-1", now, now.Add(-time.Minute))
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		require.Equal(t, due.ID, got.ID)
+		require.Equal(t, 1, got.Attempts)
+
+		// the claimed mail is locked and the other one isn't due
+		got, err = r.Claim(ctx, "dispatcher-1", now, now.Add(-time.Minute))
+		require.NoError(t, err)
+		require.Nil(t, got)
+
+		// the lock of a dead dispatcher expires
+		got, err = r.Claim(ctx, "dispatcher-1", now.Add(time.Minute), now.Add(time.Second))
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		require.Equal(t, due.ID, got.ID)
+		require.Equal(t, 2, got.Attempts)
+	})
+}
+
+func Test_repo_Retry(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		now := time.Now()
+		r := &repo{}
+
+		m, err := r.Create(ctx, model.MailOutboxMessage{Template: "welcome", To: "a@d.foundation", MessageID: "<1@d.foundation>", MaxAttempts: 3, NextAttemptAt: now.Add(-time.Minute)})
+		require.NoError(t, err)
+		_, err = r.Claim(ctx, "dispatcher-1", now, now.Add(-time.Minute))
+		require.NoError(t, err)
+
+		require.NoError(t, r.Retry(ctx, m.ID, "dispatcher-1", now, now.Add(time.Minute), "timeout"))
+		got, err := r.GetByID(ctx, m.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.MailOutboxStatusQueued, got.Status)
+		require.Equal(t, "timeout", got.LastError)
+
+		// the mail isn't claimed before the backoff
+		claimed, err := r.Claim(ctx, "dispatcher-1", now, now.Add(-time.Minute))
+		require.NoError(t, err)
+		require.Nil(t, claimed)
+
+		claimed, err = r.Claim(ctx, "dispatcher-1", now.Add(time.Minute), now)
+		require.NoError(t, err)
+		require.Equal(t, m.ID, claimed.ID)
+	})
+}
+
+func Test_repo_MarkSent_lockExpired(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		now := time.Now()
+		r := &repo{}
+
+		m, err := r.Create(ctx, model.MailOutboxMessage{Template: "welcome", To: "a@d.foundation", MessageID: "<1@d.foundation>", MaxAttempts: 3, NextAttemptAt: now.Add(-time.Hour)})
+		require.NoError(t, err)
+		_, err = r.Claim(ctx, "dispatcher-1", now.Add(-time.Hour), now.Add(-2*time.Hour))
+		require.NoError(t, err)
+
+		// dispatcher-1 hangs past the lock timeout, the mail is claimed by dispatcher-2
+		claimed, err := r.Claim(ctx, "dispatcher-2", now, now.Add(-time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, m.ID, claimed.ID)
+		require.Equal(t, "dispatcher-2", claimed.LockedBy)
+
+		// the outcome of dispatcher-1 doesn't override the attempt of dispatcher-2
+		require.ErrorIs(t, r.MarkSent(ctx, m.ID, "dispatcher-1", now), model.ErrMailLockLost)
+		require.ErrorIs(t, r.Retry(ctx, m.ID, "dispatcher-1", now, now, "timeout"), model.ErrMailLockLost)
+		require.ErrorIs(t, r.Fail(ctx, m.ID, "dispatcher-1", now, "timeout"), model.ErrMailLockLost)
+
+		require.NoError(t, r.MarkSent(ctx, m.ID, "dispatcher-2", now))
+		got, err := r.GetByID(ctx, m.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.MailOutboxStatusSent, got.Status)
+		require.Empty(t, got.LockedBy)
+	})
+}
+
+func Test_repo_MarkBounced(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		now := time.Now()
+		r := &repo{}
+
+		m, err := r.Create(ctx, model.MailOutboxMessage{Template: "welcome", To: "a@d.foundation", MessageID: "<1@d.foundation>", MaxAttempts: 3})
+		require.NoError(t, err)
+
+		// only a sent mail bounces
+		n, err := r.MarkBounced(ctx, m.MessageID, now, "mailbox full")
+		require.NoError(t, err)
+		require.Equal(t, int64(0), n)
+
+		_, err = r.Claim(ctx, "dispatcher-1", now, now.Add(-time.Minute))
+		require.NoError(t, err)
+		require.NoError(t, r.MarkSent(ctx, m.ID, "dispatcher-1", now))
+		n, err = r.MarkBounced(ctx, m.MessageID, now, "mailbox full")
+		require.NoError(t, err)
+		require.Equal(t, int64(1), n)
+
+		got, err := r.GetByID(ctx, m.ID)
+		require.NoError(t, err)
+		require.Equal(t, model.MailOutboxStatusBounced, got.Status)
+		require.Equal(t, "mailbox full", got.LastError)
+		require.NotNil(t, got.SentAt)
+	})
+}
@@ -0,0 +1,48 @@
+package mailoutbox
+
+import (
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+)
+
+// Repo represent the outbox of the transactional mails
+type Repo interface {
+	Create(ctx db.Context, m model.MailOutboxMessage) (*model.MailOutboxMessage, error)
+	GetByID(ctx db.Context, id int64) (*model.MailOutboxMessage, error)
+	Claim(ctx db.Context, dispatcherID string, now time.Time, lockedBefore time.Time) (*model.MailOutboxMessage, error)
+	MarkSent(ctx db.Context, id int64, dispatcherID string, now time.Time) error
+	Retry(ctx db.Context, id int64, dispatcherID string, now time.Time, nextAttemptAt time.Time, lastErr string) error
+	Fail(ctx db.Context, id int64, dispatcherID string, now time.Time, lastErr string) error
+	MarkBounced(ctx db.Context, messageID string, now time.Time, detail string) (int64, error)
+}
+
+// New return new mail outbox repo
+func New() Repo {
+	return &repo{}
+}
+
+func toMessageModel(m *orm.MailOutbox) *model.MailOutboxMessage {
+	if m == nil {
+		return nil
+	}
+	return &model.MailOutboxMessage{
+		ID:            m.ID,
+		Template:      m.Template,
+		To:            m.Recipient,
+		Subject:       m.Subject,
+		HTML:          m.HTML,
+		Text:          m.Text,
+		MessageID:     m.MessageID,
+		Status:        model.MailOutboxStatus(m.Status),
+		Attempts:      m.Attempts,
+		MaxAttempts:   m.MaxAttempts,
+		NextAttemptAt: m.NextAttemptAt,
+		LockedBy:      m.LockedBy.String,
+		LastError:     m.LastError.String,
+		SentAt:        m.SentAt.Ptr(),
+		CreatedAt:     m.CreatedAt,
+	}
+}
@@ -0,0 +1,43 @@
+package mailpreference
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type repo struct {
+}
+
+// Get returns the preference of the user, a user who never changed it receives every mail.
+func (r *repo) Get(ctx db.Context, userID int) (*model.MailPreference, error) {
+	m, err := orm.FindMailPreference(ctx, ctx.DB, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &model.MailPreference{UserID: userID, Campaigns: true}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.MailPreference{
+		UserID:    m.UserID,
+		Campaigns: m.Campaigns,
+	}, nil
+}
+
+func (r *repo) Save(ctx db.Context, p model.MailPreference) error {
+	m := &orm.MailPreference{
+		UserID:    p.UserID,
+		Campaigns: p.Campaigns,
+	}
+
+	return m.Upsert(ctx, ctx.DB, true,
+		[]string{orm.MailPreferenceColumns.UserID},
+		boil.Whitelist(orm.MailPreferenceColumns.Campaigns, orm.MailPreferenceColumns.UpdatedAt),
+		boil.Infer(),
+	)
+}
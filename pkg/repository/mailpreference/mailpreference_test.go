@@ -0,0 +1,40 @@
+package mailpreference
+
+import (
+	"testing"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func Test_repo_Save(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		u := &orm.User{
+			Email:          "admin@d.foundation",
+			Name:           "admin",
+			Status:         "active",
+			Role:           "admin",
+			HashedPassword: "123456",
+			Salt:           "abcdef",
+		}
+		require.NoError(t, u.Insert(ctx, ctx.DB, boil.Infer()))
+		r := &repo{}
+
+		got, err := r.Get(ctx, u.ID)
+		require.NoError(t, err)
+		require.Equal(t, &model.MailPreference{UserID: u.ID, Campaigns: true}, got)
+
+		require.NoError(t, r.Save(ctx, model.MailPreference{UserID: u.ID, Campaigns: false}))
+		got, err = r.Get(ctx, u.ID)
+		require.NoError(t, err)
+		require.False(t, got.Campaigns)
+
+		require.NoError(t, r.Save(ctx, model.MailPreference{UserID: u.ID, Campaigns: true}))
+		got, err = r.Get(ctx, u.ID)
+		require.NoError(t, err)
+		require.True(t, got.Campaigns)
+	})
+}
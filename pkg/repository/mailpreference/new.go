@@ -0,0 +1,17 @@
+package mailpreference
+
+import (
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+)
+
+// Repo represent the mail preferences of the users
+type Repo interface {
+	Get(ctx db.Context, userID int) (*model.MailPreference, error)
+	Save(ctx db.Context, p model.MailPreference) error
+}
+
+// New return new mail preference repo
+func New() Repo {
+	return &repo{}
+}
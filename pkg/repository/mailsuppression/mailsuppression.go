@@ -0,0 +1,33 @@
+package mailsuppression
+
+import (
+	"strings"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type repo struct {
+}
+
+// Save suppresses the address, the addresses are stored in lower case.
+func (r *repo) Save(ctx db.Context, s model.MailSuppression) error {
+	m := &orm.MailSuppression{
+		Email:  strings.ToLower(s.Email),
+		Reason: string(s.Reason),
+		Detail: null.NewString(s.Detail, s.Detail != ""),
+	}
+
+	return m.Upsert(ctx, ctx.DB, true,
+		[]string{orm.MailSuppressionColumns.Email},
+		boil.Whitelist(orm.MailSuppressionColumns.Reason, orm.MailSuppressionColumns.Detail, orm.MailSuppressionColumns.UpdatedAt),
+		boil.Infer(),
+	)
+}
+
+func (r *repo) IsSuppressed(ctx db.Context, email string) (bool, error) {
+	return orm.MailSuppressionExists(ctx, ctx.DB, strings.ToLower(email))
+}
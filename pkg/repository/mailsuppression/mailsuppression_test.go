@@ -0,0 +1,27 @@
+package mailsuppression
+
+import (
+	"testing"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_repo_Save(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		r := &repo{}
+
+		got, err := r.IsSuppressed(ctx, "admin@d.foundation")
+		require.NoError(t, err)
+		require.False(t, got)
+
+		require.NoError(t, r.Save(ctx, model.MailSuppression{Email: "Admin@d.foundation", Reason: model.MailEventTypeBounce}))
+		require.NoError(t, r.Save(ctx, model.MailSuppression{Email: "admin@d.foundation", Reason: model.MailEventTypeComplaint, Detail: "spam"}))
+
+		// the addresses are compared in lower case
+		got, err = r.IsSuppressed(ctx, "ADMIN@d.foundation")
+		require.NoError(t, err)
+		require.True(t, got)
+	})
+}
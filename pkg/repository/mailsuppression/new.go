@@ -0,0 +1,17 @@
+package mailsuppression
+
+import (
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+)
+
+// Repo represent the addresses which are never mailed again
+type Repo interface {
+	Save(ctx db.Context, s model.MailSuppression) error
+	IsSuppressed(ctx db.Context, email string) (bool, error)
+}
+
+// New return new mail suppression repo
+func New() Repo {
+	return &repo{}
+}
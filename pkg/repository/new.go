@@ -1,15 +1,49 @@
 package repository
 
-import "github.com/dwarvesf/go-api/pkg/repository/user"
+import (
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/repository/campaign"
+	"github.com/dwarvesf/go-api/pkg/repository/job"
+	"github.com/dwarvesf/go-api/pkg/repository/maildelivery"
+	"github.com/dwarvesf/go-api/pkg/repository/mailoutbox"
+	"github.com/dwarvesf/go-api/pkg/repository/mailpreference"
+	"github.com/dwarvesf/go-api/pkg/repository/mailsuppression"
+	"github.com/dwarvesf/go-api/pkg/repository/offlinemessage"
+	"github.com/dwarvesf/go-api/pkg/repository/realtimeticket"
+	"github.com/dwarvesf/go-api/pkg/repository/schedule"
+	"github.com/dwarvesf/go-api/pkg/repository/user"
+	"github.com/dwarvesf/go-api/pkg/repository/userdevice"
+)
 
 // Repo represent the repository
 type Repo struct {
-	User user.Repo
+	User            user.Repo
+	OfflineMessage  offlinemessage.Repo
+	Job             job.Repo
+	Schedule        schedule.Repo
+	MailDelivery    maildelivery.Repo
+	MailPreference  mailpreference.Repo
+	Campaign        campaign.Repo
+	MailOutbox      mailoutbox.Repo
+	MailSuppression mailsuppression.Repo
+	RealtimeTicket  realtimeticket.Repo
+	UserDevice      userdevice.Repo
 }
 
-// NewRepo will create an object that represent the Repo interface
-func NewRepo() *Repo {
+// NewRepo will create an object that represent the Repo interface,
+// the repos built on base.Repo start their spans with the monitor
+func NewRepo(monitor monitor.Tracer) *Repo {
 	return &Repo{
-		User: user.New(),
+		User:            user.New(monitor),
+		OfflineMessage:  offlinemessage.New(),
+		Job:             job.New(),
+		Schedule:        schedule.New(),
+		MailDelivery:    maildelivery.New(),
+		MailPreference:  mailpreference.New(),
+		Campaign:        campaign.New(),
+		MailOutbox:      mailoutbox.New(),
+		MailSuppression: mailsuppression.New(),
+		RealtimeTicket:  realtimeticket.New(monitor),
+		UserDevice:      userdevice.New(monitor),
 	}
 }
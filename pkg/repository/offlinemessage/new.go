@@ -0,0 +1,36 @@
+package offlinemessage
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+)
+
+// Repo represent the offline message
+type Repo interface {
+	Create(ctx db.Context, uID int, payload json.RawMessage, expiresAt time.Time) (*model.OfflineMessage, error)
+	GetPending(ctx db.Context, uID int, now time.Time) ([]model.OfflineMessage, error)
+	Delete(ctx db.Context, uID int, id int64) error
+	DeleteExpired(ctx db.Context, uID int, now time.Time) error
+}
+
+// New return new offline message repo
+func New() Repo {
+	return &repo{}
+}
+
+func toOfflineMessageModel(m *orm.OfflineMessage) *model.OfflineMessage {
+	if m == nil {
+		return nil
+	}
+	return &model.OfflineMessage{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		Payload:   json.RawMessage(m.Payload),
+		ExpiresAt: m.ExpiresAt,
+		CreatedAt: m.CreatedAt,
+	}
+}
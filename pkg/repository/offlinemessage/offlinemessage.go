@@ -0,0 +1,61 @@
+package offlinemessage
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+type repo struct {
+}
+
+func (r *repo) Create(ctx db.Context, uID int, payload json.RawMessage, expiresAt time.Time) (*model.OfflineMessage, error) {
+	m := &orm.OfflineMessage{
+		UserID:    uID,
+		Payload:   types.JSON(payload),
+		ExpiresAt: expiresAt,
+	}
+
+	err := m.Insert(ctx, ctx.DB, boil.Infer())
+	return toOfflineMessageModel(m), err
+}
+
+func (r *repo) GetPending(ctx db.Context, uID int, now time.Time) ([]model.OfflineMessage, error) {
+	dt, err := orm.OfflineMessages(
+		orm.OfflineMessageWhere.UserID.EQ(uID),
+		orm.OfflineMessageWhere.ExpiresAt.GT(now),
+		qm.OrderBy(orm.OfflineMessageColumns.ID+" ASC"),
+	).All(ctx.Context, ctx.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]model.OfflineMessage, 0, len(dt))
+	for _, m := range dt {
+		rs = append(rs, *toOfflineMessageModel(m))
+	}
+
+	return rs, nil
+}
+
+func (r *repo) Delete(ctx db.Context, uID int, id int64) error {
+	_, err := orm.OfflineMessages(
+		orm.OfflineMessageWhere.UserID.EQ(uID),
+		orm.OfflineMessageWhere.ID.EQ(id),
+	).DeleteAll(ctx.Context, ctx.DB)
+	return err
+}
+
+func (r *repo) DeleteExpired(ctx db.Context, uID int, now time.Time) error {
+	_, err := orm.OfflineMessages(
+		orm.OfflineMessageWhere.UserID.EQ(uID),
+		orm.OfflineMessageWhere.ExpiresAt.LTE(now),
+	).DeleteAll(ctx.Context, ctx.DB)
+	return err
+}
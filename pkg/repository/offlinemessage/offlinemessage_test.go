@@ -0,0 +1,96 @@
+package offlinemessage
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func createUser(t *testing.T, ctx db.Context, email string) *orm.User {
+	u := &orm.User{
+		Email:          email,
+		Name:           "admin",
+		Status:         "active",
+		Role:           "admin",
+		HashedPassword: "123456",
+		Salt:           "abcdef",
+	}
+	err := u.Insert(ctx, ctx.DB, boil.Infer())
+	require.NoError(t, err)
+
+	return u
+}
+
+func Test_repo_GetPending(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		u := createUser(t, ctx, "admin@d.foundation")
+		other := createUser(t, ctx, "other@d.foundation")
+		now := time.Now()
+
+		r := &repo{}
+		first, err := r.Create(ctx, u.ID, json.RawMessage(`{"n":1}`), now.Add(time.Hour))
+		require.NoError(t, err)
+		_, err = r.Create(ctx, u.ID, json.RawMessage(`{"n":2}`), now.Add(-time.Hour))
+		require.NoError(t, err)
+		third, err := r.Create(ctx, u.ID, json.RawMessage(`{"n":3}`), now.Add(time.Hour))
+		require.NoError(t, err)
+		_, err = r.Create(ctx, other.ID, json.RawMessage(`{"n":4}`), now.Add(time.Hour))
+		require.NoError(t, err)
+
+		tests := map[string]struct {
+			uID  int
+			want []int64
+		}{
+			"skip expired messages and keep the order": {
+				uID:  u.ID,
+				want: []int64{first.ID, third.ID},
+			},
+			"no message": {
+				uID:  other.ID + 1,
+				want: []int64{},
+			},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				got, err := r.GetPending(ctx, tt.uID, now)
+				require.NoError(t, err)
+
+				ids := make([]int64, 0, len(got))
+				for _, m := range got {
+					ids = append(ids, m.ID)
+				}
+				require.Equal(t, tt.want, ids)
+			})
+		}
+	})
+}
+
+func Test_repo_Delete(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		u := createUser(t, ctx, "admin@d.foundation")
+		other := createUser(t, ctx, "other@d.foundation")
+		now := time.Now()
+
+		r := &repo{}
+		m, err := r.Create(ctx, u.ID, json.RawMessage(`{"n":1}`), now.Add(time.Hour))
+		require.NoError(t, err)
+
+		// the message of another user is never removed
+		err = r.Delete(ctx, other.ID, m.ID)
+		require.NoError(t, err)
+		got, err := r.GetPending(ctx, u.ID, now)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+
+		err = r.Delete(ctx, u.ID, m.ID)
+		require.NoError(t, err)
+		got, err = r.GetPending(ctx, u.ID, now)
+		require.NoError(t, err)
+		require.Len(t, got, 0)
+	})
+}
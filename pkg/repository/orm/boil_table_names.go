@@ -4,9 +4,29 @@
 package orm
 
 var TableNames = struct {
-	GorpMigrations string
-	Users          string
+	Campaigns        string
+	Jobs             string
+	MailCheckpoints  string
+	MailDeliveries   string
+	MailOutbox       string
+	MailPreferences  string
+	MailSuppressions string
+	OfflineMessages  string
+	RealtimeTickets  string
+	Schedules        string
+	UserDevices      string
+	Users            string
 }{
-	GorpMigrations: "gorp_migrations",
-	Users:          "users",
+	Campaigns:        "campaigns",
+	Jobs:             "jobs",
+	MailCheckpoints:  "mail_checkpoints",
+	MailDeliveries:   "mail_deliveries",
+	MailOutbox:       "mail_outbox",
+	MailPreferences:  "mail_preferences",
+	MailSuppressions: "mail_suppressions",
+	OfflineMessages:  "offline_messages",
+	RealtimeTickets:  "realtime_tickets",
+	Schedules:        "schedules",
+	UserDevices:      "user_devices",
+	Users:            "users",
 }
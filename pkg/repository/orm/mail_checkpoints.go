@@ -0,0 +1,744 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MailCheckpoint is an object representing the database table.
+type MailCheckpoint struct {
+	Campaign   string    `boil:"campaign" json:"campaign" toml:"campaign" yaml:"campaign"`
+	LastUserID int       `boil:"last_user_id" json:"last_user_id" toml:"last_user_id" yaml:"last_user_id"`
+	Sent       int       `boil:"sent" json:"sent" toml:"sent" yaml:"sent"`
+	Skipped    int       `boil:"skipped" json:"skipped" toml:"skipped" yaml:"skipped"`
+	Failed     int       `boil:"failed" json:"failed" toml:"failed" yaml:"failed"`
+	StartedAt  time.Time `boil:"started_at" json:"started_at" toml:"started_at" yaml:"started_at"`
+	FinishedAt null.Time `boil:"finished_at" json:"finished_at,omitempty" toml:"finished_at" yaml:"finished_at,omitempty"`
+	UpdatedAt  time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedBy  null.Int  `boil:"created_by" json:"created_by,omitempty" toml:"created_by" yaml:"created_by,omitempty"`
+	UpdatedBy  null.Int  `boil:"updated_by" json:"updated_by,omitempty" toml:"updated_by" yaml:"updated_by,omitempty"`
+
+	R *mailCheckpointR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L mailCheckpointL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MailCheckpointColumns = struct {
+	Campaign   string
+	LastUserID string
+	Sent       string
+	Skipped    string
+	Failed     string
+	StartedAt  string
+	FinishedAt string
+	UpdatedAt  string
+	CreatedBy  string
+	UpdatedBy  string
+}{
+	Campaign:   "campaign",
+	LastUserID: "last_user_id",
+	Sent:       "sent",
+	Skipped:    "skipped",
+	Failed:     "failed",
+	StartedAt:  "started_at",
+	FinishedAt: "finished_at",
+	UpdatedAt:  "updated_at",
+	CreatedBy:  "created_by",
+	UpdatedBy:  "updated_by",
+}
+
+var MailCheckpointTableColumns = struct {
+	Campaign   string
+	LastUserID string
+	Sent       string
+	Skipped    string
+	Failed     string
+	StartedAt  string
+	FinishedAt string
+	UpdatedAt  string
+	CreatedBy  string
+	UpdatedBy  string
+}{
+	Campaign:   "mail_checkpoints.campaign",
+	LastUserID: "mail_checkpoints.last_user_id",
+	Sent:       "mail_checkpoints.sent",
+	Skipped:    "mail_checkpoints.skipped",
+	Failed:     "mail_checkpoints.failed",
+	StartedAt:  "mail_checkpoints.started_at",
+	FinishedAt: "mail_checkpoints.finished_at",
+	UpdatedAt:  "mail_checkpoints.updated_at",
+	CreatedBy:  "mail_checkpoints.created_by",
+	UpdatedBy:  "mail_checkpoints.updated_by",
+}
+
+// Generated where
+
+var MailCheckpointWhere = struct {
+	Campaign   whereHelperstring
+	LastUserID whereHelperint
+	Sent       whereHelperint
+	Skipped    whereHelperint
+	Failed     whereHelperint
+	StartedAt  whereHelpertime_Time
+	FinishedAt whereHelpernull_Time
+	UpdatedAt  whereHelpertime_Time
+	CreatedBy  whereHelpernull_Int
+	UpdatedBy  whereHelpernull_Int
+}{
+	Campaign:   whereHelperstring{field: "\"mail_checkpoints\".\"campaign\""},
+	LastUserID: whereHelperint{field: "\"mail_checkpoints\".\"last_user_id\""},
+	Sent:       whereHelperint{field: "\"mail_checkpoints\".\"sent\""},
+	Skipped:    whereHelperint{field: "\"mail_checkpoints\".\"skipped\""},
+	Failed:     whereHelperint{field: "\"mail_checkpoints\".\"failed\""},
+	StartedAt:  whereHelpertime_Time{field: "\"mail_checkpoints\".\"started_at\""},
+	FinishedAt: whereHelpernull_Time{field: "\"mail_checkpoints\".\"finished_at\""},
+	UpdatedAt:  whereHelpertime_Time{field: "\"mail_checkpoints\".\"updated_at\""},
+	CreatedBy:  whereHelpernull_Int{field: "\"mail_checkpoints\".\"created_by\""},
+	UpdatedBy:  whereHelpernull_Int{field: "\"mail_checkpoints\".\"updated_by\""},
+}
+
+// MailCheckpointRels is where relationship names are stored.
+var MailCheckpointRels = struct {
+}{}
+
+// mailCheckpointR is where relationships are stored.
+type mailCheckpointR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*mailCheckpointR) NewStruct() *mailCheckpointR {
+	return &mailCheckpointR{}
+}
+
+// mailCheckpointL is where Load methods for each relationship are stored.
+type mailCheckpointL struct{}
+
+var (
+	mailCheckpointAllColumns            = []string{"campaign", "last_user_id", "sent", "skipped", "failed", "started_at", "finished_at", "updated_at", "created_by", "updated_by"}
+	mailCheckpointColumnsWithoutDefault = []string{"campaign"}
+	mailCheckpointColumnsWithDefault    = []string{"last_user_id", "sent", "skipped", "failed", "started_at", "finished_at", "updated_at", "created_by", "updated_by"}
+	mailCheckpointPrimaryKeyColumns     = []string{"campaign"}
+	mailCheckpointGeneratedColumns      = []string{}
+)
+
+type (
+	// MailCheckpointSlice is an alias for a slice of pointers to MailCheckpoint.
+	// This should almost always be used instead of []MailCheckpoint.
+	MailCheckpointSlice []*MailCheckpoint
+
+	mailCheckpointQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	mailCheckpointType                 = reflect.TypeOf(&MailCheckpoint{})
+	mailCheckpointMapping              = queries.MakeStructMapping(mailCheckpointType)
+	mailCheckpointPrimaryKeyMapping, _ = queries.BindMapping(mailCheckpointType, mailCheckpointMapping, mailCheckpointPrimaryKeyColumns)
+	mailCheckpointInsertCacheMut       sync.RWMutex
+	mailCheckpointInsertCache          = make(map[string]insertCache)
+	mailCheckpointUpdateCacheMut       sync.RWMutex
+	mailCheckpointUpdateCache          = make(map[string]updateCache)
+	mailCheckpointUpsertCacheMut       sync.RWMutex
+	mailCheckpointUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single mailCheckpoint record from the query.
+func (q mailCheckpointQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MailCheckpoint, error) {
+	o := &MailCheckpoint{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for mail_checkpoints")
+	}
+
+	return o, nil
+}
+
+// All returns all MailCheckpoint records from the query.
+func (q mailCheckpointQuery) All(ctx context.Context, exec boil.ContextExecutor) (MailCheckpointSlice, error) {
+	var o []*MailCheckpoint
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to MailCheckpoint slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MailCheckpoint records in the query.
+func (q mailCheckpointQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count mail_checkpoints rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q mailCheckpointQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if mail_checkpoints exists")
+	}
+
+	return count > 0, nil
+}
+
+// MailCheckpoints retrieves all the records using an executor.
+func MailCheckpoints(mods ...qm.QueryMod) mailCheckpointQuery {
+	mods = append(mods, qm.From("\"mail_checkpoints\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"mail_checkpoints\".*"})
+	}
+
+	return mailCheckpointQuery{q}
+}
+
+// FindMailCheckpoint retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMailCheckpoint(ctx context.Context, exec boil.ContextExecutor, campaign string, selectCols ...string) (*MailCheckpoint, error) {
+	mailCheckpointObj := &MailCheckpoint{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"mail_checkpoints\" where \"campaign\"=$1", sel,
+	)
+
+	q := queries.Raw(query, campaign)
+
+	err := q.Bind(ctx, exec, mailCheckpointObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from mail_checkpoints")
+	}
+
+	return mailCheckpointObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MailCheckpoint) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no mail_checkpoints provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mailCheckpointColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	mailCheckpointInsertCacheMut.RLock()
+	cache, cached := mailCheckpointInsertCache[key]
+	mailCheckpointInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			mailCheckpointAllColumns,
+			mailCheckpointColumnsWithDefault,
+			mailCheckpointColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(mailCheckpointType, mailCheckpointMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(mailCheckpointType, mailCheckpointMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"mail_checkpoints\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"mail_checkpoints\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into mail_checkpoints")
+	}
+
+	if !cached {
+		mailCheckpointInsertCacheMut.Lock()
+		mailCheckpointInsertCache[key] = cache
+		mailCheckpointInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the MailCheckpoint.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MailCheckpoint) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	mailCheckpointUpdateCacheMut.RLock()
+	cache, cached := mailCheckpointUpdateCache[key]
+	mailCheckpointUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			mailCheckpointAllColumns,
+			mailCheckpointPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update mail_checkpoints, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"mail_checkpoints\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, mailCheckpointPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(mailCheckpointType, mailCheckpointMapping, append(wl, mailCheckpointPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update mail_checkpoints row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for mail_checkpoints")
+	}
+
+	if !cached {
+		mailCheckpointUpdateCacheMut.Lock()
+		mailCheckpointUpdateCache[key] = cache
+		mailCheckpointUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q mailCheckpointQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for mail_checkpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for mail_checkpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MailCheckpointSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mailCheckpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"mail_checkpoints\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, mailCheckpointPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in mailCheckpoint slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all mailCheckpoint")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MailCheckpoint) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no mail_checkpoints provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mailCheckpointColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	mailCheckpointUpsertCacheMut.RLock()
+	cache, cached := mailCheckpointUpsertCache[key]
+	mailCheckpointUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			mailCheckpointAllColumns,
+			mailCheckpointColumnsWithDefault,
+			mailCheckpointColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			mailCheckpointAllColumns,
+			mailCheckpointPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert mail_checkpoints, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(mailCheckpointPrimaryKeyColumns))
+			copy(conflict, mailCheckpointPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"mail_checkpoints\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(mailCheckpointType, mailCheckpointMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(mailCheckpointType, mailCheckpointMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert mail_checkpoints")
+	}
+
+	if !cached {
+		mailCheckpointUpsertCacheMut.Lock()
+		mailCheckpointUpsertCache[key] = cache
+		mailCheckpointUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single MailCheckpoint record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MailCheckpoint) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no MailCheckpoint provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), mailCheckpointPrimaryKeyMapping)
+	sql := "DELETE FROM \"mail_checkpoints\" WHERE \"campaign\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from mail_checkpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for mail_checkpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q mailCheckpointQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no mailCheckpointQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from mail_checkpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for mail_checkpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MailCheckpointSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mailCheckpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"mail_checkpoints\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, mailCheckpointPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from mailCheckpoint slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for mail_checkpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MailCheckpoint) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMailCheckpoint(ctx, exec, o.Campaign)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MailCheckpointSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MailCheckpointSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mailCheckpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"mail_checkpoints\".* FROM \"mail_checkpoints\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, mailCheckpointPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in MailCheckpointSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MailCheckpointExists checks if the MailCheckpoint row exists.
+func MailCheckpointExists(ctx context.Context, exec boil.ContextExecutor, campaign string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"mail_checkpoints\" where \"campaign\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, campaign)
+	}
+	row := exec.QueryRowContext(ctx, sql, campaign)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if mail_checkpoints exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the MailCheckpoint row exists.
+func (o *MailCheckpoint) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return MailCheckpointExists(ctx, exec, o.Campaign)
+}
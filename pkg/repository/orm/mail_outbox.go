@@ -0,0 +1,813 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MailOutbox is an object representing the database table.
+type MailOutbox struct {
+	ID            int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Template      string      `boil:"template" json:"template" toml:"template" yaml:"template"`
+	Recipient     string      `boil:"recipient" json:"recipient" toml:"recipient" yaml:"recipient"`
+	Subject       string      `boil:"subject" json:"subject" toml:"subject" yaml:"subject"`
+	HTML          string      `boil:"html" json:"html" toml:"html" yaml:"html"`
+	Text          string      `boil:"text" json:"text" toml:"text" yaml:"text"`
+	MessageID     string      `boil:"message_id" json:"message_id" toml:"message_id" yaml:"message_id"`
+	Status        string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Attempts      int         `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	MaxAttempts   int         `boil:"max_attempts" json:"max_attempts" toml:"max_attempts" yaml:"max_attempts"`
+	NextAttemptAt time.Time   `boil:"next_attempt_at" json:"next_attempt_at" toml:"next_attempt_at" yaml:"next_attempt_at"`
+	LockedAt      null.Time   `boil:"locked_at" json:"locked_at,omitempty" toml:"locked_at" yaml:"locked_at,omitempty"`
+	LockedBy      null.String `boil:"locked_by" json:"locked_by,omitempty" toml:"locked_by" yaml:"locked_by,omitempty"`
+	LastError     null.String `boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	SentAt        null.Time   `boil:"sent_at" json:"sent_at,omitempty" toml:"sent_at" yaml:"sent_at,omitempty"`
+	CreatedAt     time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedBy     null.Int    `boil:"created_by" json:"created_by,omitempty" toml:"created_by" yaml:"created_by,omitempty"`
+	UpdatedBy     null.Int    `boil:"updated_by" json:"updated_by,omitempty" toml:"updated_by" yaml:"updated_by,omitempty"`
+
+	R *mailOutboxR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L mailOutboxL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MailOutboxColumns = struct {
+	ID            string
+	Template      string
+	Recipient     string
+	Subject       string
+	HTML          string
+	Text          string
+	MessageID     string
+	Status        string
+	Attempts      string
+	MaxAttempts   string
+	NextAttemptAt string
+	LockedAt      string
+	LockedBy      string
+	LastError     string
+	SentAt        string
+	CreatedAt     string
+	UpdatedAt     string
+	CreatedBy     string
+	UpdatedBy     string
+}{
+	ID:            "id",
+	Template:      "template",
+	Recipient:     "recipient",
+	Subject:       "subject",
+	HTML:          "html",
+	Text:          "text",
+	MessageID:     "message_id",
+	Status:        "status",
+	Attempts:      "attempts",
+	MaxAttempts:   "max_attempts",
+	NextAttemptAt: "next_attempt_at",
+	LockedAt:      "locked_at",
+	LockedBy:      "locked_by",
+	LastError:     "last_error",
+	SentAt:        "sent_at",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+	CreatedBy:     "created_by",
+	UpdatedBy:     "updated_by",
+}
+
+var MailOutboxTableColumns = struct {
+	ID            string
+	Template      string
+	Recipient     string
+	Subject       string
+	HTML          string
+	Text          string
+	MessageID     string
+	Status        string
+	Attempts      string
+	MaxAttempts   string
+	NextAttemptAt string
+	LockedAt      string
+	LockedBy      string
+	LastError     string
+	SentAt        string
+	CreatedAt     string
+	UpdatedAt     string
+	CreatedBy     string
+	UpdatedBy     string
+}{
+	ID:            "mail_outbox.id",
+	Template:      "mail_outbox.template",
+	Recipient:     "mail_outbox.recipient",
+	Subject:       "mail_outbox.subject",
+	HTML:          "mail_outbox.html",
+	Text:          "mail_outbox.text",
+	MessageID:     "mail_outbox.message_id",
+	Status:        "mail_outbox.status",
+	Attempts:      "mail_outbox.attempts",
+	MaxAttempts:   "mail_outbox.max_attempts",
+	NextAttemptAt: "mail_outbox.next_attempt_at",
+	LockedAt:      "mail_outbox.locked_at",
+	LockedBy:      "mail_outbox.locked_by",
+	LastError:     "mail_outbox.last_error",
+	SentAt:        "mail_outbox.sent_at",
+	CreatedAt:     "mail_outbox.created_at",
+	UpdatedAt:     "mail_outbox.updated_at",
+	CreatedBy:     "mail_outbox.created_by",
+	UpdatedBy:     "mail_outbox.updated_by",
+}
+
+// Generated where
+
+var MailOutboxWhere = struct {
+	ID            whereHelperint64
+	Template      whereHelperstring
+	Recipient     whereHelperstring
+	Subject       whereHelperstring
+	HTML          whereHelperstring
+	Text          whereHelperstring
+	MessageID     whereHelperstring
+	Status        whereHelperstring
+	Attempts      whereHelperint
+	MaxAttempts   whereHelperint
+	NextAttemptAt whereHelpertime_Time
+	LockedAt      whereHelpernull_Time
+	LockedBy      whereHelpernull_String
+	LastError     whereHelpernull_String
+	SentAt        whereHelpernull_Time
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+	CreatedBy     whereHelpernull_Int
+	UpdatedBy     whereHelpernull_Int
+}{
+	ID:            whereHelperint64{field: "\"mail_outbox\".\"id\""},
+	Template:      whereHelperstring{field: "\"mail_outbox\".\"template\""},
+	Recipient:     whereHelperstring{field: "\"mail_outbox\".\"recipient\""},
+	Subject:       whereHelperstring{field: "\"mail_outbox\".\"subject\""},
+	HTML:          whereHelperstring{field: "\"mail_outbox\".\"html\""},
+	Text:          whereHelperstring{field: "\"mail_outbox\".\"text\""},
+	MessageID:     whereHelperstring{field: "\"mail_outbox\".\"message_id\""},
+	Status:        whereHelperstring{field: "\"mail_outbox\".\"status\""},
+	Attempts:      whereHelperint{field: "\"mail_outbox\".\"attempts\""},
+	MaxAttempts:   whereHelperint{field: "\"mail_outbox\".\"max_attempts\""},
+	NextAttemptAt: whereHelpertime_Time{field: "\"mail_outbox\".\"next_attempt_at\""},
+	LockedAt:      whereHelpernull_Time{field: "\"mail_outbox\".\"locked_at\""},
+	LockedBy:      whereHelpernull_String{field: "\"mail_outbox\".\"locked_by\""},
+	LastError:     whereHelpernull_String{field: "\"mail_outbox\".\"last_error\""},
+	SentAt:        whereHelpernull_Time{field: "\"mail_outbox\".\"sent_at\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"mail_outbox\".\"created_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"mail_outbox\".\"updated_at\""},
+	CreatedBy:     whereHelpernull_Int{field: "\"mail_outbox\".\"created_by\""},
+	UpdatedBy:     whereHelpernull_Int{field: "\"mail_outbox\".\"updated_by\""},
+}
+
+// MailOutboxRels is where relationship names are stored.
+var MailOutboxRels = struct {
+}{}
+
+// mailOutboxR is where relationships are stored.
+type mailOutboxR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*mailOutboxR) NewStruct() *mailOutboxR {
+	return &mailOutboxR{}
+}
+
+// mailOutboxL is where Load methods for each relationship are stored.
+type mailOutboxL struct{}
+
+var (
+	mailOutboxAllColumns            = []string{"id", "template", "recipient", "subject", "html", "text", "message_id", "status", "attempts", "max_attempts", "next_attempt_at", "locked_at", "locked_by", "last_error", "sent_at", "created_at", "updated_at", "created_by", "updated_by"}
+	mailOutboxColumnsWithoutDefault = []string{"template", "recipient", "message_id"}
+	mailOutboxColumnsWithDefault    = []string{"id", "subject", "html", "text", "status", "attempts", "max_attempts", "next_attempt_at", "locked_at", "locked_by", "last_error", "sent_at", "created_at", "updated_at", "created_by", "updated_by"}
+	mailOutboxPrimaryKeyColumns     = []string{"id"}
+	mailOutboxGeneratedColumns      = []string{}
+)
+
+type (
+	// MailOutboxSlice is an alias for a slice of pointers to MailOutbox.
+	// This should almost always be used instead of []MailOutbox.
+	MailOutboxSlice []*MailOutbox
+
+	mailOutboxQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	mailOutboxType                 = reflect.TypeOf(&MailOutbox{})
+	mailOutboxMapping              = queries.MakeStructMapping(mailOutboxType)
+	mailOutboxPrimaryKeyMapping, _ = queries.BindMapping(mailOutboxType, mailOutboxMapping, mailOutboxPrimaryKeyColumns)
+	mailOutboxInsertCacheMut       sync.RWMutex
+	mailOutboxInsertCache          = make(map[string]insertCache)
+	mailOutboxUpdateCacheMut       sync.RWMutex
+	mailOutboxUpdateCache          = make(map[string]updateCache)
+	mailOutboxUpsertCacheMut       sync.RWMutex
+	mailOutboxUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single mailOutbox record from the query.
+func (q mailOutboxQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MailOutbox, error) {
+	o := &MailOutbox{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for mail_outbox")
+	}
+
+	return o, nil
+}
+
+// All returns all MailOutbox records from the query.
+func (q mailOutboxQuery) All(ctx context.Context, exec boil.ContextExecutor) (MailOutboxSlice, error) {
+	var o []*MailOutbox
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to MailOutbox slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MailOutbox records in the query.
+func (q mailOutboxQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count mail_outbox rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q mailOutboxQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if mail_outbox exists")
+	}
+
+	return count > 0, nil
+}
+
+// MailOutboxes retrieves all the records using an executor.
+func MailOutboxes(mods ...qm.QueryMod) mailOutboxQuery {
+	mods = append(mods, qm.From("\"mail_outbox\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"mail_outbox\".*"})
+	}
+
+	return mailOutboxQuery{q}
+}
+
+// FindMailOutbox retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMailOutbox(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*MailOutbox, error) {
+	mailOutboxObj := &MailOutbox{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"mail_outbox\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, mailOutboxObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from mail_outbox")
+	}
+
+	return mailOutboxObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MailOutbox) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no mail_outbox provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mailOutboxColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	mailOutboxInsertCacheMut.RLock()
+	cache, cached := mailOutboxInsertCache[key]
+	mailOutboxInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			mailOutboxAllColumns,
+			mailOutboxColumnsWithDefault,
+			mailOutboxColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(mailOutboxType, mailOutboxMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(mailOutboxType, mailOutboxMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"mail_outbox\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"mail_outbox\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into mail_outbox")
+	}
+
+	if !cached {
+		mailOutboxInsertCacheMut.Lock()
+		mailOutboxInsertCache[key] = cache
+		mailOutboxInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the MailOutbox.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MailOutbox) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	mailOutboxUpdateCacheMut.RLock()
+	cache, cached := mailOutboxUpdateCache[key]
+	mailOutboxUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			mailOutboxAllColumns,
+			mailOutboxPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update mail_outbox, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"mail_outbox\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, mailOutboxPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(mailOutboxType, mailOutboxMapping, append(wl, mailOutboxPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update mail_outbox row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for mail_outbox")
+	}
+
+	if !cached {
+		mailOutboxUpdateCacheMut.Lock()
+		mailOutboxUpdateCache[key] = cache
+		mailOutboxUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q mailOutboxQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for mail_outbox")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for mail_outbox")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MailOutboxSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mailOutboxPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"mail_outbox\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, mailOutboxPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in mailOutbox slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all mailOutbox")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MailOutbox) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no mail_outbox provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mailOutboxColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	mailOutboxUpsertCacheMut.RLock()
+	cache, cached := mailOutboxUpsertCache[key]
+	mailOutboxUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			mailOutboxAllColumns,
+			mailOutboxColumnsWithDefault,
+			mailOutboxColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			mailOutboxAllColumns,
+			mailOutboxPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert mail_outbox, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(mailOutboxPrimaryKeyColumns))
+			copy(conflict, mailOutboxPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"mail_outbox\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(mailOutboxType, mailOutboxMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(mailOutboxType, mailOutboxMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert mail_outbox")
+	}
+
+	if !cached {
+		mailOutboxUpsertCacheMut.Lock()
+		mailOutboxUpsertCache[key] = cache
+		mailOutboxUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single MailOutbox record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MailOutbox) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no MailOutbox provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), mailOutboxPrimaryKeyMapping)
+	sql := "DELETE FROM \"mail_outbox\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from mail_outbox")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for mail_outbox")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q mailOutboxQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no mailOutboxQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from mail_outbox")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for mail_outbox")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MailOutboxSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mailOutboxPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"mail_outbox\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, mailOutboxPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from mailOutbox slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for mail_outbox")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MailOutbox) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMailOutbox(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MailOutboxSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MailOutboxSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mailOutboxPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"mail_outbox\".* FROM \"mail_outbox\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, mailOutboxPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in MailOutboxSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MailOutboxExists checks if the MailOutbox row exists.
+func MailOutboxExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"mail_outbox\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if mail_outbox exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the MailOutbox row exists.
+func (o *MailOutbox) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return MailOutboxExists(ctx, exec, o.ID)
+}
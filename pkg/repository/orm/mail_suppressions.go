@@ -0,0 +1,729 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MailSuppression is an object representing the database table.
+type MailSuppression struct {
+	Email     string      `boil:"email" json:"email" toml:"email" yaml:"email"`
+	Reason    string      `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	Detail    null.String `boil:"detail" json:"detail,omitempty" toml:"detail" yaml:"detail,omitempty"`
+	CreatedAt time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	CreatedBy null.Int    `boil:"created_by" json:"created_by,omitempty" toml:"created_by" yaml:"created_by,omitempty"`
+	UpdatedBy null.Int    `boil:"updated_by" json:"updated_by,omitempty" toml:"updated_by" yaml:"updated_by,omitempty"`
+
+	R *mailSuppressionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L mailSuppressionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MailSuppressionColumns = struct {
+	Email     string
+	Reason    string
+	Detail    string
+	CreatedAt string
+	UpdatedAt string
+	CreatedBy string
+	UpdatedBy string
+}{
+	Email:     "email",
+	Reason:    "reason",
+	Detail:    "detail",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+	CreatedBy: "created_by",
+	UpdatedBy: "updated_by",
+}
+
+var MailSuppressionTableColumns = struct {
+	Email     string
+	Reason    string
+	Detail    string
+	CreatedAt string
+	UpdatedAt string
+	CreatedBy string
+	UpdatedBy string
+}{
+	Email:     "mail_suppressions.email",
+	Reason:    "mail_suppressions.reason",
+	Detail:    "mail_suppressions.detail",
+	CreatedAt: "mail_suppressions.created_at",
+	UpdatedAt: "mail_suppressions.updated_at",
+	CreatedBy: "mail_suppressions.created_by",
+	UpdatedBy: "mail_suppressions.updated_by",
+}
+
+// Generated where
+
+var MailSuppressionWhere = struct {
+	Email     whereHelperstring
+	Reason    whereHelperstring
+	Detail    whereHelpernull_String
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+	CreatedBy whereHelpernull_Int
+	UpdatedBy whereHelpernull_Int
+}{
+	Email:     whereHelperstring{field: "\"mail_suppressions\".\"email\""},
+	Reason:    whereHelperstring{field: "\"mail_suppressions\".\"reason\""},
+	Detail:    whereHelpernull_String{field: "\"mail_suppressions\".\"detail\""},
+	CreatedAt: whereHelpertime_Time{field: "\"mail_suppressions\".\"created_at\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"mail_suppressions\".\"updated_at\""},
+	CreatedBy: whereHelpernull_Int{field: "\"mail_suppressions\".\"created_by\""},
+	UpdatedBy: whereHelpernull_Int{field: "\"mail_suppressions\".\"updated_by\""},
+}
+
+// MailSuppressionRels is where relationship names are stored.
+var MailSuppressionRels = struct {
+}{}
+
+// mailSuppressionR is where relationships are stored.
+type mailSuppressionR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*mailSuppressionR) NewStruct() *mailSuppressionR {
+	return &mailSuppressionR{}
+}
+
+// mailSuppressionL is where Load methods for each relationship are stored.
+type mailSuppressionL struct{}
+
+var (
+	mailSuppressionAllColumns            = []string{"email", "reason", "detail", "created_at", "updated_at", "created_by", "updated_by"}
+	mailSuppressionColumnsWithoutDefault = []string{"email", "reason"}
+	mailSuppressionColumnsWithDefault    = []string{"detail", "created_at", "updated_at", "created_by", "updated_by"}
+	mailSuppressionPrimaryKeyColumns     = []string{"email"}
+	mailSuppressionGeneratedColumns      = []string{}
+)
+
+type (
+	// MailSuppressionSlice is an alias for a slice of pointers to MailSuppression.
+	// This should almost always be used instead of []MailSuppression.
+	MailSuppressionSlice []*MailSuppression
+
+	mailSuppressionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	mailSuppressionType                 = reflect.TypeOf(&MailSuppression{})
+	mailSuppressionMapping              = queries.MakeStructMapping(mailSuppressionType)
+	mailSuppressionPrimaryKeyMapping, _ = queries.BindMapping(mailSuppressionType, mailSuppressionMapping, mailSuppressionPrimaryKeyColumns)
+	mailSuppressionInsertCacheMut       sync.RWMutex
+	mailSuppressionInsertCache          = make(map[string]insertCache)
+	mailSuppressionUpdateCacheMut       sync.RWMutex
+	mailSuppressionUpdateCache          = make(map[string]updateCache)
+	mailSuppressionUpsertCacheMut       sync.RWMutex
+	mailSuppressionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single mailSuppression record from the query.
+func (q mailSuppressionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MailSuppression, error) {
+	o := &MailSuppression{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for mail_suppressions")
+	}
+
+	return o, nil
+}
+
+// All returns all MailSuppression records from the query.
+func (q mailSuppressionQuery) All(ctx context.Context, exec boil.ContextExecutor) (MailSuppressionSlice, error) {
+	var o []*MailSuppression
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to MailSuppression slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MailSuppression records in the query.
+func (q mailSuppressionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count mail_suppressions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q mailSuppressionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if mail_suppressions exists")
+	}
+
+	return count > 0, nil
+}
+
+// MailSuppressions retrieves all the records using an executor.
+func MailSuppressions(mods ...qm.QueryMod) mailSuppressionQuery {
+	mods = append(mods, qm.From("\"mail_suppressions\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"mail_suppressions\".*"})
+	}
+
+	return mailSuppressionQuery{q}
+}
+
+// FindMailSuppression retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMailSuppression(ctx context.Context, exec boil.ContextExecutor, email string, selectCols ...string) (*MailSuppression, error) {
+	mailSuppressionObj := &MailSuppression{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"mail_suppressions\" where \"email\"=$1", sel,
+	)
+
+	q := queries.Raw(query, email)
+
+	err := q.Bind(ctx, exec, mailSuppressionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from mail_suppressions")
+	}
+
+	return mailSuppressionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MailSuppression) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no mail_suppressions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mailSuppressionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	mailSuppressionInsertCacheMut.RLock()
+	cache, cached := mailSuppressionInsertCache[key]
+	mailSuppressionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			mailSuppressionAllColumns,
+			mailSuppressionColumnsWithDefault,
+			mailSuppressionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(mailSuppressionType, mailSuppressionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(mailSuppressionType, mailSuppressionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"mail_suppressions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"mail_suppressions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into mail_suppressions")
+	}
+
+	if !cached {
+		mailSuppressionInsertCacheMut.Lock()
+		mailSuppressionInsertCache[key] = cache
+		mailSuppressionInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the MailSuppression.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MailSuppression) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	mailSuppressionUpdateCacheMut.RLock()
+	cache, cached := mailSuppressionUpdateCache[key]
+	mailSuppressionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			mailSuppressionAllColumns,
+			mailSuppressionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update mail_suppressions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"mail_suppressions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, mailSuppressionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(mailSuppressionType, mailSuppressionMapping, append(wl, mailSuppressionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update mail_suppressions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for mail_suppressions")
+	}
+
+	if !cached {
+		mailSuppressionUpdateCacheMut.Lock()
+		mailSuppressionUpdateCache[key] = cache
+		mailSuppressionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q mailSuppressionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for mail_suppressions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for mail_suppressions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MailSuppressionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mailSuppressionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"mail_suppressions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, mailSuppressionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in mailSuppression slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all mailSuppression")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MailSuppression) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no mail_suppressions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mailSuppressionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	mailSuppressionUpsertCacheMut.RLock()
+	cache, cached := mailSuppressionUpsertCache[key]
+	mailSuppressionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			mailSuppressionAllColumns,
+			mailSuppressionColumnsWithDefault,
+			mailSuppressionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			mailSuppressionAllColumns,
+			mailSuppressionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert mail_suppressions, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(mailSuppressionPrimaryKeyColumns))
+			copy(conflict, mailSuppressionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"mail_suppressions\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(mailSuppressionType, mailSuppressionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(mailSuppressionType, mailSuppressionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert mail_suppressions")
+	}
+
+	if !cached {
+		mailSuppressionUpsertCacheMut.Lock()
+		mailSuppressionUpsertCache[key] = cache
+		mailSuppressionUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single MailSuppression record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MailSuppression) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no MailSuppression provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), mailSuppressionPrimaryKeyMapping)
+	sql := "DELETE FROM \"mail_suppressions\" WHERE \"email\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from mail_suppressions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for mail_suppressions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q mailSuppressionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no mailSuppressionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from mail_suppressions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for mail_suppressions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MailSuppressionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mailSuppressionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"mail_suppressions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, mailSuppressionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from mailSuppression slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for mail_suppressions")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MailSuppression) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMailSuppression(ctx, exec, o.Email)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MailSuppressionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MailSuppressionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mailSuppressionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"mail_suppressions\".* FROM \"mail_suppressions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, mailSuppressionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in MailSuppressionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MailSuppressionExists checks if the MailSuppression row exists.
+func MailSuppressionExists(ctx context.Context, exec boil.ContextExecutor, email string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"mail_suppressions\" where \"email\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, email)
+	}
+	row := exec.QueryRowContext(ctx, sql, email)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if mail_suppressions exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the MailSuppression row exists.
+func (o *MailSuppression) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return MailSuppressionExists(ctx, exec, o.Email)
+}
@@ -0,0 +1,878 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// RealtimeTicket is an object representing the database table.
+type RealtimeTicket struct {
+	TokenHash string    `boil:"token_hash" json:"token_hash" toml:"token_hash" yaml:"token_hash"`
+	UserID    int       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	ExpiresAt time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *realtimeTicketR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L realtimeTicketL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RealtimeTicketColumns = struct {
+	TokenHash string
+	UserID    string
+	ExpiresAt string
+	CreatedAt string
+}{
+	TokenHash: "token_hash",
+	UserID:    "user_id",
+	ExpiresAt: "expires_at",
+	CreatedAt: "created_at",
+}
+
+var RealtimeTicketTableColumns = struct {
+	TokenHash string
+	UserID    string
+	ExpiresAt string
+	CreatedAt string
+}{
+	TokenHash: "realtime_tickets.token_hash",
+	UserID:    "realtime_tickets.user_id",
+	ExpiresAt: "realtime_tickets.expires_at",
+	CreatedAt: "realtime_tickets.created_at",
+}
+
+// Generated where
+
+var RealtimeTicketWhere = struct {
+	TokenHash whereHelperstring
+	UserID    whereHelperint
+	ExpiresAt whereHelpertime_Time
+	CreatedAt whereHelpertime_Time
+}{
+	TokenHash: whereHelperstring{field: "\"realtime_tickets\".\"token_hash\""},
+	UserID:    whereHelperint{field: "\"realtime_tickets\".\"user_id\""},
+	ExpiresAt: whereHelpertime_Time{field: "\"realtime_tickets\".\"expires_at\""},
+	CreatedAt: whereHelpertime_Time{field: "\"realtime_tickets\".\"created_at\""},
+}
+
+// RealtimeTicketRels is where relationship names are stored.
+var RealtimeTicketRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// realtimeTicketR is where relationships are stored.
+type realtimeTicketR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*realtimeTicketR) NewStruct() *realtimeTicketR {
+	return &realtimeTicketR{}
+}
+
+func (r *realtimeTicketR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// realtimeTicketL is where Load methods for each relationship are stored.
+type realtimeTicketL struct{}
+
+var (
+	realtimeTicketAllColumns            = []string{"token_hash", "user_id", "expires_at", "created_at"}
+	realtimeTicketColumnsWithoutDefault = []string{"token_hash", "user_id", "expires_at"}
+	realtimeTicketColumnsWithDefault    = []string{"created_at"}
+	realtimeTicketPrimaryKeyColumns     = []string{"token_hash"}
+	realtimeTicketGeneratedColumns      = []string{}
+)
+
+type (
+	// RealtimeTicketSlice is an alias for a slice of pointers to RealtimeTicket.
+	// This should almost always be used instead of []RealtimeTicket.
+	RealtimeTicketSlice []*RealtimeTicket
+
+	realtimeTicketQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	realtimeTicketType                 = reflect.TypeOf(&RealtimeTicket{})
+	realtimeTicketMapping              = queries.MakeStructMapping(realtimeTicketType)
+	realtimeTicketPrimaryKeyMapping, _ = queries.BindMapping(realtimeTicketType, realtimeTicketMapping, realtimeTicketPrimaryKeyColumns)
+	realtimeTicketInsertCacheMut       sync.RWMutex
+	realtimeTicketInsertCache          = make(map[string]insertCache)
+	realtimeTicketUpdateCacheMut       sync.RWMutex
+	realtimeTicketUpdateCache          = make(map[string]updateCache)
+	realtimeTicketUpsertCacheMut       sync.RWMutex
+	realtimeTicketUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single realtimeTicket record from the query.
+func (q realtimeTicketQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RealtimeTicket, error) {
+	o := &RealtimeTicket{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for realtime_tickets")
+	}
+
+	return o, nil
+}
+
+// All returns all RealtimeTicket records from the query.
+func (q realtimeTicketQuery) All(ctx context.Context, exec boil.ContextExecutor) (RealtimeTicketSlice, error) {
+	var o []*RealtimeTicket
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to RealtimeTicket slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all RealtimeTicket records in the query.
+func (q realtimeTicketQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count realtime_tickets rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q realtimeTicketQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if realtime_tickets exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *RealtimeTicket) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (realtimeTicketL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRealtimeTicket interface{}, mods queries.Applicator) error {
+	var slice []*RealtimeTicket
+	var object *RealtimeTicket
+
+	if singular {
+		var ok bool
+		object, ok = maybeRealtimeTicket.(*RealtimeTicket)
+		if !ok {
+			object = new(RealtimeTicket)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRealtimeTicket)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRealtimeTicket))
+			}
+		}
+	} else {
+		s, ok := maybeRealtimeTicket.(*[]*RealtimeTicket)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRealtimeTicket)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRealtimeTicket))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &realtimeTicketR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &realtimeTicketR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.RealtimeTickets = append(foreign.R.RealtimeTickets, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.RealtimeTickets = append(foreign.R.RealtimeTickets, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the realtimeTicket to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.RealtimeTickets.
+func (o *RealtimeTicket) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"realtime_tickets\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, realtimeTicketPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.TokenHash}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &realtimeTicketR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			RealtimeTickets: RealtimeTicketSlice{o},
+		}
+	} else {
+		related.R.RealtimeTickets = append(related.R.RealtimeTickets, o)
+	}
+
+	return nil
+}
+
+// RealtimeTickets retrieves all the records using an executor.
+func RealtimeTickets(mods ...qm.QueryMod) realtimeTicketQuery {
+	mods = append(mods, qm.From("\"realtime_tickets\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"realtime_tickets\".*"})
+	}
+
+	return realtimeTicketQuery{q}
+}
+
+// FindRealtimeTicket retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRealtimeTicket(ctx context.Context, exec boil.ContextExecutor, tokenHash string, selectCols ...string) (*RealtimeTicket, error) {
+	realtimeTicketObj := &RealtimeTicket{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"realtime_tickets\" where \"token_hash\"=$1", sel,
+	)
+
+	q := queries.Raw(query, tokenHash)
+
+	err := q.Bind(ctx, exec, realtimeTicketObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from realtime_tickets")
+	}
+
+	return realtimeTicketObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RealtimeTicket) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no realtime_tickets provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(realtimeTicketColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	realtimeTicketInsertCacheMut.RLock()
+	cache, cached := realtimeTicketInsertCache[key]
+	realtimeTicketInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			realtimeTicketAllColumns,
+			realtimeTicketColumnsWithDefault,
+			realtimeTicketColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(realtimeTicketType, realtimeTicketMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(realtimeTicketType, realtimeTicketMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"realtime_tickets\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"realtime_tickets\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into realtime_tickets")
+	}
+
+	if !cached {
+		realtimeTicketInsertCacheMut.Lock()
+		realtimeTicketInsertCache[key] = cache
+		realtimeTicketInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the RealtimeTicket.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RealtimeTicket) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	realtimeTicketUpdateCacheMut.RLock()
+	cache, cached := realtimeTicketUpdateCache[key]
+	realtimeTicketUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			realtimeTicketAllColumns,
+			realtimeTicketPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update realtime_tickets, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"realtime_tickets\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, realtimeTicketPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(realtimeTicketType, realtimeTicketMapping, append(wl, realtimeTicketPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update realtime_tickets row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for realtime_tickets")
+	}
+
+	if !cached {
+		realtimeTicketUpdateCacheMut.Lock()
+		realtimeTicketUpdateCache[key] = cache
+		realtimeTicketUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q realtimeTicketQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for realtime_tickets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for realtime_tickets")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RealtimeTicketSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), realtimeTicketPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"realtime_tickets\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, realtimeTicketPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in realtimeTicket slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all realtimeTicket")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RealtimeTicket) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no realtime_tickets provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(realtimeTicketColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	realtimeTicketUpsertCacheMut.RLock()
+	cache, cached := realtimeTicketUpsertCache[key]
+	realtimeTicketUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			realtimeTicketAllColumns,
+			realtimeTicketColumnsWithDefault,
+			realtimeTicketColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			realtimeTicketAllColumns,
+			realtimeTicketPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert realtime_tickets, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(realtimeTicketPrimaryKeyColumns))
+			copy(conflict, realtimeTicketPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"realtime_tickets\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(realtimeTicketType, realtimeTicketMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(realtimeTicketType, realtimeTicketMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert realtime_tickets")
+	}
+
+	if !cached {
+		realtimeTicketUpsertCacheMut.Lock()
+		realtimeTicketUpsertCache[key] = cache
+		realtimeTicketUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single RealtimeTicket record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RealtimeTicket) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no RealtimeTicket provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), realtimeTicketPrimaryKeyMapping)
+	sql := "DELETE FROM \"realtime_tickets\" WHERE \"token_hash\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from realtime_tickets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for realtime_tickets")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q realtimeTicketQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no realtimeTicketQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from realtime_tickets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for realtime_tickets")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RealtimeTicketSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), realtimeTicketPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"realtime_tickets\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, realtimeTicketPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from realtimeTicket slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for realtime_tickets")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RealtimeTicket) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRealtimeTicket(ctx, exec, o.TokenHash)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RealtimeTicketSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RealtimeTicketSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), realtimeTicketPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"realtime_tickets\".* FROM \"realtime_tickets\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, realtimeTicketPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in RealtimeTicketSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RealtimeTicketExists checks if the RealtimeTicket row exists.
+func RealtimeTicketExists(ctx context.Context, exec boil.ContextExecutor, tokenHash string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"realtime_tickets\" where \"token_hash\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, tokenHash)
+	}
+	row := exec.QueryRowContext(ctx, sql, tokenHash)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if realtime_tickets exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the RealtimeTicket row exists.
+func (o *RealtimeTicket) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RealtimeTicketExists(ctx, exec, o.TokenHash)
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,16 +24,20 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID             int       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Status         string    `boil:"status" json:"status" toml:"status" yaml:"status"`
-	Email          string    `boil:"email" json:"email" toml:"email" yaml:"email"`
-	Name           string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	HashedPassword string    `boil:"hashed_password" json:"hashed_password" toml:"hashed_password" yaml:"hashed_password"`
-	Salt           string    `boil:"salt" json:"salt" toml:"salt" yaml:"salt"`
-	Avatar         string    `boil:"avatar" json:"avatar" toml:"avatar" yaml:"avatar"`
-	Role           string    `boil:"role" json:"role" toml:"role" yaml:"role"`
-	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt      time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID             int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Status         string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Email          string      `boil:"email" json:"email" toml:"email" yaml:"email"`
+	Name           string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	HashedPassword string      `boil:"hashed_password" json:"hashed_password" toml:"hashed_password" yaml:"hashed_password"`
+	Salt           string      `boil:"salt" json:"salt" toml:"salt" yaml:"salt"`
+	Avatar         string      `boil:"avatar" json:"avatar" toml:"avatar" yaml:"avatar"`
+	Role           string      `boil:"role" json:"role" toml:"role" yaml:"role"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	SearchVector   null.String `boil:"search_vector" json:"search_vector,omitempty" toml:"search_vector" yaml:"search_vector,omitempty"`
+	Version        int         `boil:"version" json:"version" toml:"version" yaml:"version"`
+	CreatedBy      null.Int    `boil:"created_by" json:"created_by,omitempty" toml:"created_by" yaml:"created_by,omitempty"`
+	UpdatedBy      null.Int    `boil:"updated_by" json:"updated_by,omitempty" toml:"updated_by" yaml:"updated_by,omitempty"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -49,6 +54,10 @@ var UserColumns = struct {
 	Role           string
 	CreatedAt      string
 	UpdatedAt      string
+	SearchVector   string
+	Version        string
+	CreatedBy      string
+	UpdatedBy      string
 }{
 	ID:             "id",
 	Status:         "status",
@@ -60,6 +69,10 @@ var UserColumns = struct {
 	Role:           "role",
 	CreatedAt:      "created_at",
 	UpdatedAt:      "updated_at",
+	SearchVector:   "search_vector",
+	Version:        "version",
+	CreatedBy:      "created_by",
+	UpdatedBy:      "updated_by",
 }
 
 var UserTableColumns = struct {
@@ -73,6 +86,10 @@ var UserTableColumns = struct {
 	Role           string
 	CreatedAt      string
 	UpdatedAt      string
+	SearchVector   string
+	Version        string
+	CreatedBy      string
+	UpdatedBy      string
 }{
 	ID:             "users.id",
 	Status:         "users.status",
@@ -84,54 +101,14 @@ var UserTableColumns = struct {
 	Role:           "users.role",
 	CreatedAt:      "users.created_at",
 	UpdatedAt:      "users.updated_at",
+	SearchVector:   "users.search_vector",
+	Version:        "users.version",
+	CreatedBy:      "users.created_by",
+	UpdatedBy:      "users.updated_by",
 }
 
 // Generated where
 
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var UserWhere = struct {
 	ID             whereHelperint
 	Status         whereHelperstring
@@ -143,6 +120,10 @@ var UserWhere = struct {
 	Role           whereHelperstring
 	CreatedAt      whereHelpertime_Time
 	UpdatedAt      whereHelpertime_Time
+	SearchVector   whereHelpernull_String
+	Version        whereHelperint
+	CreatedBy      whereHelpernull_Int
+	UpdatedBy      whereHelpernull_Int
 }{
 	ID:             whereHelperint{field: "\"users\".\"id\""},
 	Status:         whereHelperstring{field: "\"users\".\"status\""},
@@ -154,14 +135,34 @@ var UserWhere = struct {
 	Role:           whereHelperstring{field: "\"users\".\"role\""},
 	CreatedAt:      whereHelpertime_Time{field: "\"users\".\"created_at\""},
 	UpdatedAt:      whereHelpertime_Time{field: "\"users\".\"updated_at\""},
+	SearchVector:   whereHelpernull_String{field: "\"users\".\"search_vector\""},
+	Version:        whereHelperint{field: "\"users\".\"version\""},
+	CreatedBy:      whereHelpernull_Int{field: "\"users\".\"created_by\""},
+	UpdatedBy:      whereHelpernull_Int{field: "\"users\".\"updated_by\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-}{}
+	MailDeliveries  string
+	MailPreferences string
+	OfflineMessages string
+	RealtimeTickets string
+	UserDevices     string
+}{
+	MailDeliveries:  "MailDeliveries",
+	MailPreferences: "MailPreferences",
+	OfflineMessages: "OfflineMessages",
+	RealtimeTickets: "RealtimeTickets",
+	UserDevices:     "UserDevices",
+}
 
 // userR is where relationships are stored.
 type userR struct {
+	MailDeliveries  MailDeliverySlice   `boil:"MailDeliveries" json:"MailDeliveries" toml:"MailDeliveries" yaml:"MailDeliveries"`
+	MailPreferences MailPreferenceSlice `boil:"MailPreferences" json:"MailPreferences" toml:"MailPreferences" yaml:"MailPreferences"`
+	OfflineMessages OfflineMessageSlice `boil:"OfflineMessages" json:"OfflineMessages" toml:"OfflineMessages" yaml:"OfflineMessages"`
+	RealtimeTickets RealtimeTicketSlice `boil:"RealtimeTickets" json:"RealtimeTickets" toml:"RealtimeTickets" yaml:"RealtimeTickets"`
+	UserDevices     UserDeviceSlice     `boil:"UserDevices" json:"UserDevices" toml:"UserDevices" yaml:"UserDevices"`
 }
 
 // NewStruct creates a new relationship struct
@@ -169,15 +170,50 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (r *userR) GetMailDeliveries() MailDeliverySlice {
+	if r == nil {
+		return nil
+	}
+	return r.MailDeliveries
+}
+
+func (r *userR) GetMailPreferences() MailPreferenceSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MailPreferences
+}
+
+func (r *userR) GetOfflineMessages() OfflineMessageSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OfflineMessages
+}
+
+func (r *userR) GetRealtimeTickets() RealtimeTicketSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RealtimeTickets
+}
+
+func (r *userR) GetUserDevices() UserDeviceSlice {
+	if r == nil {
+		return nil
+	}
+	return r.UserDevices
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "status", "email", "name", "hashed_password", "salt", "avatar", "role", "created_at", "updated_at"}
+	userAllColumns            = []string{"id", "status", "email", "name", "hashed_password", "salt", "avatar", "role", "created_at", "updated_at", "search_vector", "version", "created_by", "updated_by"}
 	userColumnsWithoutDefault = []string{"email", "name", "hashed_password", "salt"}
-	userColumnsWithDefault    = []string{"id", "status", "avatar", "role", "created_at", "updated_at"}
+	userColumnsWithDefault    = []string{"id", "status", "avatar", "role", "created_at", "updated_at", "search_vector", "version", "created_by", "updated_by"}
 	userPrimaryKeyColumns     = []string{"id"}
-	userGeneratedColumns      = []string{}
+	userGeneratedColumns      = []string{"search_vector"}
 )
 
 type (
@@ -271,6 +307,876 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// MailDeliveries retrieves all the mail_delivery's MailDeliveries with an executor.
+func (o *User) MailDeliveries(mods ...qm.QueryMod) mailDeliveryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"mail_deliveries\".\"user_id\"=?", o.ID),
+	)
+
+	return MailDeliveries(queryMods...)
+}
+
+// MailPreferences retrieves all the mail_preference's MailPreferences with an executor.
+func (o *User) MailPreferences(mods ...qm.QueryMod) mailPreferenceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"mail_preferences\".\"user_id\"=?", o.ID),
+	)
+
+	return MailPreferences(queryMods...)
+}
+
+// OfflineMessages retrieves all the offline_message's OfflineMessages with an executor.
+func (o *User) OfflineMessages(mods ...qm.QueryMod) offlineMessageQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"offline_messages\".\"user_id\"=?", o.ID),
+	)
+
+	return OfflineMessages(queryMods...)
+}
+
+// RealtimeTickets retrieves all the realtime_ticket's RealtimeTickets with an executor.
+func (o *User) RealtimeTickets(mods ...qm.QueryMod) realtimeTicketQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"realtime_tickets\".\"user_id\"=?", o.ID),
+	)
+
+	return RealtimeTickets(queryMods...)
+}
+
+// UserDevices retrieves all the user_device's UserDevices with an executor.
+func (o *User) UserDevices(mods ...qm.QueryMod) userDeviceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_devices\".\"user_id\"=?", o.ID),
+	)
+
+	return UserDevices(queryMods...)
+}
+
+// LoadMailDeliveries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadMailDeliveries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`mail_deliveries`),
+		qm.WhereIn(`mail_deliveries.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load mail_deliveries")
+	}
+
+	var resultSlice []*MailDelivery
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice mail_deliveries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on mail_deliveries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for mail_deliveries")
+	}
+
+	if singular {
+		object.R.MailDeliveries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &mailDeliveryR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.MailDeliveries = append(local.R.MailDeliveries, foreign)
+				if foreign.R == nil {
+					foreign.R = &mailDeliveryR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMailPreferences allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadMailPreferences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`mail_preferences`),
+		qm.WhereIn(`mail_preferences.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load mail_preferences")
+	}
+
+	var resultSlice []*MailPreference
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice mail_preferences")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on mail_preferences")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for mail_preferences")
+	}
+
+	if singular {
+		object.R.MailPreferences = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &mailPreferenceR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.MailPreferences = append(local.R.MailPreferences, foreign)
+				if foreign.R == nil {
+					foreign.R = &mailPreferenceR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOfflineMessages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOfflineMessages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`offline_messages`),
+		qm.WhereIn(`offline_messages.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load offline_messages")
+	}
+
+	var resultSlice []*OfflineMessage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice offline_messages")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on offline_messages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for offline_messages")
+	}
+
+	if singular {
+		object.R.OfflineMessages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &offlineMessageR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.OfflineMessages = append(local.R.OfflineMessages, foreign)
+				if foreign.R == nil {
+					foreign.R = &offlineMessageR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRealtimeTickets allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRealtimeTickets(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`realtime_tickets`),
+		qm.WhereIn(`realtime_tickets.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load realtime_tickets")
+	}
+
+	var resultSlice []*RealtimeTicket
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice realtime_tickets")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on realtime_tickets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for realtime_tickets")
+	}
+
+	if singular {
+		object.R.RealtimeTickets = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &realtimeTicketR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.RealtimeTickets = append(local.R.RealtimeTickets, foreign)
+				if foreign.R == nil {
+					foreign.R = &realtimeTicketR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserDevices allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserDevices(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_devices`),
+		qm.WhereIn(`user_devices.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_devices")
+	}
+
+	var resultSlice []*UserDevice
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_devices")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_devices")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_devices")
+	}
+
+	if singular {
+		object.R.UserDevices = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userDeviceR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserDevices = append(local.R.UserDevices, foreign)
+				if foreign.R == nil {
+					foreign.R = &userDeviceR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddMailDeliveries adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.MailDeliveries.
+// Sets related.R.User appropriately.
+func (o *User) AddMailDeliveries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MailDelivery) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"mail_deliveries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, mailDeliveryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			MailDeliveries: related,
+		}
+	} else {
+		o.R.MailDeliveries = append(o.R.MailDeliveries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &mailDeliveryR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddMailPreferences adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.MailPreferences.
+// Sets related.R.User appropriately.
+func (o *User) AddMailPreferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MailPreference) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"mail_preferences\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, mailPreferencePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			MailPreferences: related,
+		}
+	} else {
+		o.R.MailPreferences = append(o.R.MailPreferences, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &mailPreferenceR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddOfflineMessages adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OfflineMessages.
+// Sets related.R.User appropriately.
+func (o *User) AddOfflineMessages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OfflineMessage) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"offline_messages\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, offlineMessagePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OfflineMessages: related,
+		}
+	} else {
+		o.R.OfflineMessages = append(o.R.OfflineMessages, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &offlineMessageR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddRealtimeTickets adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RealtimeTickets.
+// Sets related.R.User appropriately.
+func (o *User) AddRealtimeTickets(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RealtimeTicket) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"realtime_tickets\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, realtimeTicketPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.TokenHash}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			RealtimeTickets: related,
+		}
+	} else {
+		o.R.RealtimeTickets = append(o.R.RealtimeTickets, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &realtimeTicketR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddUserDevices adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserDevices.
+// Sets related.R.User appropriately.
+func (o *User) AddUserDevices(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserDevice) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_devices\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userDevicePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserDevices: related,
+		}
+	} else {
+		o.R.UserDevices = append(o.R.UserDevices, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userDeviceR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("\"users\""))
@@ -341,6 +1247,7 @@ func (o *User) Insert(ctx context.Context, exec boil.ContextExecutor, columns bo
 			userColumnsWithoutDefault,
 			nzDefaults,
 		)
+		wl = strmangle.SetComplement(wl, userGeneratedColumns)
 
 		cache.valueMapping, err = queries.BindMapping(userType, userMapping, wl)
 		if err != nil {
@@ -414,6 +1321,7 @@ func (o *User) Update(ctx context.Context, exec boil.ContextExecutor, columns bo
 			userAllColumns,
 			userPrimaryKeyColumns,
 		)
+		wl = strmangle.SetComplement(wl, userGeneratedColumns)
 
 		if !columns.IsWhitelist() {
 			wl = strmangle.SetComplement(wl, []string{"created_at"})
@@ -588,6 +1496,9 @@ func (o *User) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnCo
 			userPrimaryKeyColumns,
 		)
 
+		insert = strmangle.SetComplement(insert, userGeneratedColumns)
+		update = strmangle.SetComplement(update, userGeneratedColumns)
+
 		if updateOnConflict && len(update) == 0 {
 			return errors.New("orm: unable to upsert users, could not build update column list")
 		}
@@ -0,0 +1,52 @@
+package realtimeticket
+
+import (
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/base"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Repo represent the realtime ticket
+type Repo interface {
+	Create(ctx db.Context, t model.RealtimeTicket) error
+	Take(ctx db.Context, tokenHash string) (*model.RealtimeTicket, error)
+	DeleteExpired(ctx db.Context, now time.Time) error
+}
+
+// New return new realtime ticket repo
+func New(monitor monitor.Tracer) Repo {
+	return &repo{
+		tickets: base.Repo[orm.RealtimeTicket, model.RealtimeTicket]{
+			Name:       "RealtimeTicket",
+			Tracer:     monitor,
+			PrimaryKey: orm.RealtimeTicketColumns.TokenHash,
+			QueryFn:    func(mods ...qm.QueryMod) base.Query { return orm.RealtimeTickets(mods...) },
+			MappingFn:  toRealtimeTicketModel,
+			ToOrmFn:    toRealtimeTicketOrm,
+		},
+	}
+}
+
+func toRealtimeTicketModel(m *orm.RealtimeTicket) *model.RealtimeTicket {
+	if m == nil {
+		return nil
+	}
+	return &model.RealtimeTicket{
+		TokenHash: m.TokenHash,
+		UserID:    m.UserID,
+		ExpiresAt: m.ExpiresAt,
+	}
+}
+
+func toRealtimeTicketOrm(t model.RealtimeTicket) *orm.RealtimeTicket {
+	return &orm.RealtimeTicket{
+		TokenHash: t.TokenHash,
+		UserID:    t.UserID,
+		ExpiresAt: t.ExpiresAt,
+	}
+}
@@ -0,0 +1,37 @@
+package realtimeticket
+
+import (
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/base"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+)
+
+// takeQuery removes the ticket and returns it, a ticket is taken by a single connection
+const takeQuery = `DELETE FROM realtime_tickets WHERE token_hash = $1 RETURNING *`
+
+type repo struct {
+	tickets base.Repo[orm.RealtimeTicket, model.RealtimeTicket]
+}
+
+func (r *repo) Create(ctx db.Context, t model.RealtimeTicket) error {
+	_, err := r.tickets.Create(ctx, t)
+	return err
+}
+
+func (r *repo) Take(ctx db.Context, tokenHash string) (*model.RealtimeTicket, error) {
+	var m orm.RealtimeTicket
+	err := r.tickets.Raw(ctx, "Take", &m, takeQuery, tokenHash)
+	if err != nil {
+		return nil, base.GetOneErrorHandler(err)
+	}
+
+	return toRealtimeTicketModel(&m), nil
+}
+
+func (r *repo) DeleteExpired(ctx db.Context, now time.Time) error {
+	_, err := r.tickets.DeleteAll(ctx, orm.RealtimeTicketWhere.ExpiresAt.LTE(now))
+	return err
+}
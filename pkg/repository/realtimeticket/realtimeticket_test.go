@@ -0,0 +1,72 @@
+package realtimeticket_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/realtimeticket"
+	"github.com/dwarvesf/go-api/pkg/testfixtures"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_repo_Take(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		u := testfixtures.User(t, ctx)
+		expiresAt := time.Now().Add(time.Minute).Truncate(time.Microsecond)
+
+		r := realtimeticket.New(monitor.TestMonitor())
+		err := r.Create(ctx, model.RealtimeTicket{TokenHash: "hash", UserID: u.ID, ExpiresAt: expiresAt})
+		require.NoError(t, err)
+
+		tests := map[string]struct {
+			tokenHash string
+			want      *model.RealtimeTicket
+			wantErr   error
+		}{
+			"success": {
+				tokenHash: "hash",
+				want:      &model.RealtimeTicket{TokenHash: "hash", UserID: u.ID, ExpiresAt: expiresAt},
+			},
+			"already taken": {
+				tokenHash: "hash",
+				wantErr:   model.ErrNotFound,
+			},
+			"unknown ticket": {
+				tokenHash: "unknown",
+				wantErr:   model.ErrNotFound,
+			},
+		}
+		for _, name := range []string{"success", "already taken", "unknown ticket"} {
+			tt := tests[name]
+			t.Run(name, func(t *testing.T) {
+				got, err := r.Take(ctx, tt.tokenHash)
+				require.ErrorIs(t, err, tt.wantErr)
+				if tt.want != nil {
+					require.Equal(t, tt.want.UserID, got.UserID)
+					require.True(t, tt.want.ExpiresAt.Equal(got.ExpiresAt))
+				}
+			})
+		}
+	})
+}
+
+func Test_repo_DeleteExpired(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		u := testfixtures.User(t, ctx)
+		now := time.Now()
+
+		r := realtimeticket.New(monitor.TestMonitor())
+		require.NoError(t, r.Create(ctx, model.RealtimeTicket{TokenHash: "expired", UserID: u.ID, ExpiresAt: now}))
+		require.NoError(t, r.Create(ctx, model.RealtimeTicket{TokenHash: "valid", UserID: u.ID, ExpiresAt: now.Add(time.Minute)}))
+
+		require.NoError(t, r.DeleteExpired(ctx, now))
+
+		_, err := r.Take(ctx, "expired")
+		require.ErrorIs(t, err, model.ErrNotFound)
+		_, err = r.Take(ctx, "valid")
+		require.NoError(t, err)
+	})
+}
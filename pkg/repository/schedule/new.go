@@ -0,0 +1,37 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+)
+
+// Repo represent the schedule
+type Repo interface {
+	GetList(ctx db.Context) ([]model.Schedule, error)
+	Save(ctx db.Context, name string, spec string, nextRunAt time.Time) error
+	UpdateRun(ctx db.Context, name string, run model.ScheduleRun) error
+}
+
+// New return new schedule repo
+func New() Repo {
+	return &repo{}
+}
+
+func toScheduleModel(m *orm.Schedule) *model.Schedule {
+	if m == nil {
+		return nil
+	}
+	return &model.Schedule{
+		Name:         m.Name,
+		Spec:         m.Spec,
+		NextRunAt:    m.NextRunAt.Ptr(),
+		LastRunAt:    m.LastRunAt.Ptr(),
+		LastStatus:   model.ScheduleStatus(m.LastStatus.String),
+		LastError:    m.LastError.String,
+		LastDuration: time.Duration(m.LastDurationMS.Int64) * time.Millisecond,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
@@ -0,0 +1,56 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type repo struct {
+}
+
+func (r *repo) GetList(ctx db.Context) ([]model.Schedule, error) {
+	dt, err := orm.Schedules(qm.OrderBy(orm.ScheduleColumns.Name+" ASC")).All(ctx, ctx.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]model.Schedule, 0, len(dt))
+	for _, m := range dt {
+		rs = append(rs, *toScheduleModel(m))
+	}
+
+	return rs, nil
+}
+
+// Save registers the schedule, the outcome of the previous runs is kept.
+func (r *repo) Save(ctx db.Context, name string, spec string, nextRunAt time.Time) error {
+	m := &orm.Schedule{
+		Name:      name,
+		Spec:      spec,
+		NextRunAt: null.TimeFrom(nextRunAt),
+	}
+
+	return m.Upsert(ctx, ctx.DB, true,
+		[]string{orm.ScheduleColumns.Name},
+		boil.Whitelist(orm.ScheduleColumns.Spec, orm.ScheduleColumns.NextRunAt, orm.ScheduleColumns.UpdatedAt),
+		boil.Infer(),
+	)
+}
+
+func (r *repo) UpdateRun(ctx db.Context, name string, run model.ScheduleRun) error {
+	_, err := orm.Schedules(orm.ScheduleWhere.Name.EQ(name)).UpdateAll(ctx, ctx.DB, orm.M{
+		orm.ScheduleColumns.LastRunAt:      run.StartedAt,
+		orm.ScheduleColumns.NextRunAt:      run.NextRunAt,
+		orm.ScheduleColumns.LastStatus:     string(run.Status),
+		orm.ScheduleColumns.LastError:      null.NewString(run.Error, run.Error != ""),
+		orm.ScheduleColumns.LastDurationMS: run.Duration.Milliseconds(),
+		orm.ScheduleColumns.UpdatedAt:      time.Now(),
+	})
+	return err
+}
@@ -0,0 +1,41 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_repo_Save(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		now := time.Now().Truncate(time.Millisecond)
+		r := &repo{}
+
+		err := r.Save(ctx, "daily", "0 0 * * *", now.Add(time.Hour))
+		require.NoError(t, err)
+		err = r.UpdateRun(ctx, "daily", model.ScheduleRun{
+			StartedAt: now,
+			NextRunAt: now.Add(time.Hour),
+			Status:    model.ScheduleStatusFailed,
+			Error:     "failed",
+			Duration:  2 * time.Second,
+		})
+		require.NoError(t, err)
+
+		// saving again changes the spec and keeps the outcome of the last run
+		err = r.Save(ctx, "daily", "0 1 * * *", now.Add(2*time.Hour))
+		require.NoError(t, err)
+
+		got, err := r.GetList(ctx)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		require.Equal(t, "0 1 * * *", got[0].Spec)
+		require.True(t, now.Add(2*time.Hour).Equal(*got[0].NextRunAt))
+		require.Equal(t, model.ScheduleStatusFailed, got[0].LastStatus)
+		require.Equal(t, "failed", got[0].LastError)
+		require.Equal(t, 2*time.Second, got[0].LastDuration)
+	})
+}
@@ -1,15 +1,21 @@
 package user
 
 import (
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
 	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/base"
 	"github.com/dwarvesf/go-api/pkg/repository/db"
 	"github.com/dwarvesf/go-api/pkg/repository/orm"
+	"github.com/dwarvesf/go-api/pkg/repository/util"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 // Repo represent the user
 type Repo interface {
 	GetByID(ctx db.Context, uID int) (*model.User, error)
 	GetList(ctx db.Context, q model.ListQuery) (*model.ListResult[model.User], error)
+	GetListAfter(ctx db.Context, f model.AudienceFilter, afterID int, limit int) ([]model.User, error)
+	CountAudience(ctx db.Context, f model.AudienceFilter) (int64, error)
 	Count(ctx db.Context) (int64, error)
 	GetByEmail(ctx db.Context, email string) (*model.User, error)
 	Create(ctx db.Context, user model.SignupRequest) (*model.User, error)
@@ -18,8 +24,28 @@ type Repo interface {
 }
 
 // New return new user repo
-func New() Repo {
-	return &repo{}
+func New(monitor monitor.Tracer) Repo {
+	return &repo{
+		users: base.Repo[orm.User, model.User]{
+			Name:       "User",
+			Tracer:     monitor,
+			PrimaryKey: orm.UserColumns.ID,
+			QueryFn:    func(mods ...qm.QueryMod) base.Query { return orm.Users(mods...) },
+			MappingFn:  toUserModel,
+			ToOrmFn:    toUserOrm,
+		},
+	}
+}
+
+// userFields are the fields the list of users can be sorted and filtered by
+var userFields = util.Fields{
+	"id":         {Column: orm.UserColumns.ID, Type: util.FieldTypeInt, Sortable: true, Filterable: true},
+	"email":      {Column: orm.UserColumns.Email, Sortable: true, Filterable: true},
+	"name":       {Column: orm.UserColumns.Name, Sortable: true, Filterable: true},
+	"status":     {Column: orm.UserColumns.Status, Filterable: true},
+	"role":       {Column: orm.UserColumns.Role, Filterable: true},
+	"created_at": {Column: orm.UserColumns.CreatedAt, Type: util.FieldTypeTime, Sortable: true, Filterable: true},
+	"updated_at": {Column: orm.UserColumns.UpdatedAt, Type: util.FieldTypeTime, Sortable: true, Filterable: true},
 }
 
 func toUserModel(user *orm.User) *model.User {
@@ -35,5 +61,20 @@ func toUserModel(user *orm.User) *model.User {
 		HashedPassword: user.HashedPassword,
 		Role:           user.Role,
 		Salt:           user.Salt,
+		Version:        user.Version,
+	}
+}
+
+func toUserOrm(user model.User) *orm.User {
+	return &orm.User{
+		ID:             user.ID,
+		Email:          user.Email,
+		Name:           user.FullName,
+		Status:         user.Status,
+		Avatar:         user.Avatar,
+		HashedPassword: user.HashedPassword,
+		Role:           user.Role,
+		Salt:           user.Salt,
+		Version:        user.Version,
 	}
 }
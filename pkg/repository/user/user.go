@@ -5,18 +5,27 @@ import (
 	"github.com/dwarvesf/go-api/pkg/repository/base"
 	"github.com/dwarvesf/go-api/pkg/repository/db"
 	"github.com/dwarvesf/go-api/pkg/repository/orm"
-	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/dwarvesf/go-api/pkg/repository/util"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
+// updatePasswordQuery bumps the version in the same statement, a concurrent update can't reuse it
+const updatePasswordQuery = `UPDATE users SET hashed_password = $1, version = version + 1 WHERE id = $2`
+
 type repo struct {
+	users base.Repo[orm.User, model.User]
 }
 
 func (r *repo) GetList(ctx db.Context, q model.ListQuery) (*model.ListResult[model.User], error) {
 	fnSet := base.GetListFuncSet[orm.User, model.User]{
 		PrepareQueryFn: func(ctx db.Context, q model.ListQuery) []qm.QueryMod {
 			queryParams := []qm.QueryMod{}
-			if q.Query != "" {
+			switch {
+			case q.Query == "":
+			case q.Search != nil:
+				queryParams = append(queryParams, searchQuery(q))
+			default:
 				queryParams = append(queryParams, qm.Where("lower(name) LIKE lower(?)", "%"+q.Query+"%"))
 			}
 			return queryParams
@@ -27,62 +36,171 @@ func (r *repo) GetList(ctx db.Context, q model.ListQuery) (*model.ListResult[mod
 		QueryListFn: func(q []qm.QueryMod) ([]*orm.User, error) {
 			return orm.Users(q...).All(ctx.Context, ctx.DB)
 		},
-		MappingFn: toUserModel,
+		MappingFn:   toUserModel,
+		Fields:      userFields,
+		RankFn:      searchRank,
+		HighlightFn: searchHighlight,
 	}
 	return base.GetList(ctx, q, fnSet)
 }
 
+// searchQuery matches the users whose name or email has a word starting with every term of the query,
+// or in fuzzy mode whose name or email has a word similar to the query, with the trigram indexes.
+func searchQuery(q model.ListQuery) qm.QueryMod {
+	tsQuery := util.TSQuery(util.SearchTerms(q.Query))
+	if q.Search.Fuzzy {
+		return qm.Where("(search_vector @@ to_tsquery('simple', ?) OR lower(?) <% lower(name) OR lower(?) <% lower(email))",
+			tsQuery, q.Query, q.Query)
+	}
+	if tsQuery == "" {
+		return qm.Where("false")
+	}
+
+	return qm.Where("search_vector @@ to_tsquery('simple', ?)", tsQuery)
+}
+
+// searchRank ranks the matches of the name above the ones of the email, the similarity
+// of the misspelled matches adds to the rank in fuzzy mode.
+func searchRank(q model.ListQuery) (string, []interface{}) {
+	tsQuery := util.TSQuery(util.SearchTerms(q.Query))
+	if q.Search.Fuzzy {
+		return "(ts_rank(search_vector, to_tsquery('simple', ?)) + greatest(word_similarity(lower(?), lower(name)), word_similarity(lower(?), lower(email))))",
+			[]interface{}{tsQuery, q.Query, q.Query}
+	}
+
+	return "ts_rank(search_vector, to_tsquery('simple', ?))", []interface{}{tsQuery}
+}
+
+// searchHighlight highlights the words of the name and email starting with a term of the query,
+// the misspelled matches of the fuzzy mode aren't highlighted.
+func searchHighlight(u *orm.User, q model.ListQuery) map[string]string {
+	terms := util.SearchTerms(q.Query)
+	return map[string]string{
+		"name":  util.Highlight(u.Name, terms),
+		"email": util.Highlight(u.Email, terms),
+	}
+}
+
+// GetListAfter returns the users of the audience whose ID is greater than afterID in order of ID,
+// the users inserted while iterating don't shift the next pages.
+func (r *repo) GetListAfter(ctx db.Context, f model.AudienceFilter, afterID int, limit int) ([]model.User, error) {
+	q := append(audienceQuery(f),
+		orm.UserWhere.ID.GT(afterID),
+		qm.OrderBy(orm.UserColumns.ID+" ASC"),
+		qm.Limit(limit),
+	)
+	dt, err := orm.Users(q...).All(ctx.Context, ctx.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]model.User, 0, len(dt))
+	for _, u := range dt {
+		rs = append(rs, *toUserModel(u))
+	}
+
+	return rs, nil
+}
+
+func (r *repo) CountAudience(ctx db.Context, f model.AudienceFilter) (int64, error) {
+	return orm.Users(audienceQuery(f)...).Count(ctx.Context, ctx.DB)
+}
+
+func audienceQuery(f model.AudienceFilter) []qm.QueryMod {
+	// the addresses reported as undeliverable are never part of an audience
+	q := []qm.QueryMod{
+		qm.Where("NOT EXISTS (SELECT 1 FROM mail_suppressions s WHERE s.email = lower(users.email))"),
+	}
+	if f.Role != "" {
+		q = append(q, orm.UserWhere.Role.EQ(string(f.Role)))
+	}
+	if f.Status != "" {
+		q = append(q, orm.UserWhere.Status.EQ(string(f.Status)))
+	}
+	if f.CreatedFrom != nil {
+		q = append(q, orm.UserWhere.CreatedAt.GTE(*f.CreatedFrom))
+	}
+	if f.CreatedTo != nil {
+		q = append(q, orm.UserWhere.CreatedAt.LT(*f.CreatedTo))
+	}
+	if f.Query != "" {
+		q = append(q, qm.Where("(lower(name) LIKE lower(?) OR lower(email) LIKE lower(?))", "%"+f.Query+"%", "%"+f.Query+"%"))
+	}
+	if f.Subscribed {
+		q = append(q, qm.Where("NOT EXISTS (SELECT 1 FROM mail_preferences p WHERE p.user_id = users.id AND NOT p.campaigns)"))
+	}
+
+	return q
+}
+
 func (r *repo) Count(ctx db.Context) (int64, error) {
-	return orm.Users().Count(ctx.Context, ctx.DB)
+	return r.users.Count(ctx)
 }
 
 func (r *repo) GetByID(ctx db.Context, uID int) (*model.User, error) {
-	dt, err := orm.FindUser(ctx, ctx.DB, uID)
-	return toUserModel(dt), base.GetOneErrorHandler(err)
+	return r.users.GetByID(ctx, uID)
 }
 
 func (r *repo) GetByEmail(ctx db.Context, email string) (*model.User, error) {
-	u, err := orm.Users(
-		orm.UserWhere.Email.EQ(email),
-	).One(ctx.Context, ctx.DB)
-	return toUserModel(u), base.GetOneErrorHandler(err)
+	return r.users.FindOne(ctx, orm.UserWhere.Email.EQ(email))
 }
 
 func (r *repo) Create(ctx db.Context, user model.SignupRequest) (*model.User, error) {
-	u := &orm.User{
-		Name:           user.Name,
+	return r.users.Create(ctx, model.User{
+		FullName:       user.Name,
 		Email:          user.Email,
 		HashedPassword: user.HashedPassword,
 		Salt:           user.Salt,
 		Status:         string(user.Status),
 		Role:           string(user.Role),
 		Avatar:         user.Avatar,
-	}
-
-	err := u.Insert(ctx, ctx.DB, boil.Infer())
-	return toUserModel(u), err
+	})
 }
 
+// Update changes the profile when the user is still at the version of the request and bumps it,
+// model.ErrVersionConflict is returned when another update came first.
 func (r *repo) Update(ctx db.Context, uID int, user model.UpdateUserRequest) (*model.User, error) {
-	u, err := orm.FindUser(ctx, ctx.DB, uID)
+	version := user.Version
+	n, err := orm.Users(
+		orm.UserWhere.ID.EQ(uID),
+		orm.UserWhere.Version.EQ(version),
+	).UpdateAll(ctx, ctx.DB, orm.M{
+		orm.UserColumns.Name:    user.FullName,
+		orm.UserColumns.Avatar:  user.Avatar,
+		orm.UserColumns.Version: version + 1,
+	})
 	if err != nil {
 		return nil, err
 	}
+	if n == 0 {
+		exists, err := orm.UserExists(ctx, ctx.DB, uID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, model.ErrNotFound
+		}
 
-	u.Name = user.FullName
-	u.Avatar = user.Avatar
-
-	_, err = u.Update(ctx, ctx.DB, boil.Infer())
+		return nil, model.ErrVersionConflict
+	}
 
-	return toUserModel(u), err
+	return r.users.GetByID(ctx, uID)
 }
 
+// UpdatePassword changes the password and bumps the version,
+// the clients holding the previous version can't overwrite the user anymore.
 func (r *repo) UpdatePassword(ctx db.Context, uID int, newPassword string) error {
-	u, err := orm.FindUser(ctx, ctx.DB, uID)
+	rs, err := queries.Raw(updatePasswordQuery, newPassword, uID).ExecContext(ctx, ctx.DB)
 	if err != nil {
 		return err
 	}
-	u.HashedPassword = newPassword
-	_, err = u.Update(ctx.Context, ctx.DB, boil.Infer())
-	return err
+	n, err := rs.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return model.ErrNotFound
+	}
+
+	return nil
 }
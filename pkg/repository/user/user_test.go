@@ -1,12 +1,18 @@
-package user
+package user_test
 
 import (
+	"context"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/middleware"
 	"github.com/dwarvesf/go-api/pkg/model"
 	"github.com/dwarvesf/go-api/pkg/repository/db"
 	"github.com/dwarvesf/go-api/pkg/repository/orm"
+	"github.com/dwarvesf/go-api/pkg/repository/user"
+	"github.com/dwarvesf/go-api/pkg/testfixtures"
 	"github.com/stretchr/testify/require"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 )
@@ -46,6 +52,7 @@ func Test_repo_GetByID(t *testing.T) {
 					HashedPassword: u.HashedPassword,
 					Role:           u.Role,
 					Salt:           u.Salt,
+					Version:        u.Version,
 				},
 				wantErr: false,
 			},
@@ -59,7 +66,7 @@ func Test_repo_GetByID(t *testing.T) {
 		}
 		for name, tt := range tests {
 			t.Run(name, func(t *testing.T) {
-				r := &repo{}
+				r := user.New(monitor.TestMonitor())
 				got, err := r.GetByID(ctx, tt.args.uID)
 				if (err != nil) != tt.wantErr {
 					t.Errorf("repo.GetByID() error = %v, wantErr %v", err, tt.wantErr)
@@ -96,7 +103,7 @@ func Test_repo_Count(t *testing.T) {
 		}
 		for name, tt := range tests {
 			t.Run(name, func(t *testing.T) {
-				r := &repo{}
+				r := user.New(monitor.TestMonitor())
 				got, err := r.Count(ctx)
 				if (err != nil) != tt.wantErr {
 					t.Errorf("repo.Count() error = %v, wantErr %v", err, tt.wantErr)
@@ -145,6 +152,7 @@ func Test_repo_GetByEmail(t *testing.T) {
 					HashedPassword: u.HashedPassword,
 					Role:           u.Role,
 					Salt:           u.Salt,
+					Version:        u.Version,
 				},
 				wantErr: false,
 			},
@@ -158,7 +166,7 @@ func Test_repo_GetByEmail(t *testing.T) {
 		}
 		for name, tt := range tests {
 			t.Run(name, func(t *testing.T) {
-				r := &repo{}
+				r := user.New(monitor.TestMonitor())
 				got, err := r.GetByEmail(ctx, tt.args.email)
 				if (err != nil) != tt.wantErr {
 					t.Errorf("repo.GetByEmail() error = %v, wantErr %v", err, tt.wantErr)
@@ -214,13 +222,14 @@ func Test_repo_Create(t *testing.T) {
 					Role:           "admin",
 					HashedPassword: "123456",
 					Salt:           "abcdef",
+					Version:        1,
 				},
 				wantErr: false,
 			},
 		}
 		for name, tt := range tests {
 			t.Run(name, func(t *testing.T) {
-				r := &repo{}
+				r := user.New(monitor.TestMonitor())
 				got, err := r.Create(ctx, tt.args.req)
 				if (err != nil) != tt.wantErr {
 					t.Errorf("repo.Create() error = %v, wantErr %v", err, tt.wantErr)
@@ -240,17 +249,7 @@ func Test_repo_Create(t *testing.T) {
 
 func Test_repo_Update(t *testing.T) {
 	db.WithTestingDB(t, func(ctx db.Context) {
-		u := &orm.User{
-			Email:          "admin@d.foundation",
-			Name:           "admin",
-			Status:         "active",
-			Avatar:         "https://d.foundation/avatar.png",
-			Role:           "admin",
-			HashedPassword: "123456",
-			Salt:           "abcdef",
-		}
-		err := u.Insert(ctx, ctx.DB, boil.Infer())
-		require.NoError(t, err)
+		u := testfixtures.User(t, ctx)
 
 		type args struct {
 			uID  int
@@ -267,6 +266,7 @@ func Test_repo_Update(t *testing.T) {
 					user: model.UpdateUserRequest{
 						FullName: "admin1",
 						Avatar:   "https://d.foundation/avatar2.png",
+						Version:  u.Version,
 					},
 				},
 				want: &model.User{
@@ -278,12 +278,25 @@ func Test_repo_Update(t *testing.T) {
 					HashedPassword: u.HashedPassword,
 					Role:           u.Role,
 					Salt:           u.Salt,
+					Version:        u.Version + 1,
 				},
 				wantErr: false,
 			},
 			"not found": {
 				args: args{
-					uID: u.ID + 1,
+					uID:  u.ID + 1,
+					user: model.UpdateUserRequest{Version: 1},
+				},
+				want:    nil,
+				wantErr: true,
+			},
+			"stale version": {
+				args: args{
+					uID: u.ID,
+					user: model.UpdateUserRequest{
+						FullName: "admin2",
+						Version:  u.Version + 10,
+					},
 				},
 				want:    nil,
 				wantErr: true,
@@ -291,7 +304,7 @@ func Test_repo_Update(t *testing.T) {
 		}
 		for name, tt := range tests {
 			t.Run(name, func(t *testing.T) {
-				r := &repo{}
+				r := user.New(monitor.TestMonitor())
 				got, err := r.Update(ctx, tt.args.uID, tt.args.user)
 				if (err != nil) != tt.wantErr {
 					t.Errorf("repo.Update() error = %v, wantErr %v", err, tt.wantErr)
@@ -305,19 +318,54 @@ func Test_repo_Update(t *testing.T) {
 	})
 }
 
-func Test_repo_UpdatePassword(t *testing.T) {
+func Test_repo_Update_Audit(t *testing.T) {
 	db.WithTestingDB(t, func(ctx db.Context) {
-		u := &orm.User{
+		admin := &orm.User{
 			Email:          "admin@d.foundation",
 			Name:           "admin",
 			Status:         "active",
-			Avatar:         "https://d.foundation/avatar.png",
 			Role:           "admin",
 			HashedPassword: "123456",
 			Salt:           "abcdef",
 		}
-		err := u.Insert(ctx, ctx.DB, boil.Infer())
+		require.NoError(t, admin.Insert(ctx, ctx.DB, boil.Infer()))
+
+		adminCtx := db.Context{
+			Context: context.WithValue(ctx.Context, middleware.UserIDCtxKey, admin.ID),
+			DB:      ctx.DB,
+		}
+		u := &orm.User{
+			Email:          "user@d.foundation",
+			Name:           "user",
+			Status:         "active",
+			Role:           "user",
+			HashedPassword: "123456",
+			Salt:           "abcdef",
+		}
+		require.NoError(t, u.Insert(adminCtx, adminCtx.DB, boil.Infer()))
+		require.NoError(t, u.Reload(ctx, ctx.DB))
+		require.Equal(t, admin.ID, u.CreatedBy.Int)
+		require.Equal(t, admin.ID, u.UpdatedBy.Int)
+
+		userCtx := db.Context{
+			Context: context.WithValue(ctx.Context, middleware.UserIDCtxKey, u.ID),
+			DB:      ctx.DB,
+		}
+		r := user.New(monitor.TestMonitor())
+		_, err := r.Update(userCtx, u.ID, model.UpdateUserRequest{FullName: "user1", Version: u.Version})
+		require.NoError(t, err)
+
+		got, err := orm.FindUser(ctx, ctx.DB, u.ID)
 		require.NoError(t, err)
+		require.Equal(t, admin.ID, got.CreatedBy.Int)
+		require.Equal(t, u.ID, got.UpdatedBy.Int)
+		require.False(t, got.UpdatedAt.Before(u.UpdatedAt))
+	})
+}
+
+func Test_repo_UpdatePassword(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		u := testfixtures.User(t, ctx)
 
 		type args struct {
 			uID         int
@@ -336,12 +384,13 @@ func Test_repo_UpdatePassword(t *testing.T) {
 				want: &model.User{
 					ID:             u.ID,
 					Email:          u.Email,
-					FullName:       u.Name,
+					FullName:       u.FullName,
 					Status:         u.Status,
 					Avatar:         u.Avatar,
 					HashedPassword: "1234567",
 					Role:           u.Role,
 					Salt:           u.Salt,
+					Version:        u.Version + 1,
 				},
 				wantErr: false,
 			},
@@ -355,12 +404,20 @@ func Test_repo_UpdatePassword(t *testing.T) {
 		}
 		for name, tt := range tests {
 			t.Run(name, func(t *testing.T) {
-				r := &repo{}
+				r := user.New(monitor.TestMonitor())
 				err := r.UpdatePassword(ctx, tt.args.uID, tt.args.newPassword)
 				if (err != nil) != tt.wantErr {
 					t.Errorf("repo.UpdatePassword() error = %v, wantErr %v", err, tt.wantErr)
 					return
 				}
+				if tt.want == nil {
+					return
+				}
+
+				got, err := r.GetByID(ctx, tt.args.uID)
+				require.NoError(t, err)
+				require.Equal(t, tt.want.HashedPassword, got.HashedPassword)
+				require.Equal(t, tt.want.Version, got.Version)
 			})
 		}
 	})
@@ -429,6 +486,7 @@ func Test_repo_GetList(t *testing.T) {
 							Role:           "admin",
 							HashedPassword: "123456",
 							Salt:           "abcdef",
+							Version:        1,
 						},
 						{
 							Email:          "admin1@d.foundation",
@@ -438,6 +496,7 @@ func Test_repo_GetList(t *testing.T) {
 							Role:           "admin",
 							HashedPassword: "123456",
 							Salt:           "abcdef",
+							Version:        1,
 						},
 					},
 				},
@@ -469,6 +528,7 @@ func Test_repo_GetList(t *testing.T) {
 							Role:           "admin",
 							HashedPassword: "123456",
 							Salt:           "abcdef",
+							Version:        1,
 						},
 						{
 							Email:          "admin@d.foundation",
@@ -478,6 +538,7 @@ func Test_repo_GetList(t *testing.T) {
 							Role:           "admin",
 							HashedPassword: "123456",
 							Salt:           "abcdef",
+							Version:        1,
 						},
 					},
 				},
@@ -509,6 +570,7 @@ func Test_repo_GetList(t *testing.T) {
 							Role:           "admin",
 							HashedPassword: "123456",
 							Salt:           "abcdef",
+							Version:        1,
 						},
 						{
 							Email:          "admin@d.foundation",
@@ -518,6 +580,7 @@ func Test_repo_GetList(t *testing.T) {
 							Role:           "admin",
 							HashedPassword: "123456",
 							Salt:           "abcdef",
+							Version:        1,
 						},
 					},
 				},
@@ -549,6 +612,7 @@ func Test_repo_GetList(t *testing.T) {
 							Role:           "admin",
 							HashedPassword: "123456",
 							Salt:           "abcdef",
+							Version:        1,
 						},
 					},
 				},
@@ -557,7 +621,7 @@ func Test_repo_GetList(t *testing.T) {
 		}
 		for name, tt := range tests {
 			t.Run(name, func(t *testing.T) {
-				r := &repo{}
+				r := user.New(monitor.TestMonitor())
 				got, err := r.GetList(ctx, model.ListQuery{
 					Page:     tt.args.page,
 					Sort:     tt.args.sort,
@@ -589,3 +653,182 @@ func Test_repo_GetList(t *testing.T) {
 		}
 	})
 }
+
+func Test_repo_GetList_Search(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		users := []*orm.User{
+			{Email: "andy@d.foundation", Name: "Andy Nguyen"},
+			{Email: "tom@d.foundation", Name: "Tom Anderson"},
+			{Email: "jerry@mouse.io", Name: "Jerry"},
+		}
+		for _, u := range users {
+			u.Status = "active"
+			u.Role = "user"
+			u.HashedPassword = "123456"
+			u.Salt = "abcdef"
+			require.NoError(t, u.Insert(ctx, ctx.DB, boil.Infer()))
+		}
+
+		tests := map[string]struct {
+			query          string
+			search         model.SearchOptions
+			wantEmails     []string
+			wantHighlights []map[string]string
+		}{
+			"prefix of the name": {
+				query:      "and",
+				wantEmails: []string{"andy@d.foundation", "tom@d.foundation"},
+			},
+			"domain of the email": {
+				query:      "mouse",
+				wantEmails: []string{"jerry@mouse.io"},
+			},
+			"every term": {
+				query:      "andy nguyen",
+				wantEmails: []string{"andy@d.foundation"},
+			},
+			"misspelled": {
+				query:      "jery",
+				wantEmails: []string{},
+			},
+			"misspelled fuzzy": {
+				query:      "jery",
+				search:     model.SearchOptions{Fuzzy: true},
+				wantEmails: []string{"jerry@mouse.io"},
+			},
+			"highlight": {
+				query:      "tom",
+				search:     model.SearchOptions{Highlight: true},
+				wantEmails: []string{"tom@d.foundation"},
+				wantHighlights: []map[string]string{
+					{"name": "<b>Tom</b> Anderson", "email": "<b>tom</b>@d.foundation"},
+				},
+			},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				r := user.New(monitor.TestMonitor())
+				search := tt.search
+				got, err := r.GetList(ctx, model.ListQuery{
+					Query:  tt.query,
+					Search: &search,
+				})
+				require.NoError(t, err)
+
+				gotEmails := make([]string, 0, len(got.Data))
+				for _, u := range got.Data {
+					gotEmails = append(gotEmails, u.Email)
+				}
+				require.Equal(t, tt.wantEmails, gotEmails)
+				require.Equal(t, tt.wantHighlights, got.Highlights)
+			})
+		}
+	})
+}
+
+func Test_repo_GetListAfter(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		ids := make([]int, 0, 3)
+		for _, email := range []string{"admin@d.foundation", "admin1@d.foundation", "admin2@d.foundation"} {
+			u := &orm.User{
+				Email:          email,
+				Name:           "admin",
+				Status:         "active",
+				Role:           "admin",
+				HashedPassword: "123456",
+				Salt:           "abcdef",
+			}
+			err := u.Insert(ctx, ctx.DB, boil.Infer())
+			require.NoError(t, err)
+			ids = append(ids, u.ID)
+		}
+
+		tests := map[string]struct {
+			afterID int
+			limit   int
+			want    []int
+		}{
+			"first page": {
+				afterID: 0,
+				limit:   2,
+				want:    ids[:2],
+			},
+			"next page": {
+				afterID: ids[1],
+				limit:   2,
+				want:    ids[2:],
+			},
+			"last page": {
+				afterID: ids[2],
+				limit:   2,
+				want:    []int{},
+			},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				r := user.New(monitor.TestMonitor())
+				got, err := r.GetListAfter(ctx, model.AudienceFilter{}, tt.afterID, tt.limit)
+				require.NoError(t, err)
+
+				gotIDs := make([]int, 0, len(got))
+				for _, u := range got {
+					gotIDs = append(gotIDs, u.ID)
+				}
+				require.Equal(t, tt.want, gotIDs)
+			})
+		}
+	})
+}
+
+func Test_repo_CountAudience(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		users := []*orm.User{
+			{Email: "admin@d.foundation", Name: "admin", Status: "active", Role: "admin", CreatedAt: createdAt},
+			{Email: "tom@d.foundation", Name: "Tom", Status: "active", Role: "user", CreatedAt: createdAt.AddDate(0, 1, 0)},
+			{Email: "jerry@d.foundation", Name: "Jerry", Status: "inactive", Role: "user", CreatedAt: createdAt.AddDate(0, 2, 0)},
+		}
+		for _, u := range users {
+			u.HashedPassword = "123456"
+			u.Salt = "abcdef"
+			require.NoError(t, u.Insert(ctx, ctx.DB, boil.Infer()))
+		}
+		pref := &orm.MailPreference{UserID: users[1].ID, Campaigns: false}
+		require.NoError(t, pref.Insert(ctx, ctx.DB, boil.Infer()))
+
+		from := createdAt.AddDate(0, 1, 0)
+		tests := map[string]struct {
+			filter model.AudienceFilter
+			want   int64
+		}{
+			"every user": {
+				filter: model.AudienceFilter{},
+				want:   3,
+			},
+			"role and status": {
+				filter: model.AudienceFilter{Role: model.RoleUser, Status: model.StatusActive},
+				want:   1,
+			},
+			"created range": {
+				filter: model.AudienceFilter{CreatedFrom: &from},
+				want:   2,
+			},
+			"text query": {
+				filter: model.AudienceFilter{Query: "JERRY"},
+				want:   1,
+			},
+			"subscribed": {
+				filter: model.AudienceFilter{Subscribed: true},
+				want:   2,
+			},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				r := user.New(monitor.TestMonitor())
+				got, err := r.CountAudience(ctx, tt.filter)
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			})
+		}
+	})
+}
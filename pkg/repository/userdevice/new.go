@@ -0,0 +1,40 @@
+package userdevice
+
+import (
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/base"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Repo represent the devices the users logged in from
+type Repo interface {
+	Touch(ctx db.Context, uID int, device model.Device, now time.Time) (bool, error)
+}
+
+// New return new user device repo
+func New(monitor monitor.Tracer) Repo {
+	return &repo{
+		devices: base.Repo[orm.UserDevice, model.Device]{
+			Name:       "UserDevice",
+			Tracer:     monitor,
+			PrimaryKey: orm.UserDeviceColumns.ID,
+			QueryFn:    func(mods ...qm.QueryMod) base.Query { return orm.UserDevices(mods...) },
+			MappingFn:  toDeviceModel,
+		},
+	}
+}
+
+func toDeviceModel(m *orm.UserDevice) *model.Device {
+	if m == nil {
+		return nil
+	}
+	return &model.Device{
+		IP:        m.IP,
+		UserAgent: m.UserAgent,
+	}
+}
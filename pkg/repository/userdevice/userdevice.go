@@ -0,0 +1,43 @@
+package userdevice
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/base"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+)
+
+// touchQuery records the device or refreshes it when it's known, xmax is only
+// set on the rows which already existed
+const touchQuery = `
+INSERT INTO user_devices (user_id, fingerprint, ip, user_agent, last_seen_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id, fingerprint) DO UPDATE SET last_seen_at = EXCLUDED.last_seen_at
+RETURNING (xmax = 0) AS inserted`
+
+type repo struct {
+	devices base.Repo[orm.UserDevice, model.Device]
+}
+
+// Touch records the device of the user, it reports whether the device is new.
+func (r *repo) Touch(ctx db.Context, uID int, device model.Device, now time.Time) (bool, error) {
+	var rs struct {
+		Inserted bool `boil:"inserted"`
+	}
+	err := r.devices.Raw(ctx, "Touch", &rs, touchQuery, uID, fingerprint(device), device.IP, device.UserAgent, now)
+	if err != nil {
+		return false, err
+	}
+
+	return rs.Inserted, nil
+}
+
+// fingerprint identifies the device by its user agent and IP
+func fingerprint(d model.Device) string {
+	sum := sha256.Sum256([]byte(d.UserAgent + "\n" + d.IP))
+	return hex.EncodeToString(sum[:])
+}
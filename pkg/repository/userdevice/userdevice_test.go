@@ -0,0 +1,44 @@
+package userdevice_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/orm"
+	"github.com/dwarvesf/go-api/pkg/repository/userdevice"
+	"github.com/dwarvesf/go-api/pkg/testfixtures"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_repo_Touch(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		u := testfixtures.User(t, ctx)
+		laptop := model.Device{IP: "1.1.1.1", UserAgent: "Mozilla/5.0 (Macintosh)"}
+		phone := model.Device{IP: "2.2.2.2", UserAgent: "Mozilla/5.0 (iPhone)"}
+		now := time.Now()
+
+		r := userdevice.New(monitor.TestMonitor())
+		for _, tt := range []struct {
+			name   string
+			device model.Device
+			want   bool
+		}{
+			{name: "first login", device: laptop, want: true},
+			{name: "known device", device: laptop, want: false},
+			{name: "other device", device: phone, want: true},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := r.Touch(ctx, u.ID, tt.device, now)
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			})
+		}
+
+		n, err := orm.UserDevices(orm.UserDeviceWhere.UserID.EQ(u.ID)).Count(ctx, ctx.DB)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, n)
+	})
+}
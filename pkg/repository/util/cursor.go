@@ -0,0 +1,122 @@
+package util
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"golang.org/x/crypto/hkdf"
+)
+
+// Cursor represent the position of a row in a list,
+// Values are the values of the sort columns of the row and ID breaks their ties
+type Cursor struct {
+	// Sort is the normalized sort of the list, a cursor only applies to the list it comes from
+	Sort   string `json:"s"`
+	Values []any  `json:"v"`
+	ID     any    `json:"i"`
+	// Prev is set for the cursor of the previous page, the page ends before the row
+	Prev bool `json:"p,omitempty"`
+}
+
+// cursorSecret signs the cursors, a random one makes the cursors only valid for the life of the process
+var cursorSecret = randomSecret()
+
+// SetCursorSecret sets the key signing the cursors, it's called once at startup.
+func SetCursorSecret(secret string) {
+	if secret != "" {
+		cursorSecret = []byte(secret)
+	}
+}
+
+// DeriveCursorSecret derives the key signing the cursors from the secret key of the app with HKDF,
+// the cursors never share the key signing the tokens. An empty secret key derives no key.
+func DeriveCursorSecret(secretKey string) string {
+	if secretKey == "" {
+		return ""
+	}
+
+	key := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, []byte(secretKey), nil, []byte("cursor")), key); err != nil {
+		panic(err)
+	}
+
+	return string(key)
+}
+
+// EncodeCursor returns the opaque cursor sent to the clients, the payload is signed so it can't be tampered with.
+func EncodeCursor(c Cursor) (string, error) {
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(signCursor(payload)), nil
+}
+
+// DecodeCursor returns the cursor of EncodeCursor, model.ErrInvalidCursor when it's malformed or its signature doesn't match.
+// The numbers are decoded as int64 or float64 and the times as strings, which the database converts back.
+func DecodeCursor(s string) (*Cursor, error) {
+	encodedPayload, encodedSig, ok := strings.Cut(s, ".")
+	if !ok {
+		return nil, model.ErrInvalidCursor
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, model.ErrInvalidCursor
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(encodedSig)
+	if err != nil || !hmac.Equal(sig, signCursor(payload)) {
+		return nil, model.ErrInvalidCursor
+	}
+
+	var c Cursor
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.UseNumber()
+	if err := dec.Decode(&c); err != nil {
+		return nil, model.ErrInvalidCursor
+	}
+	for i, v := range c.Values {
+		c.Values[i] = fromJSONNumber(v)
+	}
+	c.ID = fromJSONNumber(c.ID)
+
+	return &c, nil
+}
+
+func signCursor(payload []byte) []byte {
+	mac := hmac.New(sha256.New, cursorSecret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+func fromJSONNumber(v any) any {
+	n, ok := v.(json.Number)
+	if !ok {
+		return v
+	}
+	if i, err := n.Int64(); err == nil {
+		return i
+	}
+	if f, err := n.Float64(); err == nil {
+		return f
+	}
+
+	return n.String()
+}
+
+func randomSecret() []byte {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+
+	return b
+}
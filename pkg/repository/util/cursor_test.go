@@ -0,0 +1,52 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	createdAt := time.Date(2026, 10, 19, 14, 0, 0, 123456000, time.UTC)
+	encoded, err := EncodeCursor(Cursor{
+		Sort:   "created_at desc",
+		Values: []any{createdAt, 1.5},
+		ID:     42,
+		Prev:   true,
+	})
+	require.NoError(t, err)
+
+	got, err := DecodeCursor(encoded)
+	require.NoError(t, err)
+	require.Equal(t, &Cursor{
+		Sort:   "created_at desc",
+		Values: []any{"2026-10-19T14:00:00.123456Z", 1.5},
+		ID:     int64(42),
+		Prev:   true,
+	}, got)
+
+	tests := map[string]string{
+		"empty":          "",
+		"no signature":   "eyJzIjoiIn0",
+		"bad encoding":   "!!!.!!!",
+		"bad signature":  encoded[:len(encoded)-2] + "AA",
+		"signed by peer": "eyJzIjoiIn0.c2lnbmF0dXJl",
+	}
+	for name, cursor := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := DecodeCursor(cursor)
+			require.ErrorIs(t, err, model.ErrInvalidCursor)
+		})
+	}
+}
+
+func TestDeriveCursorSecret(t *testing.T) {
+	got := DeriveCursorSecret("secret")
+	require.Len(t, got, 32)
+	require.NotEqual(t, "secret", got)
+	require.Equal(t, got, DeriveCursorSecret("secret"))
+	require.NotEqual(t, got, DeriveCursorSecret("other"))
+	require.Empty(t, DeriveCursorSecret(""))
+}
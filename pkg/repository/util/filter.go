@@ -0,0 +1,189 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// FieldType represent the type of the values of a field, the values of the filters are parsed as it
+type FieldType int
+
+const (
+	// FieldTypeString is a text column
+	FieldTypeString FieldType = iota
+	// FieldTypeInt is an integer column
+	FieldTypeInt
+	// FieldTypeBool is a boolean column
+	FieldTypeBool
+	// FieldTypeTime is a timestamp column, the values are RFC 3339 times or dates
+	FieldTypeTime
+)
+
+// Field represent a column the clients may sort or filter a list by
+type Field struct {
+	Column     string
+	Type       FieldType
+	Sortable   bool
+	Filterable bool
+	// Nullable allows the is_null operator
+	Nullable bool
+}
+
+// Fields is the whitelist of the fields of a list by their name in the requests,
+// the requests only reach the database through the columns declared here:
+//
+//	var userFields = util.Fields{
+//		"email":      {Column: orm.UserColumns.Email, Sortable: true, Filterable: true},
+//		"created_at": {Column: orm.UserColumns.CreatedAt, Type: util.FieldTypeTime, Sortable: true, Filterable: true},
+//	}
+type Fields map[string]Field
+
+// Sort returns the columns of the sort, it accepts the syntax of ParseSort and
+// returns a model.ErrInvalidSort error for the fields which aren't sortable.
+func (fs Fields) Sort(sort string) ([]SortField, error) {
+	items := strings.Split(ParseSort(sort), ",")
+	rs := make([]SortField, 0, len(items))
+	for _, itm := range items {
+		parts := strings.Fields(itm)
+		if len(parts) == 0 || len(parts) > 2 || (len(parts) == 2 && parts[1] != "asc" && parts[1] != "desc") {
+			return nil, invalidSort("invalid sort %q", strings.TrimSpace(itm))
+		}
+		f, ok := fs[parts[0]]
+		if !ok || !f.Sortable {
+			return nil, invalidSort("unknown sort field %s", parts[0])
+		}
+		rs = append(rs, SortField{
+			Column: f.Column,
+			Desc:   len(parts) == 2 && parts[1] == "desc",
+		})
+	}
+
+	return rs, nil
+}
+
+// Filter returns the conditions of the filters, the values are parsed as the type of their field.
+// It returns a model.ErrInvalidFilter error for the fields which aren't filterable and the invalid values.
+func (fs Fields) Filter(filters []model.Filter) ([]qm.QueryMod, error) {
+	rs := make([]qm.QueryMod, 0, len(filters))
+	for _, filter := range filters {
+		f, ok := fs[filter.Field]
+		if !ok || !f.Filterable {
+			return nil, invalidFilter("unknown filter field %s", filter.Field)
+		}
+
+		mod, err := f.filter(filter)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, mod)
+	}
+
+	return rs, nil
+}
+
+func (f Field) filter(filter model.Filter) (qm.QueryMod, error) {
+	switch filter.Operator {
+	case model.FilterOperatorEq, model.FilterOperatorNe:
+		v, err := f.parse(filter)
+		if err != nil {
+			return nil, err
+		}
+		op := " = ?"
+		if filter.Operator == model.FilterOperatorNe {
+			op = " <> ?"
+		}
+		return qm.Where(f.Column+op, v), nil
+
+	case model.FilterOperatorGte, model.FilterOperatorLte:
+		if f.Type == FieldTypeBool {
+			return nil, invalidFilter("operator %s isn't supported by %s", filter.Operator, filter.Field)
+		}
+		v, err := f.parse(filter)
+		if err != nil {
+			return nil, err
+		}
+		op := " >= ?"
+		if filter.Operator == model.FilterOperatorLte {
+			op = " <= ?"
+		}
+		return qm.Where(f.Column+op, v), nil
+
+	case model.FilterOperatorIn:
+		items := strings.Split(filter.Value, ",")
+		values := make([]interface{}, 0, len(items))
+		for _, itm := range items {
+			v, err := f.parse(model.Filter{Field: filter.Field, Value: strings.TrimSpace(itm)})
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+		}
+		return qm.WhereIn(f.Column+" IN ?", values...), nil
+
+	case model.FilterOperatorLike:
+		if f.Type != FieldTypeString {
+			return nil, invalidFilter("operator %s isn't supported by %s", filter.Operator, filter.Field)
+		}
+		return qm.Where(f.Column+" ILIKE ?", "%"+escapeLike(filter.Value)+"%"), nil
+
+	case model.FilterOperatorIsNull:
+		if !f.Nullable {
+			return nil, invalidFilter("operator %s isn't supported by %s", filter.Operator, filter.Field)
+		}
+		isNull, err := strconv.ParseBool(filter.Value)
+		if err != nil {
+			return nil, invalidFilter("invalid value %q of %s", filter.Value, filter.Field)
+		}
+		if isNull {
+			return qm.Where(f.Column + " IS NULL"), nil
+		}
+		return qm.Where(f.Column + " IS NOT NULL"), nil
+
+	default:
+		return nil, invalidFilter("unknown filter operator %s", filter.Operator)
+	}
+}
+
+// parse parses the value of the filter as the type of the field
+func (f Field) parse(filter model.Filter) (interface{}, error) {
+	var (
+		v   interface{}
+		err error
+	)
+	switch f.Type {
+	case FieldTypeInt:
+		v, err = strconv.ParseInt(filter.Value, 10, 64)
+	case FieldTypeBool:
+		v, err = strconv.ParseBool(filter.Value)
+	case FieldTypeTime:
+		v, err = time.Parse(time.RFC3339, filter.Value)
+		if err != nil {
+			v, err = time.Parse(time.DateOnly, filter.Value)
+		}
+	default:
+		v = filter.Value
+	}
+	if err != nil {
+		return nil, invalidFilter("invalid value %q of %s", filter.Value, filter.Field)
+	}
+
+	return v, nil
+}
+
+// escapeLike escapes the wildcards of the value so it's matched literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func invalidFilter(format string, args ...any) error {
+	return model.NewError(model.ErrInvalidFilter.Status, model.ErrInvalidFilter.Code, fmt.Sprintf(format, args...))
+}
+
+func invalidSort(format string, args ...any) error {
+	return model.NewError(model.ErrInvalidSort.Status, model.ErrInvalidSort.Code, fmt.Sprintf(format, args...))
+}
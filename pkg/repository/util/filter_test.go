@@ -0,0 +1,166 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var testFields = Fields{
+	"id":         {Column: "id", Type: FieldTypeInt, Sortable: true, Filterable: true},
+	"status":     {Column: "status", Filterable: true},
+	"verified":   {Column: "verified", Type: FieldTypeBool, Filterable: true},
+	"created_at": {Column: "users.created_at", Type: FieldTypeTime, Sortable: true, Filterable: true},
+	"deleted_at": {Column: "deleted_at", Type: FieldTypeTime, Filterable: true, Nullable: true},
+	"password":   {Column: "hashed_password"},
+}
+
+func buildWhere(mods []qm.QueryMod) (string, []interface{}) {
+	q := &queries.Query{}
+	queries.SetDialect(q, &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true})
+	queries.SetFrom(q, "users")
+	qm.Apply(q, mods...)
+
+	return queries.BuildQuery(q)
+}
+
+func TestFields_Filter(t *testing.T) {
+	tests := map[string]struct {
+		filter   model.Filter
+		wantSQL  string
+		wantArgs []interface{}
+		wantErr  string
+	}{
+		"eq": {
+			filter:   model.Filter{Field: "status", Operator: model.FilterOperatorEq, Value: "active"},
+			wantSQL:  `SELECT * FROM "users" WHERE (status = $1);`,
+			wantArgs: []interface{}{"active"},
+		},
+		"ne bool": {
+			filter:   model.Filter{Field: "verified", Operator: model.FilterOperatorNe, Value: "true"},
+			wantSQL:  `SELECT * FROM "users" WHERE (verified <> $1);`,
+			wantArgs: []interface{}{true},
+		},
+		"gte date": {
+			filter:   model.Filter{Field: "created_at", Operator: model.FilterOperatorGte, Value: "2026-10-19"},
+			wantSQL:  `SELECT * FROM "users" WHERE (users.created_at >= $1);`,
+			wantArgs: []interface{}{time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+		},
+		"lte time": {
+			filter:   model.Filter{Field: "created_at", Operator: model.FilterOperatorLte, Value: "2026-10-19T14:00:00Z"},
+			wantSQL:  `SELECT * FROM "users" WHERE (users.created_at <= $1);`,
+			wantArgs: []interface{}{time.Date(2026, 10, 19, 14, 0, 0, 0, time.UTC)},
+		},
+		"in": {
+			filter:   model.Filter{Field: "id", Operator: model.FilterOperatorIn, Value: "1, 2,3"},
+			wantSQL:  `SELECT * FROM "users" WHERE ("id" IN ($1,$2,$3));`,
+			wantArgs: []interface{}{int64(1), int64(2), int64(3)},
+		},
+		"like escapes the wildcards": {
+			filter:   model.Filter{Field: "status", Operator: model.FilterOperatorLike, Value: "50%_off"},
+			wantSQL:  `SELECT * FROM "users" WHERE (status ILIKE $1);`,
+			wantArgs: []interface{}{`%50\%\_off%`},
+		},
+		"is null": {
+			filter:  model.Filter{Field: "deleted_at", Operator: model.FilterOperatorIsNull, Value: "true"},
+			wantSQL: `SELECT * FROM "users" WHERE (deleted_at IS NULL);`,
+		},
+		"is not null": {
+			filter:  model.Filter{Field: "deleted_at", Operator: model.FilterOperatorIsNull, Value: "false"},
+			wantSQL: `SELECT * FROM "users" WHERE (deleted_at IS NOT NULL);`,
+		},
+		"unknown field": {
+			filter:  model.Filter{Field: "email", Operator: model.FilterOperatorEq, Value: "a"},
+			wantErr: "unknown filter field email",
+		},
+		"not filterable": {
+			filter:  model.Filter{Field: "password", Operator: model.FilterOperatorEq, Value: "a"},
+			wantErr: "unknown filter field password",
+		},
+		"unknown operator": {
+			filter:  model.Filter{Field: "status", Operator: "between", Value: "a"},
+			wantErr: "unknown filter operator between",
+		},
+		"invalid int": {
+			filter:  model.Filter{Field: "id", Operator: model.FilterOperatorIn, Value: "1,x"},
+			wantErr: `invalid value "x" of id`,
+		},
+		"invalid time": {
+			filter:  model.Filter{Field: "created_at", Operator: model.FilterOperatorGte, Value: "yesterday"},
+			wantErr: `invalid value "yesterday" of created_at`,
+		},
+		"like on a number": {
+			filter:  model.Filter{Field: "id", Operator: model.FilterOperatorLike, Value: "1"},
+			wantErr: "operator like isn't supported by id",
+		},
+		"is null on a required field": {
+			filter:  model.Filter{Field: "status", Operator: model.FilterOperatorIsNull, Value: "true"},
+			wantErr: "operator is_null isn't supported by status",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mods, err := testFields.Filter([]model.Filter{tt.filter})
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				var e model.Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, model.ErrInvalidFilter.Code, e.Code)
+				return
+			}
+			require.NoError(t, err)
+			sql, args := buildWhere(mods)
+			require.Equal(t, tt.wantSQL, sql)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestFields_Sort(t *testing.T) {
+	tests := map[string]struct {
+		sort    string
+		want    []SortField
+		wantErr string
+	}{
+		"default": {
+			sort: "",
+			want: []SortField{{Column: "users.created_at", Desc: true}},
+		},
+		"multiple columns": {
+			sort: "-Created_At, +id",
+			want: []SortField{{Column: "users.created_at", Desc: true}, {Column: "id"}},
+		},
+		"without direction": {
+			sort: "id",
+			want: []SortField{{Column: "id"}},
+		},
+		"not sortable": {
+			sort:    "-status",
+			wantErr: "unknown sort field status",
+		},
+		"injection": {
+			sort:    "id; drop table users",
+			wantErr: `invalid sort "id; drop table users"`,
+		},
+		"subquery": {
+			sort:    "(select 1)",
+			wantErr: `invalid sort "(select 1)"`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := testFields.Sort(tt.sort)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
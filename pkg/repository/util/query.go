@@ -58,7 +58,8 @@ func calculateTotalPages(totalRecords int, pageSize int) int {
 	return totalPages
 }
 
-// ParseSort parse sort string
+// ParseSort parse sort string, the columns aren't checked so the result must not reach
+// the query as is: Fields.Sort checks them against the whitelist of the list
 func ParseSort(sort string) string {
 	if sort == "" {
 		return "created_at desc"
@@ -80,3 +81,51 @@ func ParseSort(sort string) string {
 
 	return strings.Join(sItems, ", ")
 }
+
+// SortField represent a column of a sort
+type SortField struct {
+	Column string
+	Desc   bool
+}
+
+// OrderBy returns the ORDER BY clause of the fields
+func OrderBy(fields []SortField) string {
+	items := make([]string, 0, len(fields))
+	for _, f := range fields {
+		dir := " asc"
+		if f.Desc {
+			dir = " desc"
+		}
+		items = append(items, f.Column+dir)
+	}
+
+	return strings.Join(items, ", ")
+}
+
+// KeysetWhere returns the condition matching the rows sorted after the values by the fields:
+//
+//	(a > ?) OR (a = ? AND b < ?)
+//
+// for the fields "a asc, b desc". The last field must be unique for the rows not to be skipped.
+func KeysetWhere(fields []SortField, values []any) (string, []any) {
+	var (
+		clauses = make([]string, 0, len(fields))
+		args    []any
+	)
+	for i, f := range fields {
+		conds := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			conds = append(conds, fields[j].Column+" = ?")
+			args = append(args, values[j])
+		}
+		op := " > ?"
+		if f.Desc {
+			op = " < ?"
+		}
+		conds = append(conds, f.Column+op)
+		args = append(args, values[i])
+		clauses = append(clauses, "("+strings.Join(conds, " AND ")+")")
+	}
+
+	return "(" + strings.Join(clauses, " OR ") + ")", args
+}
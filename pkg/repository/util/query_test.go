@@ -215,3 +215,20 @@ func TestParseSort(t *testing.T) {
 		})
 	}
 }
+
+func TestKeysetWhere(t *testing.T) {
+	fields := []SortField{{Column: "created_at", Desc: true}, {Column: "name"}, {Column: "id"}}
+
+	where, args := KeysetWhere(fields, []any{"2026-10-19", "andy", 7})
+	want := "((created_at < ?) OR (created_at = ? AND name > ?) OR (created_at = ? AND name = ? AND id > ?))"
+	if where != want {
+		t.Errorf("KeysetWhere() = %v, want %v", where, want)
+	}
+	wantArgs := []any{"2026-10-19", "2026-10-19", "andy", "2026-10-19", "andy", 7}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("KeysetWhere() args = %v, want %v", args, wantArgs)
+	}
+	if got := OrderBy(fields); got != "created_at desc, name asc, id asc" {
+		t.Errorf("OrderBy() = %v", got)
+	}
+}
@@ -0,0 +1,71 @@
+package util
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// SearchTerms returns the words of the search query in lower case, the other characters are dropped
+func SearchTerms(query string) []string {
+	return strings.FieldsFunc(strings.ToLower(query), isNotWordRune)
+}
+
+// TSQuery returns the tsquery matching the documents with a word starting with every term,
+// such as "and:* & ngu:*". The terms only hold letters and digits so they can't break the syntax.
+func TSQuery(terms []string) string {
+	items := make([]string, 0, len(terms))
+	for _, t := range terms {
+		items = append(items, t+":*")
+	}
+
+	return strings.Join(items, " & ")
+}
+
+// Highlight wraps the words of the text starting with one of the terms in <b> tags,
+// the rest of the text is HTML escaped so it's safe to render.
+func Highlight(text string, terms []string) string {
+	var (
+		sb    strings.Builder
+		start = -1
+	)
+	flush := func(end int) {
+		word := text[start:end]
+		if matchesTerm(strings.ToLower(word), terms) {
+			sb.WriteString("<b>" + html.EscapeString(word) + "</b>")
+		} else {
+			sb.WriteString(html.EscapeString(word))
+		}
+		start = -1
+	}
+	for i, r := range text {
+		if !isNotWordRune(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			flush(i)
+		}
+		sb.WriteString(html.EscapeString(string(r)))
+	}
+	if start >= 0 {
+		flush(len(text))
+	}
+
+	return sb.String()
+}
+
+func matchesTerm(word string, terms []string) bool {
+	for _, t := range terms {
+		if strings.HasPrefix(word, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func isNotWordRune(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
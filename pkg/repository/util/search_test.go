@@ -0,0 +1,68 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSearchTerms(t *testing.T) {
+	tests := map[string]struct {
+		query string
+		want  []string
+	}{
+		"words":          {query: "Andy  Nguyễn", want: []string{"andy", "nguyễn"}},
+		"email":          {query: "andy@d.foundation", want: []string{"andy", "d", "foundation"}},
+		"tsquery syntax": {query: "a:* | !b & 'c'", want: []string{"a", "b", "c"}},
+		"no word":        {query: " -- ", want: []string{}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := SearchTerms(tt.query)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SearchTerms() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTSQuery(t *testing.T) {
+	if got := TSQuery([]string{"and", "ngu"}); got != "and:* & ngu:*" {
+		t.Errorf("TSQuery() = %v", got)
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := map[string]struct {
+		text  string
+		terms []string
+		want  string
+	}{
+		"prefix": {
+			text:  "Andy Nguyen",
+			terms: []string{"and"},
+			want:  "<b>Andy</b> Nguyen",
+		},
+		"email": {
+			text:  "andy@d.foundation",
+			terms: []string{"found"},
+			want:  "andy@d.<b>foundation</b>",
+		},
+		"escaped": {
+			text:  "<script>andy</script>",
+			terms: []string{"andy"},
+			want:  "&lt;script&gt;<b>andy</b>&lt;/script&gt;",
+		},
+		"no match": {
+			text:  "Andy",
+			terms: []string{"bob"},
+			want:  "Andy",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := Highlight(tt.text, tt.terms); got != tt.want {
+				t.Errorf("Highlight() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/repository/schedule"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	// LockKey is the key of the advisory lock held by the leader
+	LockKey int64 = 7_300_001
+
+	// electionInterval is the wait between two attempts to become the leader,
+	// the leader also checks that it still holds the lock at this pace
+	electionInterval = 10 * time.Second
+)
+
+// ErrDuplicateSchedule is returned when a schedule name is registered twice
+var ErrDuplicateSchedule = errors.New("schedule is already registered")
+
+// Task is the work run on every tick of a schedule
+type Task func(ctx context.Context) error
+
+// Locker elects the leader among the replicas
+type Locker interface {
+	TryLock(ctx context.Context) (bool, error)
+	Check(ctx context.Context) error
+	Unlock(ctx context.Context) error
+}
+
+type entry struct {
+	name     string
+	spec     string
+	schedule cron.Schedule
+	task     Task
+	next     time.Time
+	running  bool
+}
+
+// Scheduler runs the registered tasks on their cron schedule. Only the
+// replica holding the lock fires the ticks, the others wait to take over.
+type Scheduler struct {
+	repo    schedule.Repo
+	lock    Locker
+	entries map[string]*entry
+	mutex   sync.Mutex
+	wg      sync.WaitGroup
+	log     logger.Log
+	now     func() time.Time
+}
+
+// New creates a scheduler elected with the Postgres advisory lock.
+func New(repo schedule.Repo, l logger.Log) *Scheduler {
+	return &Scheduler{
+		repo:    repo,
+		lock:    db.NewAdvisoryLock(LockKey),
+		entries: make(map[string]*entry),
+		log:     l,
+		now:     time.Now,
+	}
+}
+
+// Register adds the task run on the standard 5 fields cron spec, e.g. "0 8 * * *".
+func (s *Scheduler) Register(name string, spec string, task Task) error {
+	sched, err := cron.ParseStandard(spec)
+	if err != nil {
+		return fmt.Errorf("invalid spec %q of schedule %s: %w", spec, name, err)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.entries[name]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicateSchedule, name)
+	}
+	s.entries[name] = &entry{
+		name:     name,
+		spec:     spec,
+		schedule: sched,
+		task:     task,
+	}
+
+	return nil
+}
+
+// Run campaigns for the leadership and fires the ticks while leading,
+// until the context is done. It waits for the running tasks before returning.
+func (s *Scheduler) Run(ctx context.Context) {
+	defer s.wg.Wait()
+
+	for {
+		leader, err := s.lock.TryLock(ctx)
+		if err != nil && ctx.Err() == nil {
+			s.log.Error(err, "failed to acquire the scheduler lock")
+		}
+		if leader {
+			s.log.Info("scheduler elected as leader")
+			s.lead(ctx)
+			if err := s.lock.Unlock(context.Background()); err != nil {
+				s.log.Error(err, "failed to release the scheduler lock")
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(electionInterval):
+		}
+	}
+}
+
+// lead fires the due schedules until the context is done or the lock is lost.
+func (s *Scheduler) lead(ctx context.Context) {
+	if err := s.load(ctx); err != nil {
+		s.log.Error(err, "failed to load the schedules")
+		return
+	}
+
+	for {
+		s.fireDue(ctx)
+
+		wait := electionInterval
+		if next, ok := s.nextTick(); ok && next.Sub(s.now()) < wait {
+			wait = next.Sub(s.now())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+
+		if err := s.lock.Check(ctx); err != nil {
+			s.log.Error(err, "scheduler lost the leadership")
+			return
+		}
+	}
+}
+
+// load restores the next run of the schedules saved by the previous leader so
+// a tick missed during a failover still fires once, a changed spec starts over.
+func (s *Scheduler) load(ctx context.Context) error {
+	dbCtx := db.FromContext(ctx)
+	saved, err := s.repo.GetList(dbCtx)
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]model.Schedule, len(saved))
+	for _, sc := range saved {
+		byName[sc.Name] = sc
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	for _, e := range s.entries {
+		e.next = e.schedule.Next(now)
+		if sc, ok := byName[e.name]; ok && sc.Spec == e.spec && sc.NextRunAt != nil {
+			e.next = *sc.NextRunAt
+		}
+		if err := s.repo.Save(dbCtx, e.name, e.spec, e.next); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nextTick returns the earliest next run of the schedules.
+func (s *Scheduler) nextTick() (time.Time, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var next time.Time
+	for _, e := range s.entries {
+		if next.IsZero() || e.next.Before(next) {
+			next = e.next
+		}
+	}
+
+	return next, !next.IsZero()
+}
+
+// fireDue starts the task of every due schedule, a task still running from
+// the previous tick is skipped instead of running twice at the same time.
+func (s *Scheduler) fireDue(ctx context.Context) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	names := make([]string, 0, len(s.entries))
+	for name := range s.entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		e := s.entries[name]
+		if e.next.After(now) {
+			continue
+		}
+		e.next = e.schedule.Next(now)
+		if e.running {
+			s.log.Warnf("schedule %s is still running, skipped the tick", e.name)
+			continue
+		}
+
+		e.running = true
+		s.wg.Add(1)
+		go func(e *entry, next time.Time) {
+			defer s.wg.Done()
+			s.fire(e, next)
+		}(e, e.next)
+	}
+}
+
+// fire runs the task and records the outcome. The task isn't bound to the
+// leadership context so that a shutdown doesn't abort it halfway.
+func (s *Scheduler) fire(e *entry, next time.Time) {
+	ctx := context.Background()
+	dbCtx := db.FromContext(ctx)
+	startedAt := s.now()
+
+	run := model.ScheduleRun{
+		StartedAt: startedAt,
+		NextRunAt: next,
+		Status:    model.ScheduleStatusRunning,
+	}
+	if err := s.repo.UpdateRun(dbCtx, e.name, run); err != nil {
+		s.log.Errorf(err, "failed to record the start of schedule %s", e.name)
+	}
+
+	err := s.call(ctx, e.task)
+	run.Duration = s.now().Sub(startedAt)
+	run.Status = model.ScheduleStatusSucceeded
+	if err != nil {
+		s.log.Errorf(err, "schedule %s failed", e.name)
+		run.Status = model.ScheduleStatusFailed
+		run.Error = err.Error()
+	}
+	if err := s.repo.UpdateRun(dbCtx, e.name, run); err != nil {
+		s.log.Errorf(err, "failed to record the outcome of schedule %s", e.name)
+	}
+
+	s.mutex.Lock()
+	e.running = false
+	s.mutex.Unlock()
+}
+
+// call runs the task and turns a panic into an error.
+func (s *Scheduler) call(ctx context.Context, task Task) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return task(ctx)
+}
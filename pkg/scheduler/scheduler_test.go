@@ -0,0 +1,136 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mocks "github.com/dwarvesf/go-api/mocks/pkg/repository/schedule"
+	lockmocks "github.com/dwarvesf/go-api/mocks/pkg/scheduler"
+	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler_Register(t *testing.T) {
+	s := New(mocks.NewRepo(t), logger.NewLogger())
+	noop := func(ctx context.Context) error { return nil }
+
+	require.NoError(t, s.Register("daily", "0 0 * * *", noop))
+	assert.ErrorIs(t, s.Register("daily", "0 1 * * *", noop), ErrDuplicateSchedule)
+	assert.Error(t, s.Register("invalid", "every day", noop))
+}
+
+func TestScheduler_load(t *testing.T) {
+	now := time.Date(2026, 10, 19, 10, 30, 0, 0, time.UTC)
+	missed := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		saved    []model.Schedule
+		wantNext time.Time
+	}{
+		"new schedule": {
+			wantNext: time.Date(2026, 10, 19, 11, 0, 0, 0, time.UTC),
+		},
+		"missed tick fires once": {
+			saved:    []model.Schedule{{Name: "hourly", Spec: "0 * * * *", NextRunAt: &missed}},
+			wantNext: missed,
+		},
+		"changed spec starts over": {
+			saved:    []model.Schedule{{Name: "hourly", Spec: "0 0 * * *", NextRunAt: &missed}},
+			wantNext: time.Date(2026, 10, 19, 11, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			repoMock := mocks.NewRepo(t)
+			repoMock.EXPECT().GetList(mock.Anything).Return(tt.saved, nil)
+			repoMock.EXPECT().Save(mock.Anything, "hourly", "0 * * * *", tt.wantNext).Return(nil)
+
+			_, err := db.Init(config.LoadTestConfig())
+			require.NoError(t, err)
+
+			s := New(repoMock, logger.NewLogger())
+			s.now = func() time.Time { return now }
+			require.NoError(t, s.Register("hourly", "0 * * * *", func(ctx context.Context) error { return nil }))
+
+			require.NoError(t, s.load(context.Background()))
+			assert.Equal(t, tt.wantNext, s.entries["hourly"].next)
+		})
+	}
+}
+
+func TestScheduler_fireDue(t *testing.T) {
+	now := time.Date(2026, 10, 19, 11, 0, 0, 0, time.UTC)
+	next := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		next       time.Time
+		running    bool
+		taskErr    error
+		wantStatus model.ScheduleStatus
+		wantErr    string
+	}{
+		"succeeded": {
+			next:       now,
+			wantStatus: model.ScheduleStatusSucceeded,
+		},
+		"failed": {
+			next:       now,
+			taskErr:    errors.New("smtp unavailable"),
+			wantStatus: model.ScheduleStatusFailed,
+			wantErr:    "smtp unavailable",
+		},
+		"not due": {
+			next: now.Add(time.Minute),
+		},
+		"still running": {
+			next:    now,
+			running: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			repoMock := mocks.NewRepo(t)
+			if tt.wantStatus != "" {
+				repoMock.EXPECT().UpdateRun(mock.Anything, "hourly", model.ScheduleRun{
+					StartedAt: now,
+					NextRunAt: next,
+					Status:    model.ScheduleStatusRunning,
+				}).Return(nil).Once()
+				repoMock.EXPECT().UpdateRun(mock.Anything, "hourly", model.ScheduleRun{
+					StartedAt: now,
+					NextRunAt: next,
+					Status:    tt.wantStatus,
+					Error:     tt.wantErr,
+				}).Return(nil).Once()
+			}
+
+			_, err := db.Init(config.LoadTestConfig())
+			require.NoError(t, err)
+
+			ran := false
+			s := New(repoMock, logger.NewLogger())
+			s.lock = lockmocks.NewLocker(t)
+			s.now = func() time.Time { return now }
+			require.NoError(t, s.Register("hourly", "0 * * * *", func(ctx context.Context) error {
+				ran = true
+				return tt.taskErr
+			}))
+			s.entries["hourly"].next = tt.next
+			s.entries["hourly"].running = tt.running
+
+			s.fireDue(context.Background())
+			s.wg.Wait()
+
+			assert.Equal(t, tt.wantStatus != "", ran)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package seed
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/seeds"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrProfileNotFound is returned when no fixture file is named after the profile
+var ErrProfileNotFound = errors.New("seeding profile not found")
+
+// profileExts are the extensions of the fixture files, in the order they're looked up
+var profileExts = []string{".yaml", ".yml", ".json"}
+
+// Fixtures are the records of a seeding profile
+type Fixtures struct {
+	Users     []UserFixture     `json:"users" yaml:"users"`
+	Campaigns []CampaignFixture `json:"campaigns" yaml:"campaigns"`
+}
+
+// UserFixture is a user with its plain password, the role and the status default to an active user
+type UserFixture struct {
+	Email          string                 `json:"email" yaml:"email"`
+	Name           string                 `json:"name" yaml:"name"`
+	Password       string                 `json:"password" yaml:"password"`
+	Avatar         string                 `json:"avatar" yaml:"avatar"`
+	Role           model.Role             `json:"role" yaml:"role"`
+	Status         model.Status           `json:"status" yaml:"status"`
+	MailPreference *MailPreferenceFixture `json:"mailPreference" yaml:"mailPreference"`
+}
+
+// MailPreferenceFixture is the mail preference of a user
+type MailPreferenceFixture struct {
+	Campaigns bool `json:"campaigns" yaml:"campaigns"`
+}
+
+// CampaignFixture is a draft campaign
+type CampaignFixture struct {
+	Name     string          `json:"name" yaml:"name"`
+	Template string          `json:"template" yaml:"template"`
+	Audience AudienceFixture `json:"audience" yaml:"audience"`
+}
+
+// AudienceFixture is the audience of a campaign
+type AudienceFixture struct {
+	Role       model.Role   `json:"role" yaml:"role"`
+	Status     model.Status `json:"status" yaml:"status"`
+	Query      string       `json:"query" yaml:"query"`
+	Subscribed bool         `json:"subscribed" yaml:"subscribed"`
+}
+
+// Load reads the fixtures of a profile embedded in the binary
+func Load(profile string) (*Fixtures, error) {
+	for _, ext := range profileExts {
+		name := profile + ext
+		data, err := fs.ReadFile(seeds.FS, name)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return Parse(name, data)
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrProfileNotFound, profile)
+}
+
+// LoadFile reads the fixtures of a YAML or JSON file
+func LoadFile(path string) (*Fixtures, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(path, data)
+}
+
+// Parse decodes the fixtures by the extension of the file name, the unknown fields are rejected
+func Parse(name string, data []byte) (*Fixtures, error) {
+	var f Fixtures
+	switch filepath.Ext(name) {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(&f); err != nil {
+			return nil, fmt.Errorf("parsing %s failed. err: %w", name, err)
+		}
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&f); err != nil {
+			return nil, fmt.Errorf("parsing %s failed. err: %w", name, err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported fixture file %s", name)
+	}
+
+	if err := f.validate(); err != nil {
+		return nil, fmt.Errorf("invalid fixtures in %s: %w", name, err)
+	}
+
+	return &f, nil
+}
+
+func (f Fixtures) validate() error {
+	for i, u := range f.Users {
+		if u.Email == "" || u.Password == "" {
+			return fmt.Errorf("users[%d]: the email and the password are required", i)
+		}
+		if u.Role != "" && u.Role != model.RoleUser && u.Role != model.RoleAdmin {
+			return fmt.Errorf("users[%d]: unknown role %q", i, u.Role)
+		}
+		if u.Status != "" && u.Status != model.StatusActive && u.Status != model.StatusInactive {
+			return fmt.Errorf("users[%d]: unknown status %q", i, u.Status)
+		}
+	}
+	for i, c := range f.Campaigns {
+		if c.Name == "" || c.Template == "" {
+			return fmt.Errorf("campaigns[%d]: the name and the template are required", i)
+		}
+	}
+
+	return nil
+}
+
+func (u UserFixture) toRequest() model.SignupRequest {
+	req := model.SignupRequest{
+		Email:  u.Email,
+		Name:   u.Name,
+		Avatar: u.Avatar,
+		Role:   u.Role,
+		Status: u.Status,
+	}
+	if req.Role == "" {
+		req.Role = model.RoleUser
+	}
+	if req.Status == "" {
+		req.Status = model.StatusActive
+	}
+
+	return req
+}
+
+func (c CampaignFixture) toRequest() model.CampaignRequest {
+	return model.CampaignRequest{
+		Name:     c.Name,
+		Template: c.Template,
+		Audience: model.AudienceFilter{
+			Role:       c.Audience.Role,
+			Status:     c.Audience.Status,
+			Query:      c.Audience.Query,
+			Subscribed: c.Audience.Subscribed,
+		},
+	}
+}
@@ -0,0 +1,113 @@
+package seed
+
+import (
+	"testing"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	for _, profile := range []string{"dev", "demo"} {
+		t.Run(profile, func(t *testing.T) {
+			f, err := Load(profile)
+			require.NoError(t, err)
+			require.NotEmpty(t, f.Users)
+			require.NotEmpty(t, f.Campaigns)
+		})
+	}
+
+	_, err := Load("unknown")
+	require.ErrorIs(t, err, ErrProfileNotFound)
+}
+
+func TestParse(t *testing.T) {
+	tests := map[string]struct {
+		name    string
+		data    string
+		want    *Fixtures
+		wantErr bool
+	}{
+		"yaml": {
+			name: "fixtures.yaml",
+			data: `
+users:
+  - email: admin@d.foundation
+    password: "123456"
+    role: admin
+    mailPreference:
+      campaigns: true
+campaigns:
+  - name: News
+    template: welcome
+    audience:
+      role: user
+`,
+			want: &Fixtures{
+				Users: []UserFixture{{
+					Email:          "admin@d.foundation",
+					Password:       "123456",
+					Role:           model.RoleAdmin,
+					MailPreference: &MailPreferenceFixture{Campaigns: true},
+				}},
+				Campaigns: []CampaignFixture{{
+					Name:     "News",
+					Template: "welcome",
+					Audience: AudienceFixture{Role: model.RoleUser},
+				}},
+			},
+		},
+		"json": {
+			name: "fixtures.json",
+			data: `{"users": [{"email": "user@d.foundation", "password": "123456", "status": "inactive"}]}`,
+			want: &Fixtures{
+				Users: []UserFixture{{
+					Email:    "user@d.foundation",
+					Password: "123456",
+					Status:   model.StatusInactive,
+				}},
+			},
+		},
+		"unknown field": {
+			name:    "fixtures.yaml",
+			data:    "users:\n  - email: user@d.foundation\n    password: \"123456\"\n    nickname: user\n",
+			wantErr: true,
+		},
+		"missing password": {
+			name:    "fixtures.json",
+			data:    `{"users": [{"email": "user@d.foundation"}]}`,
+			wantErr: true,
+		},
+		"unknown role": {
+			name:    "fixtures.json",
+			data:    `{"users": [{"email": "user@d.foundation", "password": "123456", "role": "root"}]}`,
+			wantErr: true,
+		},
+		"unsupported file": {
+			name:    "fixtures.toml",
+			data:    "",
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Parse(tt.name, []byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUserFixture_toRequest(t *testing.T) {
+	req := UserFixture{Email: "user@d.foundation", Name: "user"}.toRequest()
+	require.Equal(t, model.SignupRequest{
+		Email:  "user@d.foundation",
+		Name:   "user",
+		Role:   model.RoleUser,
+		Status: model.StatusActive,
+	}, req)
+}
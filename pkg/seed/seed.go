@@ -0,0 +1,107 @@
+package seed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/service/passwordhelper"
+)
+
+// Result counts the records created by a seeding, the existing ones are skipped
+type Result struct {
+	Users     int
+	Campaigns int
+}
+
+// Seeder inserts the fixtures through the repos
+type Seeder struct {
+	repo           *repository.Repo
+	passwordHelper passwordhelper.Helper
+}
+
+// New creates the seeder, the passwords are hashed the way the signup does
+func New(repo *repository.Repo) *Seeder {
+	return &Seeder{
+		repo:           repo,
+		passwordHelper: passwordhelper.NewScrypt(),
+	}
+}
+
+// Seed inserts the fixtures in a transaction, the users are matched by email and the
+// campaigns by name so seeding a profile twice doesn't duplicate it.
+func (s *Seeder) Seed(ctx context.Context, f Fixtures) (Result, error) {
+	var rs Result
+	err := db.Transaction(ctx, func(dbCtx db.Context) error {
+		rs = Result{}
+		for i, u := range f.Users {
+			created, err := s.seedUser(dbCtx, u)
+			if err != nil {
+				return fmt.Errorf("users[%d]: %w", i, err)
+			}
+			if created {
+				rs.Users++
+			}
+		}
+
+		campaigns, err := s.repo.Campaign.GetList(dbCtx)
+		if err != nil {
+			return err
+		}
+		existing := make(map[string]bool, len(campaigns))
+		for _, c := range campaigns {
+			existing[c.Name] = true
+		}
+		for i, c := range f.Campaigns {
+			if existing[c.Name] {
+				continue
+			}
+			if _, err := s.repo.Campaign.Create(dbCtx, c.toRequest()); err != nil {
+				return fmt.Errorf("campaigns[%d]: %w", i, err)
+			}
+			existing[c.Name] = true
+			rs.Campaigns++
+		}
+
+		return nil
+	})
+
+	return rs, err
+}
+
+func (s *Seeder) seedUser(ctx db.Context, u UserFixture) (bool, error) {
+	_, err := s.repo.User.GetByEmail(ctx, u.Email)
+	if err == nil {
+		return false, nil
+	}
+	if !errors.Is(err, model.ErrNotFound) {
+		return false, err
+	}
+
+	req := u.toRequest()
+	req.Salt = s.passwordHelper.GenerateSalt()
+	req.HashedPassword, err = s.passwordHelper.Hash(u.Password, req.Salt)
+	if err != nil {
+		return false, err
+	}
+
+	created, err := s.repo.User.Create(ctx, req)
+	if err != nil {
+		return false, err
+	}
+
+	if u.MailPreference != nil {
+		err := s.repo.MailPreference.Save(ctx, model.MailPreference{
+			UserID:    created.ID,
+			Campaigns: u.MailPreference.Campaigns,
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
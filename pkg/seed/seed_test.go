@@ -0,0 +1,32 @@
+package seed
+
+import (
+	"testing"
+
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/repository"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeeder_Seed(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		f, err := Load("dev")
+		require.NoError(t, err)
+
+		repo := repository.NewRepo(monitor.TestMonitor())
+		s := New(repo)
+		rs, err := s.Seed(ctx, *f)
+		require.NoError(t, err)
+		require.Equal(t, Result{Users: len(f.Users), Campaigns: len(f.Campaigns)}, rs)
+
+		u, err := repo.User.GetByEmail(ctx, f.Users[0].Email)
+		require.NoError(t, err)
+		require.True(t, s.passwordHelper.Compare(f.Users[0].Password, u.HashedPassword, u.Salt))
+
+		// seeding the profile again skips the existing records
+		rs, err = s.Seed(ctx, *f)
+		require.NoError(t, err)
+		require.Equal(t, Result{}, rs)
+	})
+}
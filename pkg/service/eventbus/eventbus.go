@@ -0,0 +1,112 @@
+package eventbus
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/model"
+)
+
+// DefaultBufferSize is the default number of events waiting to be handled by a subscriber
+const DefaultBufferSize = 256
+
+// Handler handles a published event
+type Handler func(e model.Event) error
+
+// Bus dispatches the domain events to the subscribers in process
+type Bus interface {
+	Publish(e model.Event)
+	Subscribe(h Handler)
+	Close()
+}
+
+// subscriber handles its events in order in its own goroutine
+type subscriber struct {
+	handler Handler
+	events  chan model.Event
+}
+
+type impl struct {
+	subscribers []*subscriber
+	size        int
+	closed      bool
+	mutex       sync.RWMutex
+	wg          sync.WaitGroup
+	log         logger.Log
+}
+
+// New creates a bus which handles the events in the background, every subscriber
+// has its own buffer so a slow subscriber never holds the others back.
+// Publishing never blocks, the events are dropped when the buffer of a subscriber is full.
+func New(l logger.Log, size int) Bus {
+	return &impl{
+		size: size,
+		log:  l,
+	}
+}
+
+// Publish queues the event for the subscribers.
+func (b *impl) Publish(e model.Event) {
+	if e.OccurredAt.IsZero() {
+		e.OccurredAt = time.Now()
+	}
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	if b.closed {
+		return
+	}
+
+	for _, s := range b.subscribers {
+		select {
+		case s.events <- e:
+		default:
+			b.log.Warnf("event bus subscriber is full, dropped %s event of user %d", e.Type, e.UserID)
+		}
+	}
+}
+
+// Subscribe registers the handler for every event published afterwards.
+func (b *impl) Subscribe(h Handler) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	s := &subscriber{
+		handler: h,
+		events:  make(chan model.Event, b.size),
+	}
+	b.subscribers = append(b.subscribers, s)
+
+	b.wg.Add(1)
+	go b.run(s)
+}
+
+// Close stops accepting events and waits for the queued ones to be handled.
+func (b *impl) Close() {
+	b.mutex.Lock()
+	if !b.closed {
+		b.closed = true
+		for _, s := range b.subscribers {
+			close(s.events)
+		}
+	}
+	b.mutex.Unlock()
+
+	b.wg.Wait()
+}
+
+func (b *impl) run(s *subscriber) {
+	defer b.wg.Done()
+
+	for e := range s.events {
+		if err := s.handler(e); err != nil {
+			b.log.Errorf(err, "failed to handle %s event", e.Type)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package eventbus
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dwarvesf/go-api/pkg/logger"
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_Publish(t *testing.T) {
+	tests := map[string]struct {
+		events []model.Event
+		errs   bool
+		want   []model.EventType
+	}{
+		"handled in order": {
+			events: []model.Event{
+				{Type: model.EventNewLogin, UserID: 1},
+				{Type: model.EventProfileUpdated, UserID: 1},
+				{Type: model.EventPasswordChanged, UserID: 2},
+			},
+			want: []model.EventType{model.EventNewLogin, model.EventProfileUpdated, model.EventPasswordChanged},
+		},
+		"handler failed": {
+			events: []model.Event{
+				{Type: model.EventNewLogin, UserID: 1},
+				{Type: model.EventPasswordChanged, UserID: 1},
+			},
+			errs: true,
+			want: []model.EventType{model.EventNewLogin, model.EventPasswordChanged},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			b := New(logger.NewLogger(), DefaultBufferSize)
+
+			// both subscribers receive every event even when one of them fails
+			var got, other []model.Event
+			b.Subscribe(func(e model.Event) error {
+				got = append(got, e)
+				if tc.errs {
+					return errors.New("failed")
+				}
+				return nil
+			})
+			b.Subscribe(func(e model.Event) error {
+				other = append(other, e)
+				return nil
+			})
+
+			for _, e := range tc.events {
+				b.Publish(e)
+			}
+			b.Close()
+
+			require.Len(t, got, len(tc.want))
+			require.Len(t, other, len(tc.want))
+			for i, e := range got {
+				assert.Equal(t, tc.want[i], e.Type)
+				assert.False(t, e.OccurredAt.IsZero())
+			}
+		})
+	}
+}
+
+func Test_impl_PublishAfterClose(t *testing.T) {
+	b := New(logger.NewLogger(), DefaultBufferSize)
+
+	var got []model.Event
+	b.Subscribe(func(e model.Event) error {
+		got = append(got, e)
+		return nil
+	})
+	b.Close()
+
+	b.Publish(model.Event{Type: model.EventNewLogin, UserID: 1})
+	b.Close()
+
+	assert.Empty(t, got)
+}
+
+func Test_impl_PublishFull(t *testing.T) {
+	b := New(logger.NewLogger(), 1)
+
+	// block the subscriber on the first event to fill its buffer
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var got []model.Event
+	b.Subscribe(func(e model.Event) error {
+		if len(got) == 0 {
+			close(started)
+			<-release
+		}
+		got = append(got, e)
+		return nil
+	})
+
+	b.Publish(model.Event{Type: model.EventNewLogin, UserID: 1})
+	<-started
+	b.Publish(model.Event{Type: model.EventProfileUpdated, UserID: 1})
+	b.Publish(model.Event{Type: model.EventPasswordChanged, UserID: 1})
+	close(release)
+	b.Close()
+
+	require.Len(t, got, 2)
+	assert.Equal(t, model.EventNewLogin, got[0].Type)
+	assert.Equal(t, model.EventProfileUpdated, got[1].Type)
+}
+
+func Test_impl_SlowSubscriber(t *testing.T) {
+	b := New(logger.NewLogger(), DefaultBufferSize)
+
+	// the first subscriber is stuck until every event reached the second one
+	release := make(chan struct{})
+	b.Subscribe(func(e model.Event) error {
+		<-release
+		return nil
+	})
+	handled := make(chan model.Event, 2)
+	b.Subscribe(func(e model.Event) error {
+		handled <- e
+		return nil
+	})
+
+	b.Publish(model.Event{Type: model.EventNewLogin, UserID: 1})
+	b.Publish(model.Event{Type: model.EventProfileUpdated, UserID: 1})
+	assert.Equal(t, model.EventNewLogin, (<-handled).Type)
+	assert.Equal(t, model.EventProfileUpdated, (<-handled).Type)
+
+	close(release)
+	b.Close()
+}
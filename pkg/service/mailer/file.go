@@ -0,0 +1,55 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/util"
+)
+
+type fileMailer struct {
+	dir   string
+	from  string
+	out   io.Writer
+	mutex sync.Mutex
+}
+
+// NewFile creates a mailer for local use which writes every email as an
+// .eml file into the directory, or to stdout when the directory is empty.
+func NewFile(dir string, from string) Mailer {
+	return &fileMailer{
+		dir:  dir,
+		from: from,
+		out:  os.Stdout,
+	}
+}
+
+func (m *fileMailer) Send(ctx context.Context, msg Message) error {
+	if msg.From == "" {
+		msg.From = m.from
+	}
+	body, err := Build(msg)
+	if err != nil {
+		return err
+	}
+
+	if m.dir == "" {
+		m.mutex.Lock()
+		defer m.mutex.Unlock()
+
+		_, err := fmt.Fprintf(m.out, "%s\r\n\r\n", body)
+		return err
+	}
+
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102T150405.000000000"), util.RandomString(6))
+
+	return os.WriteFile(filepath.Join(m.dir, name), body, 0o644)
+}
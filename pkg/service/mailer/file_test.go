@@ -0,0 +1,41 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_fileMailer_Send(t *testing.T) {
+	msg := Message{To: []string{"admin@d.foundation"}, Subject: "Hello", Text: "Hello admin"}
+
+	t.Run("directory", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "mails")
+		m := NewFile(dir, "no-reply@d.foundation")
+
+		require.NoError(t, m.Send(context.Background(), msg))
+		require.NoError(t, m.Send(context.Background(), msg))
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+		require.NoError(t, err)
+		require.Len(t, files, 2)
+
+		body, err := os.ReadFile(files[0])
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "From: <no-reply@d.foundation>")
+		assert.Contains(t, string(body), "Hello admin")
+	})
+
+	t.Run("stdout", func(t *testing.T) {
+		var out bytes.Buffer
+		m := &fileMailer{from: "no-reply@d.foundation", out: &out}
+
+		require.NoError(t, m.Send(context.Background(), msg))
+		assert.Contains(t, out.String(), "Hello admin")
+	})
+}
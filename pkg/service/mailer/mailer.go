@@ -0,0 +1,72 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/config"
+)
+
+const (
+	// TransportSMTP sends the emails through the SMTP server
+	TransportSMTP = "smtp"
+	// TransportFile writes the emails to files, or to stdout when no directory is set
+	TransportFile = "file"
+)
+
+var (
+	// ErrNoRecipient is returned when the message has no recipient
+	ErrNoRecipient = errors.New("mailer: no recipient")
+	// ErrNoSender is returned when neither the message nor the config sets the sender
+	ErrNoSender = errors.New("mailer: no sender")
+)
+
+// Message represent an email
+type Message struct {
+	From        string
+	To          []string
+	Cc          []string
+	Bcc         []string
+	ReplyTo     string
+	Subject     string
+	Text        string
+	HTML        string
+	Attachments []Attachment
+	Date        time.Time
+
+	// Headers are the extra headers of the message such as List-Unsubscribe
+	Headers map[string]string
+}
+
+// Attachment represent a file attached to an email
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// Mailer sends emails
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// New creates the mailer of the transport set by MAILER_TRANSPORT.
+func New(cfg config.Config) Mailer {
+	switch cfg.MailerTransport {
+	case TransportSMTP:
+		return NewSMTP(cfg)
+	default:
+		return NewFile(cfg.MailDir, cfg.MailFrom)
+	}
+}
+
+// recipients returns every address the message is delivered to.
+func (m Message) recipients() []string {
+	rs := make([]string, 0, len(m.To)+len(m.Cc)+len(m.Bcc))
+	rs = append(rs, m.To...)
+	rs = append(rs, m.Cc...)
+	rs = append(rs, m.Bcc...)
+
+	return rs
+}
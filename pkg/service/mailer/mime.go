@@ -0,0 +1,242 @@
+package mailer
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/util"
+)
+
+// base64LineLength is the maximum length of a base64 line allowed by RFC 2045
+const base64LineLength = 76
+
+// part represent a MIME entity, its headers and its encoded body
+type part struct {
+	header textproto.MIMEHeader
+	body   []byte
+}
+
+// Build encodes the message as a multipart MIME message: the plain-text and
+// HTML bodies are alternatives, the attachments are added next to them.
+func Build(msg Message) ([]byte, error) {
+	if len(msg.recipients()) == 0 {
+		return nil, ErrNoRecipient
+	}
+	if msg.From == "" {
+		return nil, ErrNoSender
+	}
+
+	header := textproto.MIMEHeader{}
+	for k, v := range msg.Headers {
+		header.Set(k, v)
+	}
+	from, err := formatAddresses(msg.From)
+	if err != nil {
+		return nil, err
+	}
+	header.Set("From", from)
+	if len(msg.To) > 0 {
+		to, err := formatAddresses(msg.To...)
+		if err != nil {
+			return nil, err
+		}
+		header.Set("To", to)
+	}
+	if len(msg.Cc) > 0 {
+		cc, err := formatAddresses(msg.Cc...)
+		if err != nil {
+			return nil, err
+		}
+		header.Set("Cc", cc)
+	}
+	if msg.ReplyTo != "" {
+		replyTo, err := formatAddresses(msg.ReplyTo)
+		if err != nil {
+			return nil, err
+		}
+		header.Set("Reply-To", replyTo)
+	}
+
+	date := msg.Date
+	if date.IsZero() {
+		date = time.Now()
+	}
+	header.Set("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header.Set("Date", date.Format(time.RFC1123Z))
+	// a Message-ID set by the caller is kept so the bounces can be matched to the mail
+	if header.Get("Message-ID") == "" {
+		header.Set("Message-ID", MessageID(msg.From))
+	}
+	header.Set("MIME-Version", "1.0")
+
+	body, err := buildBody(msg)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range body.header {
+		header[k] = v
+	}
+
+	var buf bytes.Buffer
+	writeHeader(&buf, header)
+	buf.Write(body.body)
+
+	return buf.Bytes(), nil
+}
+
+// buildBody builds the content of the message with its attachments.
+func buildBody(msg Message) (part, error) {
+	content, err := buildContent(msg)
+	if err != nil {
+		return part{}, err
+	}
+	if len(msg.Attachments) == 0 {
+		return content, nil
+	}
+
+	parts := []part{content}
+	for _, a := range msg.Attachments {
+		parts = append(parts, attachmentPart(a))
+	}
+
+	return multipartOf("mixed", parts)
+}
+
+// buildContent builds the plain-text and HTML alternatives of the message.
+func buildContent(msg Message) (part, error) {
+	var parts []part
+	if msg.Text != "" || msg.HTML == "" {
+		parts = append(parts, textPart("text/plain", msg.Text))
+	}
+	if msg.HTML != "" {
+		parts = append(parts, textPart("text/html", msg.HTML))
+	}
+	if len(parts) == 1 {
+		return parts[0], nil
+	}
+
+	return multipartOf("alternative", parts)
+}
+
+func textPart(contentType string, content string) part {
+	var buf bytes.Buffer
+	w := quotedprintable.NewWriter(&buf)
+	w.Write([]byte(content))
+	w.Close()
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", contentType+"; charset=utf-8")
+	header.Set("Content-Transfer-Encoding", "quoted-printable")
+
+	return part{header: header, body: buf.Bytes()}
+}
+
+func attachmentPart(a Attachment) part {
+	contentType := a.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(filepath.Ext(a.Filename))
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Transfer-Encoding", "base64")
+	header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename}))
+
+	encoded := base64.StdEncoding.EncodeToString(a.Data)
+	var buf bytes.Buffer
+	for len(encoded) > base64LineLength {
+		buf.WriteString(encoded[:base64LineLength] + "\r\n")
+		encoded = encoded[base64LineLength:]
+	}
+	buf.WriteString(encoded)
+
+	return part{header: header, body: buf.Bytes()}
+}
+
+// multipartOf nests the parts into a multipart entity of the subtype.
+func multipartOf(subtype string, parts []part) (part, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for _, p := range parts {
+		pw, err := w.CreatePart(p.header)
+		if err != nil {
+			return part{}, err
+		}
+		if _, err := pw.Write(p.body); err != nil {
+			return part{}, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return part{}, err
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", fmt.Sprintf("multipart/%s; boundary=%q", subtype, w.Boundary()))
+
+	return part{header: header, body: buf.Bytes()}, nil
+}
+
+// writeHeader writes the header in a stable order followed by the blank line.
+func writeHeader(buf *bytes.Buffer, header textproto.MIMEHeader) {
+	keys := make([]string, 0, len(header))
+	for k := range header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		for _, v := range header[k] {
+			fmt.Fprintf(buf, "%s: %s\r\n", k, v)
+		}
+	}
+	buf.WriteString("\r\n")
+}
+
+// formatAddresses validates the addresses and encodes their display names.
+func formatAddresses(addrs ...string) (string, error) {
+	rs := make([]string, 0, len(addrs))
+	for _, a := range addrs {
+		addr, err := mail.ParseAddress(a)
+		if err != nil {
+			return "", fmt.Errorf("mailer: invalid address %q: %w", a, err)
+		}
+		rs = append(rs, addr.String())
+	}
+
+	return strings.Join(rs, ", "), nil
+}
+
+// envelopeAddress returns the bare address used in the SMTP envelope.
+func envelopeAddress(a string) (string, error) {
+	addr, err := mail.ParseAddress(a)
+	if err != nil {
+		return "", fmt.Errorf("mailer: invalid address %q: %w", a, err)
+	}
+
+	return addr.Address, nil
+}
+
+// MessageID creates a unique Message-ID on the domain of the sender.
+func MessageID(from string) string {
+	domain := "localhost"
+	if addr, err := envelopeAddress(from); err == nil {
+		if i := strings.LastIndex(addr, "@"); i >= 0 {
+			domain = addr[i+1:]
+		}
+	}
+
+	return fmt.Sprintf("<%d.%s@%s>", time.Now().UnixNano(), util.RandomString(12), domain)
+}
@@ -0,0 +1,143 @@
+package mailer
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuild(t *testing.T) {
+	date := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	attachment := bytes.Repeat([]byte("report,"), 30)
+
+	tests := map[string]struct {
+		msg         Message
+		wantType    string
+		wantParts   []string
+		wantErr     error
+		wantSubject string
+		wantHeaders map[string]string
+	}{
+		"plain text only": {
+			msg:         Message{From: "no-reply@d.foundation", To: []string{"admin@d.foundation"}, Subject: "Hello", Text: "Hello", Date: date},
+			wantType:    "text/plain",
+			wantSubject: "Hello",
+		},
+		"extra headers": {
+			msg: Message{
+				From:    "no-reply@d.foundation",
+				To:      []string{"admin@d.foundation"},
+				Subject: "Hello",
+				Text:    "Hello",
+				Date:    date,
+				Headers: map[string]string{"List-Unsubscribe": "<https://d.foundation/unsubscribe>", "Subject": "Overridden"},
+			},
+			wantType:    "text/plain",
+			wantSubject: "Hello",
+			wantHeaders: map[string]string{"List-Unsubscribe": "<https://d.foundation/unsubscribe>"},
+		},
+		"message id": {
+			msg: Message{
+				From:    "no-reply@d.foundation",
+				To:      []string{"admin@d.foundation"},
+				Subject: "Hello",
+				Text:    "Hello",
+				Date:    date,
+				Headers: map[string]string{"Message-ID": "<42.abc@d.foundation>"},
+			},
+			wantType:    "text/plain",
+			wantSubject: "Hello",
+			wantHeaders: map[string]string{"Message-ID": "<42.abc@d.foundation>"},
+		},
+		"text and html": {
+			msg:         Message{From: "no-reply@d.foundation", To: []string{"admin@d.foundation"}, Subject: "Xin chào", Text: "Hello", HTML: "<p>Hello</p>", Date: date},
+			wantType:    "multipart/alternative",
+			wantParts:   []string{"text/plain", "text/html"},
+			wantSubject: "Xin chào",
+		},
+		"with attachment": {
+			msg: Message{
+				From:        "no-reply@d.foundation",
+				To:          []string{"admin@d.foundation"},
+				Subject:     "Report",
+				Text:        "See attached",
+				HTML:        "<p>See attached</p>",
+				Attachments: []Attachment{{Filename: "report.csv", Data: attachment}},
+				Date:        date,
+			},
+			wantType:    "multipart/mixed",
+			wantParts:   []string{"multipart/alternative", "text/csv"},
+			wantSubject: "Report",
+		},
+		"no recipient": {
+			msg:     Message{From: "no-reply@d.foundation", Subject: "Hello"},
+			wantErr: ErrNoRecipient,
+		},
+		"no sender": {
+			msg:     Message{To: []string{"admin@d.foundation"}, Subject: "Hello"},
+			wantErr: ErrNoSender,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			raw, err := Build(tt.msg)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			m, err := mail.ReadMessage(bytes.NewReader(raw))
+			require.NoError(t, err)
+
+			subject, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSubject, subject)
+			assert.Equal(t, "Mon, 19 Oct 2026 00:00:00 +0000", m.Header.Get("Date"))
+			assert.True(t, strings.HasSuffix(m.Header.Get("Message-ID"), "@d.foundation>"))
+			for k, v := range tt.wantHeaders {
+				assert.Equal(t, v, m.Header.Get(k))
+			}
+
+			mediaType, params, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantType, mediaType)
+			if len(tt.wantParts) == 0 {
+				return
+			}
+
+			var got []string
+			r := multipart.NewReader(m.Body, params["boundary"])
+			for {
+				p, err := r.NextPart()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+
+				partType, _, err := mime.ParseMediaType(p.Header.Get("Content-Type"))
+				require.NoError(t, err)
+				got = append(got, partType)
+
+				if p.FileName() != "" {
+					assert.Equal(t, "report.csv", p.FileName())
+					body, err := io.ReadAll(p)
+					require.NoError(t, err)
+					for _, line := range strings.Split(string(body), "\r\n") {
+						assert.LessOrEqual(t, len(line), base64LineLength)
+					}
+				}
+			}
+			assert.Equal(t, tt.wantParts, got)
+		})
+	}
+}
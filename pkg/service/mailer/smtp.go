@@ -0,0 +1,133 @@
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/config"
+)
+
+// defaultSMTPTimeout bounds a delivery when the context has no deadline
+const defaultSMTPTimeout = 30 * time.Second
+
+// ErrStartTLSRequired is returned when TLS is required and the server doesn't support STARTTLS
+var ErrStartTLSRequired = errors.New("mailer: server doesn't support STARTTLS")
+
+type smtpMailer struct {
+	host       string
+	port       int
+	username   string
+	password   string
+	from       string
+	requireTLS bool
+	tlsConfig  *tls.Config
+	timeout    time.Duration
+}
+
+// NewSMTP creates a mailer which delivers through the SMTP server. The
+// connection is upgraded with STARTTLS whenever the server supports it.
+func NewSMTP(cfg config.Config) Mailer {
+	return &smtpMailer{
+		host:       cfg.SMTPHost,
+		port:       cfg.SMTPPort,
+		username:   cfg.SMTPUsername,
+		password:   cfg.SMTPPassword,
+		from:       cfg.MailFrom,
+		requireTLS: cfg.SMTPRequireTLS,
+		tlsConfig:  &tls.Config{ServerName: cfg.SMTPHost, MinVersion: tls.VersionTLS12},
+		timeout:    defaultSMTPTimeout,
+	}
+}
+
+func (m *smtpMailer) Send(ctx context.Context, msg Message) error {
+	if msg.From == "" {
+		msg.From = m.from
+	}
+	body, err := Build(msg)
+	if err != nil {
+		return err
+	}
+
+	from, err := envelopeAddress(msg.From)
+	if err != nil {
+		return err
+	}
+	rcpts := make([]string, 0, len(msg.recipients()))
+	for _, r := range msg.recipients() {
+		addr, err := envelopeAddress(r)
+		if err != nil {
+			return err
+		}
+		rcpts = append(rcpts, addr)
+	}
+
+	d := net.Dialer{Timeout: m.timeout}
+	conn, err := d.DialContext(ctx, "tcp", net.JoinHostPort(m.host, strconv.Itoa(m.port)))
+	if err != nil {
+		return fmt.Errorf("mailer: dial: %w", err)
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(m.timeout)
+	}
+	conn.SetDeadline(deadline)
+
+	c, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("mailer: greeting: %w", err)
+	}
+	defer c.Close()
+
+	if err := m.handshake(c); err != nil {
+		return err
+	}
+
+	if err := c.Mail(from); err != nil {
+		return fmt.Errorf("mailer: mail from: %w", err)
+	}
+	for _, r := range rcpts {
+		if err := c.Rcpt(r); err != nil {
+			return fmt.Errorf("mailer: rcpt to %s: %w", r, err)
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("mailer: data: %w", err)
+	}
+	if _, err := w.Write(body); err != nil {
+		return fmt.Errorf("mailer: data: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("mailer: data: %w", err)
+	}
+
+	return c.Quit()
+}
+
+// handshake upgrades the connection to TLS and authenticates.
+func (m *smtpMailer) handshake(c *smtp.Client) error {
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(m.tlsConfig); err != nil {
+			return fmt.Errorf("mailer: starttls: %w", err)
+		}
+	} else if m.requireTLS {
+		return ErrStartTLSRequired
+	}
+
+	if m.username == "" {
+		return nil
+	}
+	if err := c.Auth(smtp.PlainAuth("", m.username, m.password, m.host)); err != nil {
+		return fmt.Errorf("mailer: auth: %w", err)
+	}
+
+	return nil
+}
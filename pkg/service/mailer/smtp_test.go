@@ -0,0 +1,264 @@
+package mailer
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer is an in-process SMTP server supporting STARTTLS and AUTH PLAIN
+type fakeSMTPServer struct {
+	listener net.Listener
+	tls      *tls.Config
+	startTLS bool
+	username string
+	password string
+
+	mutex    sync.Mutex
+	from     string
+	rcpts    []string
+	data     string
+	usedTLS  bool
+	authUser string
+}
+
+func newFakeSMTPServer(t *testing.T, startTLS bool, username string, password string) (*fakeSMTPServer, *x509.CertPool) {
+	cert, pool := selfSignedCert(t)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &fakeSMTPServer{
+		listener: l,
+		tls:      &tls.Config{Certificates: []tls.Certificate{cert}},
+		startTLS: startTLS,
+		username: username,
+		password: password,
+	}
+	go s.serve()
+	t.Cleanup(func() { l.Close() })
+
+	return s, pool
+}
+
+func (s *fakeSMTPServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeSMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+
+	isTLS := false
+	r := bufio.NewReader(conn)
+	reply := func(line string) {
+		conn.Write([]byte(line + "\r\n"))
+	}
+
+	reply("220 fake ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+		switch cmd {
+		case "EHLO":
+			if s.startTLS && !isTLS {
+				reply("250-fake")
+				reply("250 STARTTLS")
+				continue
+			}
+			reply("250-fake")
+			reply("250 AUTH PLAIN")
+		case "STARTTLS":
+			reply("220 ready")
+			tlsConn := tls.Server(conn, s.tls)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			r = bufio.NewReader(conn)
+			isTLS = true
+		case "AUTH":
+			fields := strings.Fields(line)
+			creds, _ := base64.StdEncoding.DecodeString(fields[len(fields)-1])
+			parts := strings.Split(string(creds), "\x00")
+			if len(parts) != 3 || parts[1] != s.username || parts[2] != s.password {
+				reply("535 authentication failed")
+				continue
+			}
+			s.mutex.Lock()
+			s.authUser = parts[1]
+			s.mutex.Unlock()
+			reply("235 authenticated")
+		case "MAIL":
+			s.mutex.Lock()
+			s.from = strings.Trim(strings.TrimPrefix(line, "MAIL FROM:"), "<>")
+			s.usedTLS = isTLS
+			s.mutex.Unlock()
+			reply("250 ok")
+		case "RCPT":
+			s.mutex.Lock()
+			s.rcpts = append(s.rcpts, strings.Trim(strings.TrimPrefix(line, "RCPT TO:"), "<>"))
+			s.mutex.Unlock()
+			reply("250 ok")
+		case "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(l)
+			}
+			s.mutex.Lock()
+			s.data = data.String()
+			s.mutex.Unlock()
+			reply("250 queued")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+func selfSignedCert(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(leaf)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}, pool
+}
+
+func Test_smtpMailer_Send(t *testing.T) {
+	msg := Message{
+		To:      []string{"Admin <admin@d.foundation>"},
+		Bcc:     []string{"audit@d.foundation"},
+		Subject: "Hello",
+		Text:    "Hello admin",
+		HTML:    "<p>Hello admin</p>",
+	}
+
+	tests := map[string]struct {
+		startTLS   bool
+		requireTLS bool
+		username   string
+		password   string
+		wantErr    error
+		wantAnyErr bool
+		wantTLS    bool
+	}{
+		"starttls and auth": {
+			startTLS:   true,
+			requireTLS: true,
+			username:   "user",
+			password:   "secret",
+			wantTLS:    true,
+		},
+		"starttls without auth": {
+			startTLS: true,
+			wantTLS:  true,
+		},
+		"plain connection allowed": {
+			startTLS: false,
+		},
+		"plain connection refused": {
+			startTLS:   false,
+			requireTLS: true,
+			wantErr:    ErrStartTLSRequired,
+		},
+		"wrong password": {
+			startTLS:   true,
+			username:   "user",
+			password:   "wrong",
+			wantAnyErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv, pool := newFakeSMTPServer(t, tt.startTLS, "user", "secret")
+			m := &smtpMailer{
+				host:       "127.0.0.1",
+				port:       srv.port(),
+				username:   tt.username,
+				password:   tt.password,
+				from:       "App <no-reply@d.foundation>",
+				requireTLS: tt.requireTLS,
+				tlsConfig:  &tls.Config{ServerName: "127.0.0.1", RootCAs: pool},
+				timeout:    5 * time.Second,
+			}
+
+			err := m.Send(context.Background(), msg)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			if tt.wantAnyErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			srv.mutex.Lock()
+			defer srv.mutex.Unlock()
+			assert.Equal(t, tt.wantTLS, srv.usedTLS)
+			assert.Equal(t, tt.username, srv.authUser)
+			assert.Equal(t, "no-reply@d.foundation", srv.from)
+			assert.Equal(t, []string{"admin@d.foundation", "audit@d.foundation"}, srv.rcpts)
+			assert.Contains(t, srv.data, "Subject: Hello\r\n")
+			assert.Contains(t, srv.data, `To: "Admin" <admin@d.foundation>`)
+			// the blind copy recipients are only part of the envelope
+			assert.NotContains(t, srv.data, "audit@d.foundation")
+			assert.Contains(t, srv.data, "Hello admin")
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+)
+
+const (
+	// layoutName is the base name of the layout files wrapping every template
+	layoutName = "layout"
+
+	// templateDir is the directory of the templates in the file system
+	templateDir = "templates"
+)
+
+// ErrTemplateNotFound is returned when rendering an unknown template
+var ErrTemplateNotFound = errors.New("mailer: template not found")
+
+//go:embed templates
+var defaultTemplates embed.FS
+
+// Renderer renders the emails from the templates
+type Renderer interface {
+	Render(name string, data any) (*Message, error)
+}
+
+type renderer struct {
+	html map[string]*htmltemplate.Template
+	text map[string]*texttemplate.Template
+}
+
+// NewRenderer parses the templates of the file system. Every email is a pair of
+// <name>.html and <name>.txt files, either one is optional, defining the
+// "subject" and "content" blocks which are wrapped by layout.html and layout.txt.
+func NewRenderer(fsys fs.FS) (Renderer, error) {
+	r := &renderer{
+		html: make(map[string]*htmltemplate.Template),
+		text: make(map[string]*texttemplate.Template),
+	}
+
+	files, err := fs.Glob(fsys, path.Join(templateDir, "*"))
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		ext := path.Ext(f)
+		name := strings.TrimSuffix(path.Base(f), ext)
+		if name == layoutName {
+			continue
+		}
+
+		layout := path.Join(templateDir, layoutName+ext)
+		switch ext {
+		case ".html":
+			t, err := htmltemplate.New(name).ParseFS(fsys, layout, f)
+			if err != nil {
+				return nil, err
+			}
+			r.html[name] = t
+		case ".txt":
+			t, err := texttemplate.New(name).ParseFS(fsys, layout, f)
+			if err != nil {
+				return nil, err
+			}
+			r.text[name] = t
+		}
+	}
+
+	return r, nil
+}
+
+// DefaultRenderer returns the renderer of the templates embedded in the binary.
+func DefaultRenderer() Renderer {
+	r, err := NewRenderer(defaultTemplates)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// Render renders the subject and the bodies of the email, the subject is
+// taken from the plain-text template when both exist.
+func (r *renderer) Render(name string, data any) (*Message, error) {
+	html, hasHTML := r.html[name]
+	text, hasText := r.text[name]
+	if !hasHTML && !hasText {
+		return nil, fmt.Errorf("%w: %s", ErrTemplateNotFound, name)
+	}
+
+	msg := &Message{}
+	if hasHTML {
+		var buf bytes.Buffer
+		if err := html.ExecuteTemplate(&buf, layoutName, data); err != nil {
+			return nil, err
+		}
+		msg.HTML = buf.String()
+
+		buf.Reset()
+		if err := html.ExecuteTemplate(&buf, "subject", data); err != nil {
+			return nil, err
+		}
+		msg.Subject = buf.String()
+	}
+	if hasText {
+		var buf bytes.Buffer
+		if err := text.ExecuteTemplate(&buf, layoutName, data); err != nil {
+			return nil, err
+		}
+		msg.Text = buf.String()
+
+		buf.Reset()
+		if err := text.ExecuteTemplate(&buf, "subject", data); err != nil {
+			return nil, err
+		}
+		msg.Subject = buf.String()
+	}
+	msg.Subject = strings.TrimSpace(msg.Subject)
+
+	return msg, nil
+}
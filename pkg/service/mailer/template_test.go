@@ -0,0 +1,69 @@
+package mailer
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_renderer_Render(t *testing.T) {
+	fsys := fstest.MapFS{
+		"templates/layout.html":  {Data: []byte(`{{define "layout"}}<main>{{template "content" .}}</main>{{end}}`)},
+		"templates/layout.txt":   {Data: []byte(`{{define "layout"}}{{template "content" .}} -- footer{{end}}`)},
+		"templates/welcome.html": {Data: []byte(`{{define "subject"}}Welcome {{.Name}}{{end}}{{define "content"}}<p>Hi {{.Name}}</p>{{end}}`)},
+		"templates/welcome.txt":  {Data: []byte(`{{define "subject"}} Welcome {{.Name}} {{end}}{{define "content"}}Hi {{.Name}}{{end}}`)},
+		"templates/html.html":    {Data: []byte(`{{define "subject"}}Only html{{end}}{{define "content"}}<b>html</b>{{end}}`)},
+	}
+	r, err := NewRenderer(fsys)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		name    string
+		data    any
+		want    *Message
+		wantErr error
+	}{
+		"html and text": {
+			name: "welcome",
+			data: map[string]string{"Name": "<Tom & Jerry>"},
+			want: &Message{
+				Subject: "Welcome <Tom & Jerry>",
+				HTML:    "<main><p>Hi &lt;Tom &amp; Jerry&gt;</p></main>",
+				Text:    "Hi <Tom & Jerry> -- footer",
+			},
+		},
+		"html only": {
+			name: "html",
+			want: &Message{
+				Subject: "Only html",
+				HTML:    "<main><b>html</b></main>",
+			},
+		},
+		"unknown template": {
+			name:    "unknown",
+			wantErr: ErrTemplateNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := r.Render(tt.name, tt.data)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDefaultRenderer(t *testing.T) {
+	got, err := DefaultRenderer().Render("sent_mail", map[string]string{"Name": "admin"})
+	require.NoError(t, err)
+	assert.Equal(t, "Hello admin", got.Subject)
+	assert.Contains(t, got.HTML, "Hi admin,")
+	assert.Contains(t, got.Text, "Hi admin,")
+}
@@ -2,14 +2,20 @@ package service
 
 import (
 	"github.com/dwarvesf/go-api/pkg/config"
+	"github.com/dwarvesf/go-api/pkg/service/mailer"
 )
 
 // Service for app
 type Service struct {
+	Mailer        mailer.Mailer
+	MailTemplates mailer.Renderer
 }
 
 // New will return the services in app
 func New(cfg *config.Config) Service {
 
-	return Service{}
+	return Service{
+		Mailer:        mailer.New(*cfg),
+		MailTemplates: mailer.DefaultRenderer(),
+	}
 }
@@ -0,0 +1,66 @@
+package testfixtures
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/stretchr/testify/require"
+)
+
+// CampaignOption overrides a field of the campaign
+type CampaignOption func(*campaignFixture)
+
+type campaignFixture struct {
+	req         model.CampaignRequest
+	scheduledAt *time.Time
+}
+
+// WithCampaignName overrides the name
+func WithCampaignName(name string) CampaignOption {
+	return func(f *campaignFixture) { f.req.Name = name }
+}
+
+// WithTemplate overrides the mail template
+func WithTemplate(template string) CampaignOption {
+	return func(f *campaignFixture) { f.req.Template = template }
+}
+
+// WithAudience overrides the audience
+func WithAudience(a model.AudienceFilter) CampaignOption {
+	return func(f *campaignFixture) { f.req.Audience = a }
+}
+
+// WithSchedule schedules the campaign at the time
+func WithSchedule(at time.Time) CampaignOption {
+	return func(f *campaignFixture) { f.scheduledAt = &at }
+}
+
+// Campaign inserts a draft campaign sent to the active users through the campaign repo.
+func Campaign(t *testing.T, ctx db.Context, opts ...CampaignOption) *model.Campaign {
+	t.Helper()
+
+	f := campaignFixture{
+		req: model.CampaignRequest{
+			Name:     fmt.Sprintf("campaign %d", seq.Add(1)),
+			Template: "welcome",
+			Audience: model.AudienceFilter{Status: model.StatusActive},
+		},
+	}
+	for _, opt := range opts {
+		opt(&f)
+	}
+
+	c, err := repo.Campaign.Create(ctx, f.req)
+	require.NoError(t, err)
+
+	if f.scheduledAt != nil {
+		require.NoError(t, repo.Campaign.Schedule(ctx, c.ID, *f.scheduledAt))
+		c, err = repo.Campaign.GetByID(ctx, c.ID)
+		require.NoError(t, err)
+	}
+
+	return c
+}
@@ -0,0 +1,11 @@
+// Package testfixtures builds valid records for the tests which need the database,
+// they're inserted through the repos inside the transaction of db.WithTestingDB.
+package testfixtures
+
+import (
+	"github.com/dwarvesf/go-api/pkg/logger/monitor"
+	"github.com/dwarvesf/go-api/pkg/repository"
+)
+
+// repo inserts the fixtures
+var repo = repository.NewRepo(monitor.TestMonitor())
@@ -0,0 +1,117 @@
+package testfixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/dwarvesf/go-api/pkg/service/passwordhelper"
+	"github.com/stretchr/testify/require"
+)
+
+// DefaultPassword is the password of the users built without WithPassword
+const DefaultPassword = "123456"
+
+// seq keeps the emails of the built users unique
+var seq atomic.Int64
+
+// passwordHelper hashes the passwords the way the signup does, so the users can log in
+var passwordHelper = passwordhelper.NewScrypt()
+
+// UserOption overrides a field of the user or builds one of its related records
+type UserOption func(*userFixture)
+
+type userFixture struct {
+	req      model.SignupRequest
+	password string
+	related  []func(t *testing.T, ctx db.Context, u *model.User)
+}
+
+// WithEmail overrides the email
+func WithEmail(email string) UserOption {
+	return func(f *userFixture) { f.req.Email = email }
+}
+
+// WithName overrides the name
+func WithName(name string) UserOption {
+	return func(f *userFixture) { f.req.Name = name }
+}
+
+// WithPassword overrides the plain password
+func WithPassword(password string) UserOption {
+	return func(f *userFixture) { f.password = password }
+}
+
+// WithAvatar overrides the avatar
+func WithAvatar(avatar string) UserOption {
+	return func(f *userFixture) { f.req.Avatar = avatar }
+}
+
+// WithRole overrides the role
+func WithRole(role model.Role) UserOption {
+	return func(f *userFixture) { f.req.Role = role }
+}
+
+// WithStatus overrides the status
+func WithStatus(status model.Status) UserOption {
+	return func(f *userFixture) { f.req.Status = status }
+}
+
+// WithMailPreference saves the mail preference of the user
+func WithMailPreference(campaigns bool) UserOption {
+	return withRelated(func(t *testing.T, ctx db.Context, u *model.User) {
+		err := repo.MailPreference.Save(ctx, model.MailPreference{UserID: u.ID, Campaigns: campaigns})
+		require.NoError(t, err)
+	})
+}
+
+// WithOfflineMessage queues a realtime message for the user
+func WithOfflineMessage(payload json.RawMessage, expiresAt time.Time) UserOption {
+	return withRelated(func(t *testing.T, ctx db.Context, u *model.User) {
+		_, err := repo.OfflineMessage.Create(ctx, u.ID, payload, expiresAt)
+		require.NoError(t, err)
+	})
+}
+
+func withRelated(fn func(t *testing.T, ctx db.Context, u *model.User)) UserOption {
+	return func(f *userFixture) { f.related = append(f.related, fn) }
+}
+
+// User inserts an active user through the user repo, the options override its fields and build its
+// related records. It's rolled back with the transaction of db.WithTestingDB.
+func User(t *testing.T, ctx db.Context, opts ...UserOption) *model.User {
+	t.Helper()
+
+	n := seq.Add(1)
+	f := userFixture{
+		req: model.SignupRequest{
+			Email:  fmt.Sprintf("user%d@d.foundation", n),
+			Name:   fmt.Sprintf("user %d", n),
+			Avatar: "https://d.foundation/avatar.png",
+			Role:   model.RoleUser,
+			Status: model.StatusActive,
+		},
+		password: DefaultPassword,
+	}
+	for _, opt := range opts {
+		opt(&f)
+	}
+
+	var err error
+	f.req.Salt = passwordHelper.GenerateSalt()
+	f.req.HashedPassword, err = passwordHelper.Hash(f.password, f.req.Salt)
+	require.NoError(t, err)
+
+	u, err := repo.User.Create(ctx, f.req)
+	require.NoError(t, err)
+
+	for _, fn := range f.related {
+		fn(t, ctx, u)
+	}
+
+	return u
+}
@@ -0,0 +1,53 @@
+package testfixtures
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/dwarvesf/go-api/pkg/repository/db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUser(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		u := User(t, ctx)
+		require.Equal(t, string(model.RoleUser), u.Role)
+		require.Equal(t, string(model.StatusActive), u.Status)
+		require.True(t, passwordHelper.Compare(DefaultPassword, u.HashedPassword, u.Salt))
+		require.NotEqual(t, u.Email, User(t, ctx).Email)
+
+		admin := User(t, ctx,
+			WithEmail("admin@d.foundation"),
+			WithRole(model.RoleAdmin),
+			WithPassword("secret"),
+			WithMailPreference(false),
+			WithOfflineMessage(json.RawMessage(`{"type":"ping"}`), time.Now().Add(time.Hour)),
+		)
+		got, err := repo.User.GetByEmail(ctx, "admin@d.foundation")
+		require.NoError(t, err)
+		require.Equal(t, admin, got)
+		require.True(t, passwordHelper.Compare("secret", got.HashedPassword, got.Salt))
+
+		p, err := repo.MailPreference.Get(ctx, admin.ID)
+		require.NoError(t, err)
+		require.False(t, p.Campaigns)
+
+		msgs, err := repo.OfflineMessage.GetPending(ctx, admin.ID, time.Now())
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+	})
+}
+
+func TestCampaign(t *testing.T) {
+	db.WithTestingDB(t, func(ctx db.Context) {
+		c := Campaign(t, ctx)
+		require.Equal(t, model.CampaignStatusDraft, c.Status)
+
+		at := time.Now().Add(time.Hour).Truncate(time.Second)
+		c = Campaign(t, ctx, WithCampaignName("news"), WithSchedule(at))
+		require.Equal(t, "news", c.Name)
+		require.Equal(t, model.CampaignStatusScheduled, c.Status)
+	})
+}
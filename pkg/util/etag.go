@@ -0,0 +1,37 @@
+package util
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+)
+
+// ETag returns the strong ETag of a version of a resource, such as "3"
+func ETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// ParseIfMatch returns the version of the If-Match header, 0 for * as any version matches.
+// The header is required, so a client can't overwrite changes it never saw by accident.
+// The weak ETags and the lists of ETags aren't issued by the API, they're invalid.
+func ParseIfMatch(header string) (int, error) {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0, model.ErrPreconditionRequired
+	}
+	if header == "*" {
+		return 0, nil
+	}
+
+	v, err := strconv.Unquote(header)
+	if err != nil || !strings.HasPrefix(header, `"`) {
+		return 0, model.ErrInvalidIfMatch
+	}
+	version, err := strconv.Atoi(v)
+	if err != nil || version <= 0 {
+		return 0, model.ErrInvalidIfMatch
+	}
+
+	return version, nil
+}
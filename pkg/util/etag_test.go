@@ -0,0 +1,37 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestETag(t *testing.T) {
+	require.Equal(t, `"3"`, ETag(3))
+}
+
+func TestParseIfMatch(t *testing.T) {
+	tests := map[string]struct {
+		header  string
+		want    int
+		wantErr error
+	}{
+		"empty":       {header: "", wantErr: model.ErrPreconditionRequired},
+		"any":         {header: "*", want: 0},
+		"etag":        {header: `"3"`, want: 3},
+		"spaces":      {header: ` "12" `, want: 12},
+		"weak":        {header: `W/"3"`, wantErr: model.ErrInvalidIfMatch},
+		"unquoted":    {header: "3", wantErr: model.ErrInvalidIfMatch},
+		"list":        {header: `"3", "4"`, wantErr: model.ErrInvalidIfMatch},
+		"not version": {header: `"abc"`, wantErr: model.ErrInvalidIfMatch},
+		"zero":        {header: `"0"`, wantErr: model.ErrInvalidIfMatch},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseIfMatch(tt.header)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package util
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+)
+
+// filterParam is the name of the filter query params
+const filterParam = "filter"
+
+// ParseFilters parses the filters of the query params, such as filter[status]=active for eq
+// and filter[created_at][gte]=2026-10-19 for the other operators. The fields and operators are
+// checked by the repository, only the syntax is checked here.
+func ParseFilters(query url.Values) ([]model.Filter, error) {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		if strings.HasPrefix(k, filterParam+"[") {
+			keys = append(keys, k)
+		}
+	}
+	// the params of url.Values have no order, sort them so the queries are stable
+	sort.Strings(keys)
+
+	var rs []model.Filter
+	for _, k := range keys {
+		field, op, ok := parseFilterKey(strings.TrimPrefix(k, filterParam))
+		if !ok {
+			return nil, model.NewError(model.ErrInvalidFilter.Status, model.ErrInvalidFilter.Code, "invalid filter "+k)
+		}
+		for _, v := range query[k] {
+			rs = append(rs, model.Filter{
+				Field:    field,
+				Operator: op,
+				Value:    v,
+			})
+		}
+	}
+
+	return rs, nil
+}
+
+// parseFilterKey parses [field] or [field][operator]
+func parseFilterKey(k string) (string, model.FilterOperator, bool) {
+	parts := strings.Split(strings.TrimSuffix(strings.TrimPrefix(k, "["), "]"), "][")
+	if !strings.HasPrefix(k, "[") || !strings.HasSuffix(k, "]") || len(parts) > 2 || parts[0] == "" {
+		return "", "", false
+	}
+	if len(parts) == 1 {
+		return parts[0], model.FilterOperatorEq, true
+	}
+	if parts[1] == "" {
+		return "", "", false
+	}
+
+	return parts[0], model.FilterOperator(parts[1]), true
+}
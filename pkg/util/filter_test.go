@@ -0,0 +1,69 @@
+package util
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/dwarvesf/go-api/pkg/model"
+)
+
+func TestParseFilters(t *testing.T) {
+	tests := map[string]struct {
+		query   string
+		want    []model.Filter
+		wantErr bool
+	}{
+		"no filter": {
+			query: "page=1&sort=-created_at",
+		},
+		"eq and operators": {
+			query: "filter[status]=active&filter[created_at][gte]=2026-10-01&filter[created_at][lte]=2026-10-19&filter[role][in]=admin,user",
+			want: []model.Filter{
+				{Field: "created_at", Operator: model.FilterOperatorGte, Value: "2026-10-01"},
+				{Field: "created_at", Operator: model.FilterOperatorLte, Value: "2026-10-19"},
+				{Field: "role", Operator: model.FilterOperatorIn, Value: "admin,user"},
+				{Field: "status", Operator: model.FilterOperatorEq, Value: "active"},
+			},
+		},
+		"repeated": {
+			query: "filter[status][ne]=disabled&filter[status][ne]=pending",
+			want: []model.Filter{
+				{Field: "status", Operator: model.FilterOperatorNe, Value: "disabled"},
+				{Field: "status", Operator: model.FilterOperatorNe, Value: "pending"},
+			},
+		},
+		"empty field": {
+			query:   "filter[]=active",
+			wantErr: true,
+		},
+		"empty operator": {
+			query:   "filter[status][]=active",
+			wantErr: true,
+		},
+		"too deep": {
+			query:   "filter[status][eq][x]=active",
+			wantErr: true,
+		},
+		"unclosed": {
+			query:   "filter[status=active",
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			query, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := ParseFilters(query)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseFilters() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseFilters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
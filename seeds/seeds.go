@@ -0,0 +1,9 @@
+// Package seeds embeds the fixtures of the seeding profiles, a profile is a YAML or JSON file named after it
+package seeds
+
+import "embed"
+
+// FS holds the fixtures of the profiles
+//
+//go:embed *.yaml *.json
+var FS embed.FS